@@ -0,0 +1,752 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// DefaultInactiveDays is the default value of AnalyzerOptions.InactiveDays.
+const DefaultInactiveDays = 90
+
+// The default values of AnalyzerOptions.ReportPollInterval and AnalyzerOptions.ReportTimeout.
+const (
+	DefaultReportPollInterval = 5 * time.Second
+	DefaultReportTimeout      = 5 * time.Minute
+)
+
+// DefaultPrivilegedRoles is the default value of AnalyzerOptions.PrivilegedRoles.
+var DefaultPrivilegedRoles = []string{"Administrator", "Manager"}
+
+// Severity : The severity of a finding. Findings are ranked by decreasing severity.
+type Severity int
+
+// The severities of findings.
+const (
+	SeverityLow Severity = iota + 1
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+var severityNames = map[Severity]string{
+	SeverityLow:      "low",
+	SeverityMedium:   "medium",
+	SeverityHigh:     "high",
+	SeverityCritical: "critical",
+}
+
+// String returns the name of the severity (e.g. "high").
+func (severity Severity) String() string {
+	if name, found := severityNames[severity]; found {
+		return name
+	}
+	return "severity(" + strconv.Itoa(int(severity)) + ")"
+}
+
+// MarshalText encodes the severity as its name.
+func (severity Severity) MarshalText() ([]byte, error) {
+	return []byte(severity.String()), nil
+}
+
+// UnmarshalText decodes a severity encoded by MarshalText().
+func (severity *Severity) UnmarshalText(text []byte) error {
+	for value, name := range severityNames {
+		if name == string(text) {
+			*severity = value
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown severity '%s'", text)
+}
+
+// The kinds of findings, in the order in which findings of the same severity are ranked.
+const (
+	// A privileged role (see AnalyzerOptions.PrivilegedRoles) is granted on every service of the account.
+	FindingPrivilegedAccountAccess = "privileged_account_access"
+
+	// A role is granted on every service of the account, or on every value of a resource attribute.
+	FindingWildcardAccess = "wildcard_access"
+
+	// A role is granted on every resource of a service.
+	FindingServiceWideAccess = "service_wide_access"
+
+	// A policy grants access to an identity that has not authenticated recently.
+	FindingInactiveIdentity = "inactive_identity"
+
+	// A policy grants the same access to the same subject as another policy.
+	FindingDuplicatePolicy = "duplicate_policy"
+
+	// A policy grants a subset of the access granted to the same subject by another policy.
+	FindingShadowedPolicy = "shadowed_policy"
+
+	// A custom role of the account is not granted by any policy.
+	FindingUnusedCustomRole = "unused_custom_role"
+)
+
+var findingKindRanks = map[string]int{
+	FindingPrivilegedAccountAccess: 0,
+	FindingWildcardAccess:          1,
+	FindingServiceWideAccess:       2,
+	FindingInactiveIdentity:        3,
+	FindingDuplicatePolicy:         4,
+	FindingShadowedPolicy:          5,
+	FindingUnusedCustomRole:        6,
+}
+
+// Finding : A potential violation of least privilege.
+type Finding struct {
+	// The kind of finding (one of the Finding constants).
+	Kind string `json:"kind"`
+
+	// The severity of the finding.
+	Severity Severity `json:"severity"`
+
+	// The ID of the policy that the finding is about, if any.
+	PolicyID string `json:"policy_id,omitempty"`
+
+	// The subject of the policy (e.g. "iam_id=IBMid-123").
+	Subject string `json:"subject,omitempty"`
+
+	// The resource of the policy, as returned by Entry.ResourceString().
+	Resource string `json:"resource,omitempty"`
+
+	// The names of the roles that the finding is about.
+	Roles []string `json:"roles,omitempty"`
+
+	// The ID of the policy that duplicates or shadows the policy of the finding.
+	RelatedPolicyID string `json:"related_policy_id,omitempty"`
+
+	// A description of the finding.
+	Message string `json:"message"`
+}
+
+// String returns the finding in the form "[severity] kind: message".
+func (finding *Finding) String() string {
+	return fmt.Sprintf("[%s] %s: %s", finding.Severity, finding.Kind, finding.Message)
+}
+
+// Analysis : The findings of an Analyzer, ranked by decreasing severity.
+type Analysis struct {
+	// The account whose policies were analyzed.
+	AccountID string `json:"account_id"`
+
+	// The time at which the analysis was performed.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The number of policies analyzed.
+	PolicyCount int `json:"policy_count"`
+
+	// The findings, ranked by decreasing severity.
+	Findings []Finding `json:"findings"`
+}
+
+// GetFindings returns the findings with at least the specified severity.
+func (analysis *Analysis) GetFindings(minimum Severity) []Finding {
+	findings := []Finding{}
+	for _, finding := range analysis.Findings {
+		if finding.Severity >= minimum {
+			findings = append(findings, finding)
+		}
+	}
+	return findings
+}
+
+// String returns a summary of the analysis, e.g. "3 findings in 12 policies (1 critical, 2 low)".
+func (analysis *Analysis) String() string {
+	counts := make(map[Severity]int)
+	for _, finding := range analysis.Findings {
+		counts[finding.Severity]++
+	}
+	var details []string
+	for severity := SeverityCritical; severity >= SeverityLow; severity-- {
+		if counts[severity] > 0 {
+			details = append(details, fmt.Sprintf("%d %s", counts[severity], severity))
+		}
+	}
+	summary := fmt.Sprintf("%d findings in %d policies", len(analysis.Findings), analysis.PolicyCount)
+	if len(details) > 0 {
+		summary += " (" + strings.Join(details, ", ") + ")"
+	}
+	return summary
+}
+
+// AnalyzerOptions : The options used to create an Analyzer with NewAnalyzer().
+type AnalyzerOptions struct {
+	// The client used to list policies and roles.
+	IamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+
+	// The client used to create inactivity reports. Inactive identities are only reported if it is set.
+	IamIdentity *iamidentityv1.IamIdentityV1
+
+	// The client used to list the members of access groups. The access granted to inactive
+	// identities through access groups is only reported if it is set.
+	IamAccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+
+	// The account whose policies are analyzed.
+	AccountID string
+
+	// The number of days without authentication after which an identity is inactive
+	// (defaults to DefaultInactiveDays).
+	InactiveDays int
+
+	// The names or CRNs of the roles that must not be granted on the whole account
+	// (defaults to DefaultPrivilegedRoles).
+	PrivilegedRoles []string
+
+	// The interval at which an inactivity report is polled until it is complete
+	// (defaults to DefaultReportPollInterval).
+	ReportPollInterval time.Duration
+
+	// The maximum time to wait for an inactivity report (defaults to DefaultReportTimeout).
+	ReportTimeout time.Duration
+
+	// The maximum number of requests performed concurrently (defaults to DefaultConcurrency).
+	Concurrency int
+
+	// The function used to obtain the time of an analysis (defaults to time.Now).
+	Now func() time.Time
+}
+
+// Analyzer : Analyzes the access policies and custom roles of an account for violations of least privilege.
+type Analyzer struct {
+	options AnalyzerOptions
+}
+
+// NewAnalyzer returns a new Analyzer. The IamPolicyManagement and AccountID options are required.
+func NewAnalyzer(options *AnalyzerOptions) (*Analyzer, error) {
+	if options == nil || options.IamPolicyManagement == nil {
+		return nil, fmt.Errorf("an IamPolicyManagement client is required")
+	}
+	if options.AccountID == "" {
+		return nil, fmt.Errorf("an account ID is required")
+	}
+	analyzer := &Analyzer{options: *options}
+	if analyzer.options.InactiveDays <= 0 {
+		analyzer.options.InactiveDays = DefaultInactiveDays
+	}
+	if len(analyzer.options.PrivilegedRoles) == 0 {
+		analyzer.options.PrivilegedRoles = DefaultPrivilegedRoles
+	}
+	if analyzer.options.ReportPollInterval <= 0 {
+		analyzer.options.ReportPollInterval = DefaultReportPollInterval
+	}
+	if analyzer.options.ReportTimeout <= 0 {
+		analyzer.options.ReportTimeout = DefaultReportTimeout
+	}
+	if analyzer.options.Concurrency <= 0 {
+		analyzer.options.Concurrency = DefaultConcurrency
+	}
+	if analyzer.options.Now == nil {
+		analyzer.options.Now = time.Now
+	}
+	return analyzer, nil
+}
+
+// Analyze lists the active access policies and the roles of the account and returns the
+// findings, ranked by decreasing severity:
+//   - Privileged roles granted on every service of the account (critical).
+//   - Roles granted on every service of the account, or on every value of a resource
+//     attribute (high).
+//   - Roles granted on every resource of a service (medium, or high for privileged roles).
+//   - Policies of users, service IDs and trusted profiles that have not authenticated in
+//     AnalyzerOptions.InactiveDays days, as reported by an IAM Identity inactivity report, and
+//     policies of access groups with such members (high).
+//   - Duplicate policies, and policies whose access is included in the access granted to the
+//     same subject by another policy without conditions (low).
+//   - Custom roles that are not granted by any policy (low).
+func (analyzer *Analyzer) Analyze(ctx context.Context) (*Analysis, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	iamPolicyManagement := analyzer.options.IamPolicyManagement
+	pager, err := iamPolicyManagement.NewV2PoliciesPager(iamPolicyManagement.NewListV2PoliciesOptions(analyzer.options.AccountID).
+		SetType(iampolicymanagementv1.ListV2PoliciesOptionsTypeAccessConst).
+		SetState(iampolicymanagementv1.ListV2PoliciesOptionsStateActiveConst))
+	if err != nil {
+		return nil, err
+	}
+	policies, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the policies of account '%s': %w", analyzer.options.AccountID, err)
+	}
+	roles, err := listRoles(ctx, iamPolicyManagement, analyzer.options.AccountID, analyzer.options.Concurrency,
+		[][]iampolicymanagementv1.V2PolicyTemplateMetaData{policies})
+	if err != nil {
+		return nil, err
+	}
+	var inactive map[string]string
+	if analyzer.options.IamIdentity != nil {
+		if inactive, err = analyzer.getInactiveIdentities(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	analysis := &Analysis{
+		AccountID:   analyzer.options.AccountID,
+		CreatedAt:   analyzer.options.Now().UTC(),
+		PolicyCount: len(policies),
+		Findings:    []Finding{},
+	}
+	analyzedPolicies := make([]*analyzedPolicy, 0, len(policies))
+	for i := range policies {
+		policy, err := newAnalyzedPolicy(&policies[i], roles)
+		if err != nil {
+			return nil, err
+		}
+		analyzedPolicies = append(analyzedPolicies, policy)
+	}
+	var members map[string][]string
+	if len(inactive) > 0 && analyzer.options.IamAccessGroups != nil {
+		if members, err = analyzer.listAccessGroupMembers(ctx, analyzedPolicies); err != nil {
+			return nil, err
+		}
+	}
+	for _, policy := range analyzedPolicies {
+		analysis.Findings = append(analysis.Findings, analyzer.analyzeScope(policy)...)
+		if lastAuthn, found := inactive[policy.iamID]; found && policy.iamID != "" {
+			analysis.Findings = append(analysis.Findings, analyzer.newInactiveIdentityFinding(policy, policy.iamID, lastAuthn))
+		}
+		for _, member := range members[policy.accessGroupID] {
+			if lastAuthn, found := inactive[member]; found {
+				analysis.Findings = append(analysis.Findings, analyzer.newInactiveIdentityFinding(policy, member, lastAuthn))
+			}
+		}
+	}
+	analysis.Findings = append(analysis.Findings, findRedundantPolicies(analyzedPolicies)...)
+	analysis.Findings = append(analysis.Findings, findUnusedCustomRoles(analyzedPolicies, roles)...)
+
+	sort.SliceStable(analysis.Findings, func(i, j int) bool {
+		finding1, finding2 := &analysis.Findings[i], &analysis.Findings[j]
+		if finding1.Severity != finding2.Severity {
+			return finding1.Severity > finding2.Severity
+		}
+		if finding1.Kind != finding2.Kind {
+			return findingKindRanks[finding1.Kind] < findingKindRanks[finding2.Kind]
+		}
+		return finding1.PolicyID < finding2.PolicyID
+	})
+	return analysis, nil
+}
+
+// analyzedPolicy is the form of a policy used by the analysis.
+type analyzedPolicy struct {
+	id            string
+	subject       string
+	iamID         string
+	accessGroupID string
+	resource      Entry
+	rule          string
+	roles         []Role
+	actions       map[string]bool
+}
+
+func newAnalyzedPolicy(policy *iampolicymanagementv1.V2PolicyTemplateMetaData, roles map[string]Role) (*analyzedPolicy, error) {
+	analyzed := &analyzedPolicy{id: core.StringNilMapper(policy.ID), actions: make(map[string]bool)}
+	if policy.Subject != nil {
+		var subject []string
+		for _, attribute := range policy.Subject.Attributes {
+			key := core.StringNilMapper(attribute.Key)
+			subject = append(subject, fmt.Sprintf("%s=%v", key, attribute.Value))
+			switch key {
+			case "iam_id":
+				analyzed.iamID = fmt.Sprint(attribute.Value)
+			case "access_group_id":
+				analyzed.accessGroupID = fmt.Sprint(attribute.Value)
+			}
+		}
+		sort.Strings(subject)
+		analyzed.subject = strings.Join(subject, ", ")
+	}
+	analyzed.resource.Resource, analyzed.resource.ResourceTags = getPolicyResource(policy)
+	rule, err := getPolicyRule(policy)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the rule of policy '%s': %w", analyzed.id, err)
+	}
+	if rule != nil {
+		analyzed.rule = rule.String()
+	}
+	for _, roleCRN := range getRoleCRNs(policy) {
+		role, found := roles[roleCRN]
+		if !found {
+			role = Role{CRN: roleCRN, Name: roleCRN[strings.LastIndex(roleCRN, ":")+1:]}
+		}
+		analyzed.roles = append(analyzed.roles, role)
+		for _, action := range role.Actions {
+			analyzed.actions[action] = true
+		}
+	}
+	return analyzed, nil
+}
+
+func (policy *analyzedPolicy) roleNames() []string {
+	names := make([]string, len(policy.roles))
+	for i, role := range policy.roles {
+		names[i] = role.Name
+	}
+	return names
+}
+
+func (policy *analyzedPolicy) newFinding(kind string, severity Severity, roles []string, message string) Finding {
+	return Finding{
+		Kind:     kind,
+		Severity: severity,
+		PolicyID: policy.id,
+		Subject:  policy.subject,
+		Resource: policy.resource.ResourceString(),
+		Roles:    roles,
+		Message:  message,
+	}
+}
+
+// isNarrowingAttribute returns true if the resource attribute restricts a policy to a subset of
+// the resources of a service.
+func isNarrowingAttribute(attribute Attribute) bool {
+	switch attribute.Key {
+	case "accountId", "serviceName", "serviceType":
+		return false
+	}
+	return !isWildcardAttribute(attribute)
+}
+
+// isWildcardAttribute returns true if the resource attribute matches every value.
+func isWildcardAttribute(attribute Attribute) bool {
+	switch attribute.Operator {
+	case iampolicymanagementv1.V2PolicyResourceAttributeOperatorStringmatchConst:
+		return fmt.Sprint(attribute.Value) == "*"
+	case iampolicymanagementv1.V2PolicyResourceAttributeOperatorStringmatchanyofConst:
+		if values, ok := attribute.Value.([]interface{}); ok {
+			for _, value := range values {
+				if fmt.Sprint(value) == "*" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// analyzeScope returns the findings about the resource scope of the policy.
+func (analyzer *Analyzer) analyzeScope(policy *analyzedPolicy) []Finding {
+	var privileged []string
+	for _, role := range policy.roles {
+		for _, privilegedRole := range analyzer.options.PrivilegedRoles {
+			if iampolicymanagementv1.IsSameRole(role.CRN, privilegedRole) || role.Name == privilegedRole {
+				privileged = append(privileged, role.Name)
+				break
+			}
+		}
+	}
+
+	service := policy.resource.GetService()
+	narrowed := len(policy.resource.ResourceTags) > 0
+	var wildcards []string
+	for _, attribute := range policy.resource.Resource {
+		if isWildcardAttribute(attribute) {
+			wildcards = append(wildcards, attribute.Key)
+		}
+		if isNarrowingAttribute(attribute) {
+			narrowed = true
+		}
+	}
+
+	var findings []Finding
+	switch {
+	case service == "" && !narrowed && len(privileged) > 0:
+		findings = append(findings, policy.newFinding(FindingPrivilegedAccountAccess, SeverityCritical, privileged,
+			fmt.Sprintf("policy '%s' grants %s to %s on every service of the account", policy.id,
+				strings.Join(privileged, ", "), policy.subject)))
+	case service == "" && !narrowed:
+		findings = append(findings, policy.newFinding(FindingWildcardAccess, SeverityHigh, policy.roleNames(),
+			fmt.Sprintf("policy '%s' grants %s to %s on every service of the account", policy.id,
+				strings.Join(policy.roleNames(), ", "), policy.subject)))
+	case len(wildcards) > 0:
+		findings = append(findings, policy.newFinding(FindingWildcardAccess, SeverityHigh, policy.roleNames(),
+			fmt.Sprintf("policy '%s' grants %s to %s on every value of %s", policy.id,
+				strings.Join(policy.roleNames(), ", "), policy.subject, strings.Join(wildcards, ", "))))
+	case !narrowed:
+		severity, roles := SeverityMedium, policy.roleNames()
+		if len(privileged) > 0 {
+			severity, roles = SeverityHigh, privileged
+		}
+		findings = append(findings, policy.newFinding(FindingServiceWideAccess, severity, roles,
+			fmt.Sprintf("policy '%s' grants %s to %s on every resource of service '%s'", policy.id,
+				strings.Join(roles, ", "), policy.subject, service)))
+	}
+	return findings
+}
+
+// newInactiveIdentityFinding returns a finding about the access granted by the policy to the
+// inactive identity "iamID", which is either the subject of the policy or a member of its access group.
+func (analyzer *Analyzer) newInactiveIdentityFinding(policy *analyzedPolicy, iamID string, lastAuthn string) Finding {
+	activity := "has never authenticated"
+	if lastAuthn != "" {
+		activity = "last authenticated at " + lastAuthn
+	}
+	grantee := fmt.Sprintf("'%s'", iamID)
+	if iamID != policy.iamID {
+		grantee = fmt.Sprintf("'%s' through access group '%s'", iamID, policy.accessGroupID)
+	}
+	return policy.newFinding(FindingInactiveIdentity, SeverityHigh, policy.roleNames(),
+		fmt.Sprintf("policy '%s' grants %s to %s, which has not authenticated in the last %d days (%s)", policy.id,
+			strings.Join(policy.roleNames(), ", "), grantee, analyzer.options.InactiveDays, activity))
+}
+
+// listAccessGroupMembers returns the sorted IAM IDs of the members of each access group that is
+// the subject of one of the policies, indexed by access group ID.
+func (analyzer *Analyzer) listAccessGroupMembers(ctx context.Context, policies []*analyzedPolicy) (map[string][]string, error) {
+	var groupIDs []string
+	seen := make(map[string]bool)
+	for _, policy := range policies {
+		if policy.accessGroupID != "" && !seen[policy.accessGroupID] {
+			seen[policy.accessGroupID] = true
+			groupIDs = append(groupIDs, policy.accessGroupID)
+		}
+	}
+
+	iamAccessGroups := analyzer.options.IamAccessGroups
+	members := make([][]string, len(groupIDs))
+	err := forEach(ctx, analyzer.options.Concurrency, len(groupIDs), func(i int) error {
+		pager, err := iamAccessGroups.NewAccessGroupMembersPager(iamAccessGroups.NewListAccessGroupMembersOptions(groupIDs[i]).
+			SetMembershipType("all"))
+		if err != nil {
+			return err
+		}
+		groupMembers, err := pager.GetAllWithContext(ctx)
+		if err != nil {
+			return fmt.Errorf("error listing the members of access group '%s': %w", groupIDs[i], err)
+		}
+		for _, member := range groupMembers {
+			if member.IamID != nil {
+				members[i] = append(members[i], *member.IamID)
+			}
+		}
+		sort.Strings(members[i])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]string, len(groupIDs))
+	for i, groupID := range groupIDs {
+		result[groupID] = members[i]
+	}
+	return result, nil
+}
+
+// getInactiveIdentities creates an inactivity report and returns the last authentication time
+// ("" if none) of each inactive identity, indexed by IAM ID.
+func (analyzer *Analyzer) getInactiveIdentities(ctx context.Context) (map[string]string, error) {
+	iamIdentity := analyzer.options.IamIdentity
+	accountID := analyzer.options.AccountID
+	reference, _, err := iamIdentity.CreateReportWithContext(ctx, iamIdentity.NewCreateReportOptions(accountID).
+		SetType("inactive").SetDuration(strconv.Itoa(analyzer.options.InactiveDays*24)))
+	if err != nil {
+		return nil, fmt.Errorf("error creating an inactivity report: %w", err)
+	}
+	if reference == nil || reference.Reference == nil {
+		return nil, fmt.Errorf("the inactivity report was created without a reference")
+	}
+
+	deadline := time.Now().Add(analyzer.options.ReportTimeout)
+	var report *iamidentityv1.Report
+	for {
+		var response *core.DetailedResponse
+		report, response, err = iamIdentity.GetReportWithContext(ctx, iamIdentity.NewGetReportOptions(accountID, *reference.Reference))
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving inactivity report '%s': %w", *reference.Reference, err)
+		}
+		if response.StatusCode != http.StatusNoContent && report != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("inactivity report '%s' was not completed within %s", *reference.Reference,
+				analyzer.options.ReportTimeout)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(analyzer.options.ReportPollInterval):
+		}
+	}
+
+	inactive := make(map[string]string)
+	for _, user := range report.Users {
+		inactive[core.StringNilMapper(user.IamID)] = core.StringNilMapper(user.LastAuthn)
+	}
+	for _, entities := range [][]iamidentityv1.EntityActivity{report.Serviceids, report.Profiles} {
+		for _, entity := range entities {
+			iamID := core.StringNilMapper(entity.ID)
+			if !strings.HasPrefix(iamID, "iam-") {
+				iamID = "iam-" + iamID
+			}
+			inactive[iamID] = core.StringNilMapper(entity.LastAuthn)
+		}
+	}
+	return inactive, nil
+}
+
+// findRedundantPolicies returns a finding for each policy that duplicates an earlier policy of
+// the same subject, or whose access is included in the access granted by another policy of the
+// same subject.
+func findRedundantPolicies(policies []*analyzedPolicy) []Finding {
+	var findings []Finding
+	for i, policy := range policies {
+		for j, other := range policies {
+			if i == j || policy.subject != other.subject {
+				continue
+			}
+			if isDuplicatePolicy(policy, other) {
+				if j < i {
+					findings = append(findings, policy.newFinding(FindingDuplicatePolicy, SeverityLow, policy.roleNames(),
+						fmt.Sprintf("policy '%s' grants the same access to %s as policy '%s'", policy.id, policy.subject, other.id)))
+					findings[len(findings)-1].RelatedPolicyID = other.id
+					break
+				}
+				continue
+			}
+			if isShadowedPolicy(policy, other) {
+				findings = append(findings, policy.newFinding(FindingShadowedPolicy, SeverityLow, policy.roleNames(),
+					fmt.Sprintf("the access granted to %s by policy '%s' is included in the access granted by policy '%s'",
+						policy.subject, policy.id, other.id)))
+				findings[len(findings)-1].RelatedPolicyID = other.id
+				break
+			}
+		}
+	}
+	return findings
+}
+
+// isDuplicatePolicy returns true if "policy" and "other" have the same rule, resource and roles.
+// Resource attributes are compared regardless of their order.
+func isDuplicatePolicy(policy *analyzedPolicy, other *analyzedPolicy) bool {
+	return policy.rule == other.rule &&
+		sameAttributes(policy.resource.Resource, other.resource.Resource) &&
+		sameAttributes(policy.resource.ResourceTags, other.resource.ResourceTags) &&
+		containsRoles(policy, other) && containsRoles(other, policy)
+}
+
+// isShadowedPolicy returns true if "other" grants at least the access granted by "policy": its
+// rule is absent or identical, its resource includes the resource of "policy" and its roles
+// include the roles (or actions) of "policy".
+func isShadowedPolicy(policy *analyzedPolicy, other *analyzedPolicy) bool {
+	if other.rule != "" && other.rule != policy.rule {
+		return false
+	}
+	if !containsAttributes(policy.resource.Resource, other.resource.Resource) ||
+		!containsAttributes(policy.resource.ResourceTags, other.resource.ResourceTags) {
+		return false
+	}
+	return containsRoles(other, policy)
+}
+
+// containsAttributes returns true if each of "subset" is one of "attributes", i.e. if the
+// resource identified by "subset" includes the resource identified by "attributes".
+func containsAttributes(attributes []Attribute, subset []Attribute) bool {
+	for _, attribute := range subset {
+		found := false
+		for _, candidate := range attributes {
+			if candidate.String() == attribute.String() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// sameAttributes returns true if "attributes1" and "attributes2" contain the same attributes, in
+// any order.
+func sameAttributes(attributes1 []Attribute, attributes2 []Attribute) bool {
+	return containsAttributes(attributes1, attributes2) && containsAttributes(attributes2, attributes1)
+}
+
+// containsRoles returns true if the roles of "policy" include each role of "other", or if the
+// actions of the roles of "policy" include all the actions of the roles of "other".
+func containsRoles(policy *analyzedPolicy, other *analyzedPolicy) bool {
+	containsAll := true
+	for _, role := range other.roles {
+		found := false
+		for _, candidate := range policy.roles {
+			if candidate.CRN == role.CRN {
+				found = true
+				break
+			}
+		}
+		if !found {
+			containsAll = false
+			break
+		}
+	}
+	if containsAll {
+		return true
+	}
+	if len(other.actions) == 0 {
+		return false
+	}
+	for _, role := range other.roles {
+		if len(role.Actions) == 0 {
+			return false
+		}
+	}
+	for action := range other.actions {
+		if !policy.actions[action] {
+			return false
+		}
+	}
+	return true
+}
+
+// findUnusedCustomRoles returns a finding for each custom role that is not granted by any policy.
+func findUnusedCustomRoles(policies []*analyzedPolicy, roles map[string]Role) []Finding {
+	used := make(map[string]bool)
+	for _, policy := range policies {
+		for _, role := range policy.roles {
+			used[role.CRN] = true
+		}
+	}
+	var findings []Finding
+	for crn, role := range roles {
+		if role.Custom && !used[crn] {
+			findings = append(findings, Finding{
+				Kind:     FindingUnusedCustomRole,
+				Severity: SeverityLow,
+				Roles:    []string{role.Name},
+				Message:  fmt.Sprintf("custom role '%s' (%s) is not granted by any policy", role.Name, crn),
+			})
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		return findings[i].Message < findings[j].Message
+	})
+	return findings
+}
@@ -0,0 +1,221 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package accessreview_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/accessreview"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func attribute(key string, operator string, value interface{}) iampolicymanagementv1.V2PolicyResourceAttribute {
+	return iampolicymanagementv1.V2PolicyResourceAttribute{Key: core.StringPtr(key), Operator: core.StringPtr(operator), Value: value}
+}
+
+func (f *fixture) newAnalyzer(t *testing.T) *accessreview.Analyzer {
+	analyzer, err := accessreview.NewAnalyzer(&accessreview.AnalyzerOptions{
+		IamPolicyManagement: f.iamPolicyManagement,
+		IamIdentity:         f.iamIdentity,
+		IamAccessGroups:     f.iamAccessGroups,
+		AccountID:           f.server.AccountID(),
+		ReportPollInterval:  time.Millisecond,
+		Now:                 func() time.Time { return reportTime },
+	})
+	require.Nil(t, err)
+	return analyzer
+}
+
+func TestAnalyze(t *testing.T) {
+	f := newFixture(t)
+	accountID := f.server.AccountID()
+	customRolePrefix := "crn:v1:bluemix:public:iam-access-management::a/" + accountID + "::customRole:"
+	for _, name := range []string{"InstanceReader", "ObjectCleaner"} {
+		f.server.RegisterCustomRole(iampolicymanagementv1.CustomRole{
+			Name:        core.StringPtr(name),
+			DisplayName: core.StringPtr(name),
+			Actions:     []string{"resource-controller.instance.retrieve"},
+			AccountID:   core.StringPtr(accountID),
+			ServiceName: core.StringPtr("kms"),
+			CRN:         core.StringPtr(customRolePrefix + name),
+		})
+	}
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-active", LastAuthn: reportTime.Add(-time.Hour)})
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-inactive", LastAuthn: reportTime.AddDate(0, 0, -200)})
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "iam-ServiceId-idle"})
+	group := f.createAccessGroup(t, "operators")
+
+	kms := attribute("serviceName", "stringEquals", "kms")
+	cos := attribute("serviceName", "stringEquals", "cloud-object-storage")
+	resourceGroup := attribute("resourceGroupId", "stringEquals", "rg")
+	adminPolicy := f.createPolicyWithResource(t, "iam_id", "IBMid-admin", nil, nil, "crn:v1:bluemix:public:iam::::role:Administrator")
+	accountViewer := f.createPolicyWithResource(t, "iam_id", "IBMid-active", nil, nil, viewerRole)
+	kmsViewer := f.createPolicyWithResource(t, "iam_id", "IBMid-active", []iampolicymanagementv1.V2PolicyResourceAttribute{kms}, nil, viewerRole)
+	anyInstance := f.createPolicyWithResource(t, "iam_id", "IBMid-active", []iampolicymanagementv1.V2PolicyResourceAttribute{
+		cos, attribute("serviceInstance", "stringMatch", "*"),
+	}, nil, writerRole)
+	groupManager := f.createPolicyWithResource(t, "access_group_id", group, []iampolicymanagementv1.V2PolicyResourceAttribute{cos}, nil,
+		"crn:v1:bluemix:public:iam::::serviceRole:Manager")
+	instance := []iampolicymanagementv1.V2PolicyResourceAttribute{cos, attribute("serviceInstance", "stringEquals", "abc")}
+	inactiveWriter := f.createPolicyWithResource(t, "iam_id", "IBMid-inactive", instance, nil, writerRole)
+	duplicateWriter := f.createPolicyWithResource(t, "iam_id", "IBMid-inactive", instance, nil, writerRole)
+	idleViewer := f.createPolicyWithResource(t, "iam_id", "iam-ServiceId-idle", []iampolicymanagementv1.V2PolicyResourceAttribute{kms, resourceGroup}, nil,
+		viewerRole)
+	customRole := f.createPolicyWithResource(t, "iam_id", "IBMid-active", []iampolicymanagementv1.V2PolicyResourceAttribute{kms, resourceGroup}, nil,
+		customRolePrefix+"InstanceReader")
+
+	analysis, err := f.newAnalyzer(t).Analyze(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, accountID, analysis.AccountID)
+	assert.Equal(t, reportTime, analysis.CreatedAt)
+	assert.Equal(t, 9, analysis.PolicyCount)
+	assert.Equal(t, "12 findings in 9 policies (1 critical, 6 high, 1 medium, 4 low)", analysis.String())
+
+	type summary struct {
+		Kind            string
+		Severity        accessreview.Severity
+		PolicyID        string
+		RelatedPolicyID string
+	}
+	var summaries []summary
+	for _, finding := range analysis.Findings {
+		summaries = append(summaries, summary{finding.Kind, finding.Severity, finding.PolicyID, finding.RelatedPolicyID})
+	}
+	assert.Equal(t, []summary{
+		{accessreview.FindingPrivilegedAccountAccess, accessreview.SeverityCritical, adminPolicy, ""},
+		{accessreview.FindingWildcardAccess, accessreview.SeverityHigh, accountViewer, ""},
+		{accessreview.FindingWildcardAccess, accessreview.SeverityHigh, anyInstance, ""},
+		{accessreview.FindingServiceWideAccess, accessreview.SeverityHigh, groupManager, ""},
+		{accessreview.FindingInactiveIdentity, accessreview.SeverityHigh, inactiveWriter, ""},
+		{accessreview.FindingInactiveIdentity, accessreview.SeverityHigh, duplicateWriter, ""},
+		{accessreview.FindingInactiveIdentity, accessreview.SeverityHigh, idleViewer, ""},
+		{accessreview.FindingServiceWideAccess, accessreview.SeverityMedium, kmsViewer, ""},
+		{accessreview.FindingDuplicatePolicy, accessreview.SeverityLow, duplicateWriter, inactiveWriter},
+		{accessreview.FindingShadowedPolicy, accessreview.SeverityLow, kmsViewer, accountViewer},
+		{accessreview.FindingShadowedPolicy, accessreview.SeverityLow, customRole, accountViewer},
+		{accessreview.FindingUnusedCustomRole, accessreview.SeverityLow, "", ""},
+	}, summaries)
+
+	critical := analysis.Findings[0]
+	assert.Equal(t, []string{"Administrator"}, critical.Roles)
+	assert.Equal(t, "iam_id=IBMid-admin", critical.Subject)
+	assert.Equal(t, "[critical] privileged_account_access: policy '"+adminPolicy+
+		"' grants Administrator to iam_id=IBMid-admin on every service of the account", critical.String())
+	assert.Contains(t, analysis.Findings[2].Message, "on every value of serviceInstance")
+	assert.Equal(t, []string{"Manager"}, analysis.Findings[3].Roles)
+	assert.Contains(t, analysis.Findings[4].Message, "last authenticated at 2023-10-14T12:00+0000")
+	assert.Contains(t, analysis.Findings[6].Message, "has never authenticated")
+	assert.Equal(t, []string{"ObjectCleaner"}, analysis.Findings[11].Roles)
+	assert.Len(t, analysis.GetFindings(accessreview.SeverityHigh), 7)
+
+	buffer, err := json.Marshal(analysis)
+	require.Nil(t, err)
+	decoded := &accessreview.Analysis{}
+	require.Nil(t, json.Unmarshal(buffer, decoded))
+	assert.Equal(t, analysis.Findings, decoded.Findings)
+}
+
+func TestAnalyzeDuplicatePoliciesWithReorderedAttributes(t *testing.T) {
+	f := newFixture(t)
+	cos := attribute("serviceName", "stringEquals", "cloud-object-storage")
+	instance := attribute("serviceInstance", "stringEquals", "abc")
+	first := f.createPolicyWithResource(t, "iam_id", "IBMid-active", []iampolicymanagementv1.V2PolicyResourceAttribute{cos, instance}, nil,
+		writerRole)
+	second := f.createPolicyWithResource(t, "iam_id", "IBMid-active", []iampolicymanagementv1.V2PolicyResourceAttribute{instance, cos}, nil,
+		writerRole)
+
+	// Only the later policy is reported, as a duplicate of the earlier one.
+	analysis, err := f.newAnalyzer(t).Analyze(context.Background())
+	require.Nil(t, err)
+	require.Len(t, analysis.Findings, 1)
+	assert.Equal(t, accessreview.FindingDuplicatePolicy, analysis.Findings[0].Kind)
+	assert.Equal(t, second, analysis.Findings[0].PolicyID)
+	assert.Equal(t, first, analysis.Findings[0].RelatedPolicyID)
+}
+
+func TestAnalyzeInactiveAccessGroupMembers(t *testing.T) {
+	f := newFixture(t)
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-active", LastAuthn: reportTime.Add(-time.Hour)})
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-inactive", LastAuthn: reportTime.AddDate(0, 0, -200)})
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-idle"})
+	f.server.RegisterIdentity(platformfake.Identity{IamID: "iam-Profile-idle"})
+	group := f.createAccessGroup(t, "writers", "IBMid-inactive", "IBMid-active", "IBMid-idle")
+	require.True(t, f.server.AddDynamicAccessGroupMember(group, "iam-Profile-idle", "profile"))
+	f.createAccessGroup(t, "unused", "IBMid-inactive")
+	instance := []iampolicymanagementv1.V2PolicyResourceAttribute{
+		attribute("serviceName", "stringEquals", "cloud-object-storage"),
+		attribute("serviceInstance", "stringEquals", "abc"),
+	}
+	groupWriter := f.createPolicyWithResource(t, "access_group_id", group, instance, nil, writerRole)
+
+	analysis, err := f.newAnalyzer(t).Analyze(context.Background())
+	require.Nil(t, err)
+	require.Len(t, analysis.Findings, 3)
+	for _, finding := range analysis.Findings {
+		assert.Equal(t, accessreview.FindingInactiveIdentity, finding.Kind)
+		assert.Equal(t, groupWriter, finding.PolicyID)
+		assert.Equal(t, "access_group_id="+group, finding.Subject)
+	}
+	assert.Equal(t, "policy '"+groupWriter+"' grants Writer to 'IBMid-idle' through access group '"+group+
+		"', which has not authenticated in the last 90 days (has never authenticated)", analysis.Findings[0].Message)
+	assert.Contains(t, analysis.Findings[1].Message, "'IBMid-inactive' through access group")
+	// Members that match a dynamic rule of the group are included.
+	assert.Contains(t, analysis.Findings[2].Message, "'iam-Profile-idle' through access group")
+
+	f.server.Fail(http.MethodGet, "/v2/groups/"+group+"/members", http.StatusForbidden, 1)
+	_, err = f.newAnalyzer(t).Analyze(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing the members of access group '"+group+"'")
+}
+
+func TestAnalyzeErrors(t *testing.T) {
+	f := newFixture(t)
+	_, err := accessreview.NewAnalyzer(&accessreview.AnalyzerOptions{AccountID: f.server.AccountID()})
+	assert.NotNil(t, err)
+	_, err = accessreview.NewAnalyzer(&accessreview.AnalyzerOptions{IamPolicyManagement: f.iamPolicyManagement})
+	assert.NotNil(t, err)
+
+	analyzer := f.newAnalyzer(t)
+	f.server.Fail(http.MethodGet, "/v2/policies", http.StatusForbidden, 1)
+	_, err = analyzer.Analyze(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing the policies of account")
+
+	f.server.Fail(http.MethodPost, "/v1/activity/accounts/"+f.server.AccountID()+"/report", http.StatusForbidden, 1)
+	_, err = analyzer.Analyze(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error creating an inactivity report")
+
+	// Without an IamIdentity client, inactive identities are not reported.
+	analyzer, err = accessreview.NewAnalyzer(&accessreview.AnalyzerOptions{
+		IamPolicyManagement: f.iamPolicyManagement,
+		AccountID:           f.server.AccountID(),
+	})
+	require.Nil(t, err)
+	analysis, err := analyzer.Analyze(context.Background())
+	require.Nil(t, err)
+	assert.Empty(t, analysis.Findings)
+	assert.Equal(t, "0 findings in 0 policies", analysis.String())
+}
@@ -0,0 +1,75 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package accessreview
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// csvHeader is the first row written by Report.WriteCSV().
+var csvHeader = []string{"iam_id", "service", "resource", "role", "role_crn", "actions", "conditions", "sources"}
+
+// WriteJSON writes the report to "w" as a single, indented JSON document.
+func (report *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("error writing the report: %w", err)
+	}
+	return nil
+}
+
+// WriteCSV writes the entries of the report to "w" as CSV, with a header row and one row per
+// entry. Lists (actions and sources) are separated by semicolons.
+func (report *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return fmt.Errorf("error writing the report: %w", err)
+	}
+	for _, entry := range report.Entries {
+		conditions := ""
+		if entry.Rule != nil {
+			conditions = entry.Rule.String()
+		}
+		sources := make([]string, len(entry.Sources))
+		for i, source := range entry.Sources {
+			sources[i] = source.String()
+		}
+		row := []string{
+			report.IamID,
+			entry.GetService(),
+			entry.ResourceString(),
+			entry.Role.Name,
+			entry.Role.CRN,
+			strings.Join(entry.Role.Actions, ";"),
+			conditions,
+			strings.Join(sources, ";"),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("error writing the report: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("error writing the report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,641 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package accessreview reports the IAM access of the identities of an account, for use in
+// periodic access reviews.
+//
+// A Reporter determines the effective access of a user, service ID or trusted profile: the
+// access granted by the policies of the identity itself and by the policies of the access
+// groups that it is a member of, with each role resolved to its name and actions:
+//
+//	reporter, err := accessreview.NewReporter(&accessreview.ReporterOptions{
+//		IamAccessGroups:     iamAccessGroups,
+//		IamPolicyManagement: iamPolicyManagement,
+//		AccountID:           accountID,
+//	})
+//	...
+//	report, err := reporter.GetEffectiveAccess(ctx, "IBMid-123456")
+//	...
+//	err = report.WriteCSV(file)
+//
+// Access that is granted several times (e.g. by the policies of two access groups) appears once
+// in the report, with every policy that grants it listed as a source.
+//
+// An Analyzer examines the access policies and custom roles of an account and returns findings
+// that violate least privilege, ranked by severity: privileged or wildcard grants on the whole
+// account, service-wide grants, grants held by inactive identities (directly or through access
+// groups), redundant policies and unused custom roles:
+//
+//	analyzer, err := accessreview.NewAnalyzer(&accessreview.AnalyzerOptions{
+//		IamPolicyManagement: iamPolicyManagement,
+//		IamIdentity:         iamIdentity,
+//		IamAccessGroups:     iamAccessGroups,
+//		AccountID:           accountID,
+//		InactiveDays:        60,
+//	})
+//	...
+//	analysis, err := analyzer.Analyze(ctx)
+//	...
+//	for _, finding := range analysis.GetFindings(accessreview.SeverityHigh) {
+//		fmt.Println(finding.String())
+//	}
+package accessreview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// DefaultConcurrency is the default value of ReporterOptions.Concurrency.
+const DefaultConcurrency = 4
+
+// The types of the identities whose access is reported.
+const (
+	IdentityTypeUser           = "user"
+	IdentityTypeServiceID      = "service_id"
+	IdentityTypeTrustedProfile = "trusted_profile"
+)
+
+// GetIdentityType returns the type of the identity with the specified IAM ID (one of the
+// IdentityType constants).
+func GetIdentityType(iamID string) string {
+	switch {
+	case strings.HasPrefix(iamID, "iam-ServiceId-"):
+		return IdentityTypeServiceID
+	case strings.HasPrefix(iamID, "iam-Profile-"):
+		return IdentityTypeTrustedProfile
+	}
+	return IdentityTypeUser
+}
+
+// Report : The effective access of an identity at a point in time.
+type Report struct {
+	// The account in which the access is granted.
+	AccountID string `json:"account_id"`
+
+	// The IAM ID of the identity.
+	IamID string `json:"iam_id"`
+
+	// The type of the identity (one of the IdentityType constants).
+	IdentityType string `json:"identity_type"`
+
+	// The time at which the report was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The access groups that the identity is a member of, sorted by name.
+	AccessGroups []AccessGroup `json:"access_groups"`
+
+	// The access of the identity, sorted by resource and role.
+	Entries []*Entry `json:"entries"`
+
+	// The CRNs of the roles granted by the policies that could not be resolved to a role of
+	// the account, sorted. The entries of these roles have no actions.
+	UnresolvedRoles []string `json:"unresolved_roles,omitempty"`
+}
+
+// AccessGroup : An access group that the identity of a report is a member of.
+type AccessGroup struct {
+	// The ID of the access group.
+	ID string `json:"id"`
+
+	// The name of the access group.
+	Name string `json:"name"`
+}
+
+// Entry : A role granted to the identity of a report on a resource.
+type Entry struct {
+	// The attributes that identify the resource.
+	Resource []Attribute `json:"resource"`
+
+	// The access management tags that the resource must have, if any.
+	ResourceTags []Attribute `json:"resource_tags,omitempty"`
+
+	// The role granted on the resource.
+	Role Role `json:"role"`
+
+	// The conditions under which the role is granted, or nil if it is always granted.
+	Rule *Condition `json:"rule,omitempty"`
+
+	// The pattern of the policies with conditions (e.g. "time-based-conditions:once").
+	Pattern string `json:"pattern,omitempty"`
+
+	// The policies that grant the role.
+	Sources []Source `json:"sources"`
+}
+
+// Attribute : An attribute of the resource of a policy.
+type Attribute struct {
+	// The name of the attribute (e.g. "serviceName").
+	Key string `json:"key"`
+
+	// The operator of the attribute (e.g. "stringEquals").
+	Operator string `json:"operator"`
+
+	// The value of the attribute.
+	Value interface{} `json:"value"`
+}
+
+// Role : A role granted by a policy.
+type Role struct {
+	// The CRN of the role.
+	CRN string `json:"crn"`
+
+	// The display name of the role (the last segment of its CRN if the role is not resolved).
+	Name string `json:"name"`
+
+	// The actions that the role permits, sorted.
+	Actions []string `json:"actions,omitempty"`
+
+	// True if the role is a custom role of the account.
+	Custom bool `json:"custom,omitempty"`
+}
+
+// Condition : A condition of the rule of a policy, or a group of conditions.
+type Condition struct {
+	// The key of the condition, or "" for a group.
+	Key string `json:"key,omitempty"`
+
+	// The operator of the condition, or "and" or "or" for a group.
+	Operator string `json:"operator"`
+
+	// The value of the condition.
+	Value interface{} `json:"value,omitempty"`
+
+	// The conditions of a group.
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// Source : A policy that grants access to the identity of a report.
+type Source struct {
+	// The ID of the policy.
+	PolicyID string `json:"policy_id"`
+
+	// The ID of the access group whose policy grants the access, or "" if the policy is a
+	// policy of the identity itself.
+	AccessGroupID string `json:"access_group_id,omitempty"`
+
+	// The name of the access group.
+	AccessGroupName string `json:"access_group_name,omitempty"`
+}
+
+// String returns the attribute in the form "key=value" for the "stringEquals" operator, or
+// "key operator value" otherwise.
+func (attribute Attribute) String() string {
+	if attribute.Operator == iampolicymanagementv1.V2PolicyResourceAttributeOperatorStringequalsConst {
+		return fmt.Sprintf("%s=%v", attribute.Key, attribute.Value)
+	}
+	return fmt.Sprintf("%s %s %v", attribute.Key, attribute.Operator, attribute.Value)
+}
+
+// String returns the condition in the form "key operator value", or "(c1 and c2 ...)" for a group.
+func (condition *Condition) String() string {
+	if condition.Key != "" || len(condition.Conditions) == 0 {
+		return fmt.Sprintf("%s %s %v", condition.Key, condition.Operator, condition.Value)
+	}
+	conditions := make([]string, len(condition.Conditions))
+	for i := range condition.Conditions {
+		conditions[i] = condition.Conditions[i].String()
+	}
+	return "(" + strings.Join(conditions, " "+condition.Operator+" ") + ")"
+}
+
+// String returns "policy <ID>", followed by " via access group '<name>'" if the policy is a
+// policy of an access group.
+func (source Source) String() string {
+	if source.AccessGroupID == "" {
+		return "policy " + source.PolicyID
+	}
+	return fmt.Sprintf("policy %s via access group '%s'", source.PolicyID, source.AccessGroupName)
+}
+
+// ResourceString returns the attributes of the resource (and resource tags) of the entry,
+// separated by commas.
+func (entry *Entry) ResourceString() string {
+	attributes := make([]string, 0, len(entry.Resource)+len(entry.ResourceTags))
+	for _, attribute := range entry.Resource {
+		attributes = append(attributes, attribute.String())
+	}
+	for _, tag := range entry.ResourceTags {
+		attributes = append(attributes, "tag:"+tag.String())
+	}
+	return strings.Join(attributes, ", ")
+}
+
+// GetService returns the value of the "serviceName" attribute of the resource of the entry, or
+// "" if the entry applies to all services.
+func (entry *Entry) GetService() string {
+	for _, attribute := range entry.Resource {
+		if attribute.Key == "serviceName" {
+			return fmt.Sprint(attribute.Value)
+		}
+	}
+	return ""
+}
+
+// String returns a summary of the report.
+func (report *Report) String() string {
+	return fmt.Sprintf("%s '%s' has %d role assignments (%d access groups)", strings.ReplaceAll(report.IdentityType, "_", " "),
+		report.IamID, len(report.Entries), len(report.AccessGroups))
+}
+
+// ReporterOptions : The options used to create a Reporter with NewReporter().
+type ReporterOptions struct {
+	// The client used to list the access groups of an identity.
+	IamAccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+
+	// The client used to list policies and roles.
+	IamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+
+	// The account whose policies are reported.
+	AccountID string
+
+	// The maximum number of requests performed concurrently (defaults to DefaultConcurrency).
+	Concurrency int
+
+	// The function used to obtain the creation time of a report (defaults to time.Now).
+	Now func() time.Time
+}
+
+// Reporter : Reports the effective access of the identities of an account.
+type Reporter struct {
+	options ReporterOptions
+}
+
+// NewReporter returns a new Reporter. The IamAccessGroups, IamPolicyManagement and AccountID
+// options are required.
+func NewReporter(options *ReporterOptions) (*Reporter, error) {
+	if options == nil || options.IamAccessGroups == nil || options.IamPolicyManagement == nil {
+		return nil, fmt.Errorf("an IamAccessGroups and an IamPolicyManagement client are required")
+	}
+	if options.AccountID == "" {
+		return nil, fmt.Errorf("an account ID is required")
+	}
+	reporter := &Reporter{options: *options}
+	if reporter.options.Concurrency <= 0 {
+		reporter.options.Concurrency = DefaultConcurrency
+	}
+	if reporter.options.Now == nil {
+		reporter.options.Now = time.Now
+	}
+	return reporter, nil
+}
+
+// GetEffectiveAccess returns the access of the identity with the specified IAM ID:
+//
+//  1. The access groups of the identity are listed, whether it was added to them explicitly
+//     (static membership) or matches one of their dynamic rules (dynamic membership).
+//  2. The active access policies of the identity and of each of its access groups are listed.
+//  3. The roles of the services named by the policies are listed, to resolve the name and
+//     actions of each role granted by the policies.
+func (reporter *Reporter) GetEffectiveAccess(ctx context.Context, iamID string) (*Report, error) {
+	if iamID == "" {
+		return nil, fmt.Errorf("an IAM ID is required")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	report := &Report{
+		AccountID:    reporter.options.AccountID,
+		IamID:        iamID,
+		IdentityType: GetIdentityType(iamID),
+		CreatedAt:    reporter.options.Now().UTC(),
+		AccessGroups: []AccessGroup{},
+		Entries:      []*Entry{},
+	}
+
+	groups, err := reporter.listAccessGroups(ctx, iamID)
+	if err != nil {
+		return nil, err
+	}
+	report.AccessGroups = groups
+
+	// The policies of the identity are listed first, then those of each access group.
+	sources := make([]AccessGroup, len(groups)+1)
+	copy(sources[1:], groups)
+	policies := make([][]iampolicymanagementv1.V2PolicyTemplateMetaData, len(sources))
+	err = forEach(ctx, reporter.options.Concurrency, len(sources), func(i int) (err error) {
+		policies[i], err = reporter.listPolicies(ctx, iamID, sources[i].ID)
+		if err != nil {
+			if sources[i].ID == "" {
+				return fmt.Errorf("error listing the policies of '%s': %w", iamID, err)
+			}
+			return fmt.Errorf("error listing the policies of access group '%s': %w", sources[i].ID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := listRoles(ctx, reporter.options.IamPolicyManagement, reporter.options.AccountID,
+		reporter.options.Concurrency, policies)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[string]*Entry)
+	seen := make(map[string]bool)
+	for i := range sources {
+		for j := range policies[i] {
+			policy := &policies[i][j]
+			policyID := core.StringNilMapper(policy.ID)
+			if seen[policyID] {
+				continue
+			}
+			seen[policyID] = true
+			source := Source{PolicyID: policyID, AccessGroupID: sources[i].ID, AccessGroupName: sources[i].Name}
+			if err = addPolicyEntries(report, entries, roles, policy, source); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	sort.SliceStable(report.Entries, func(i, j int) bool {
+		resource1, resource2 := report.Entries[i].ResourceString(), report.Entries[j].ResourceString()
+		if resource1 != resource2 {
+			return resource1 < resource2
+		}
+		return report.Entries[i].Role.Name < report.Entries[j].Role.Name
+	})
+	sort.Strings(report.UnresolvedRoles)
+	return report, nil
+}
+
+// forEach calls "f" for each index in [0, count), using up to "concurrency" goroutines, and
+// returns the first error returned by "f".
+func forEach(ctx context.Context, concurrency int, count int, f func(i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var firstErr error
+	for n := 0; n < concurrency && n < count; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				if err := f(i); err != nil {
+					mutex.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		if ctx.Err() != nil {
+			break
+		}
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+	if firstErr == nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return firstErr
+}
+
+// listAccessGroups returns the access groups that the identity is a member of, sorted by name.
+func (reporter *Reporter) listAccessGroups(ctx context.Context, iamID string) ([]AccessGroup, error) {
+	iamAccessGroups := reporter.options.IamAccessGroups
+	pager, err := iamAccessGroups.NewAccessGroupsPager(iamAccessGroups.NewListAccessGroupsOptions(reporter.options.AccountID).
+		SetIamID(iamID).SetMembershipType("all"))
+	if err != nil {
+		return nil, err
+	}
+	groups, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the access groups of '%s': %w", iamID, err)
+	}
+	result := make([]AccessGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, AccessGroup{ID: core.StringNilMapper(group.ID), Name: core.StringNilMapper(group.Name)})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// listPolicies returns the active access policies of the access group "accessGroupID", or of the
+// identity if "accessGroupID" is "".
+func (reporter *Reporter) listPolicies(ctx context.Context, iamID string, accessGroupID string) ([]iampolicymanagementv1.V2PolicyTemplateMetaData, error) {
+	iamPolicyManagement := reporter.options.IamPolicyManagement
+	options := iamPolicyManagement.NewListV2PoliciesOptions(reporter.options.AccountID).
+		SetType(iampolicymanagementv1.ListV2PoliciesOptionsTypeAccessConst).
+		SetState(iampolicymanagementv1.ListV2PoliciesOptionsStateActiveConst)
+	if accessGroupID != "" {
+		options.SetAccessGroupID(accessGroupID)
+	} else {
+		options.SetIamID(iamID)
+	}
+	pager, err := iamPolicyManagement.NewV2PoliciesPager(options)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
+
+// listRoles returns the roles of the account and of the services named by the policies,
+// indexed by CRN.
+func listRoles(ctx context.Context, iamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1, accountID string,
+	concurrency int, policies [][]iampolicymanagementv1.V2PolicyTemplateMetaData) (map[string]Role, error) {
+	serviceNames := map[string]bool{"": true}
+	for _, list := range policies {
+		for i := range list {
+			if list[i].Resource == nil {
+				continue
+			}
+			for _, attribute := range list[i].Resource.Attributes {
+				if core.StringNilMapper(attribute.Key) == "serviceName" &&
+					core.StringNilMapper(attribute.Operator) == iampolicymanagementv1.V2PolicyResourceAttributeOperatorStringequalsConst {
+					serviceNames[fmt.Sprint(attribute.Value)] = true
+				}
+			}
+		}
+	}
+	names := make([]string, 0, len(serviceNames))
+	for name := range serviceNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	collections := make([]*iampolicymanagementv1.RoleCollection, len(names))
+	err := forEach(ctx, concurrency, len(names), func(i int) (err error) {
+		options := iamPolicyManagement.NewListRolesOptions().SetAccountID(accountID)
+		if names[i] != "" {
+			options.SetServiceName(names[i])
+		}
+		collections[i], _, err = iamPolicyManagement.ListRolesWithContext(ctx, options)
+		if err != nil {
+			return fmt.Errorf("error listing the roles of service '%s': %w", names[i], err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make(map[string]Role)
+	add := func(crn *string, displayName *string, actions []string, custom bool) {
+		role := Role{
+			CRN:     core.StringNilMapper(crn),
+			Name:    core.StringNilMapper(displayName),
+			Actions: append([]string{}, actions...),
+			Custom:  custom,
+		}
+		sort.Strings(role.Actions)
+		roles[role.CRN] = role
+	}
+	for _, collection := range collections {
+		for _, role := range collection.SystemRoles {
+			add(role.CRN, role.DisplayName, role.Actions, false)
+		}
+		for _, role := range collection.ServiceRoles {
+			add(role.CRN, role.DisplayName, role.Actions, false)
+		}
+		for _, role := range collection.CustomRoles {
+			add(role.CRN, role.DisplayName, role.Actions, true)
+		}
+	}
+	return roles, nil
+}
+
+// addPolicyEntries adds an entry to the report for each role granted by "policy", or adds
+// "source" to the existing entry that grants the same role on the same resource under the same
+// conditions.
+func addPolicyEntries(report *Report, entries map[string]*Entry, roles map[string]Role, policy *iampolicymanagementv1.V2PolicyTemplateMetaData, source Source) error {
+	entry := Entry{Pattern: core.StringNilMapper(policy.Pattern)}
+	entry.Resource, entry.ResourceTags = getPolicyResource(policy)
+	rule, err := getPolicyRule(policy)
+	if err != nil {
+		return fmt.Errorf("error reading the rule of policy '%s': %w", source.PolicyID, err)
+	}
+	entry.Rule = rule
+
+	for _, roleCRN := range getRoleCRNs(policy) {
+		role, found := roles[roleCRN]
+		if !found {
+			role = Role{CRN: roleCRN, Name: roleCRN[strings.LastIndex(roleCRN, ":")+1:]}
+			if !containsString(report.UnresolvedRoles, roleCRN) {
+				report.UnresolvedRoles = append(report.UnresolvedRoles, roleCRN)
+			}
+		}
+		key := entry.ResourceString() + "|" + roleCRN
+		if entry.Rule != nil {
+			key += "|" + entry.Rule.String()
+		}
+		if existing, found := entries[key]; found {
+			existing.Sources = append(existing.Sources, source)
+			continue
+		}
+		roleEntry := entry
+		roleEntry.Role = role
+		roleEntry.Sources = []Source{source}
+		entries[key] = &roleEntry
+		report.Entries = append(report.Entries, &roleEntry)
+	}
+	return nil
+}
+
+// getPolicyResource returns the attributes and tags of the resource of "policy".
+func getPolicyResource(policy *iampolicymanagementv1.V2PolicyTemplateMetaData) (attributes []Attribute, tags []Attribute) {
+	if policy.Resource == nil {
+		return
+	}
+	for _, attribute := range policy.Resource.Attributes {
+		attributes = append(attributes, Attribute{
+			Key:      core.StringNilMapper(attribute.Key),
+			Operator: core.StringNilMapper(attribute.Operator),
+			Value:    attribute.Value,
+		})
+	}
+	for _, tag := range policy.Resource.Tags {
+		tags = append(tags, Attribute{
+			Key:      core.StringNilMapper(tag.Key),
+			Operator: core.StringNilMapper(tag.Operator),
+			Value:    core.StringNilMapper(tag.Value),
+		})
+	}
+	return
+}
+
+// getPolicyRule returns the rule of "policy", or nil if the policy has no rule.
+func getPolicyRule(policy *iampolicymanagementv1.V2PolicyTemplateMetaData) (*Condition, error) {
+	if policy.Rule == nil {
+		return nil, nil
+	}
+	// The rule models are converted through their JSON form, which is common to all of them.
+	buffer, err := json.Marshal(policy.Rule)
+	if err != nil {
+		return nil, err
+	}
+	rule := &Condition{}
+	if err = json.Unmarshal(buffer, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// getRoleCRNs returns the CRNs of the roles granted by "policy".
+func getRoleCRNs(policy *iampolicymanagementv1.V2PolicyTemplateMetaData) []string {
+	var crns []string
+	switch control := policy.Control.(type) {
+	case *iampolicymanagementv1.ControlResponse:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				crns = append(crns, core.StringNilMapper(role.RoleID))
+			}
+		}
+	case *iampolicymanagementv1.ControlResponseControl:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				crns = append(crns, core.StringNilMapper(role.RoleID))
+			}
+		}
+	case *iampolicymanagementv1.ControlResponseControlWithEnrichedRoles:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				crns = append(crns, core.StringNilMapper(role.RoleID))
+			}
+		}
+	}
+	return crns
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
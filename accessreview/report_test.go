@@ -0,0 +1,281 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package accessreview_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/accessreview"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	userID     = "IBMid-123"
+	viewerRole = "crn:v1:bluemix:public:iam::::role:Viewer"
+	writerRole = "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+)
+
+var reportTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+type fixture struct {
+	server              *platformfake.Server
+	iamAccessGroups     *iamaccessgroupsv2.IamAccessGroupsV2
+	iamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+	iamIdentity         *iamidentityv1.IamIdentityV1
+}
+
+func newFixture(t *testing.T) *fixture {
+	server := platformfake.NewServer(&platformfake.ServerOptions{Now: func() time.Time { return reportTime }})
+	t.Cleanup(server.Close)
+	server.RegisterServiceRole("cloud-object-storage", iampolicymanagementv1.Role{
+		DisplayName: core.StringPtr("Writer"),
+		Actions:     []string{"cloud-object-storage.object.put", "cloud-object-storage.object.get"},
+		CRN:         core.StringPtr(writerRole),
+	})
+
+	f := &fixture{server: server}
+	var err error
+	f.iamAccessGroups, err = iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	f.iamPolicyManagement, err = iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	f.iamIdentity, err = iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return f
+}
+
+func (f *fixture) createAccessGroup(t *testing.T, name string, members ...string) string {
+	group, _, err := f.iamAccessGroups.CreateAccessGroup(f.iamAccessGroups.NewCreateAccessGroupOptions(f.server.AccountID(), name))
+	require.Nil(t, err)
+	if len(members) > 0 {
+		options := f.iamAccessGroups.NewAddMembersToAccessGroupOptions(*group.ID)
+		for _, member := range members {
+			options.Members = append(options.Members, iamaccessgroupsv2.AddGroupMembersRequestMembersItem{
+				IamID: core.StringPtr(member),
+				Type:  core.StringPtr("user"),
+			})
+		}
+		_, _, err = f.iamAccessGroups.AddMembersToAccessGroup(options)
+		require.Nil(t, err)
+	}
+	return *group.ID
+}
+
+func (f *fixture) createPolicy(t *testing.T, subjectKey string, subjectValue string, serviceName string, rule iampolicymanagementv1.V2PolicyRuleIntf, roles ...string) string {
+	attributes := []iampolicymanagementv1.V2PolicyResourceAttribute{
+		{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: serviceName},
+	}
+	return f.createPolicyWithResource(t, subjectKey, subjectValue, attributes, rule, roles...)
+}
+
+// createPolicyWithResource creates a policy on the resource of the account with the specified attributes.
+func (f *fixture) createPolicyWithResource(t *testing.T, subjectKey string, subjectValue string, attributes []iampolicymanagementv1.V2PolicyResourceAttribute, rule iampolicymanagementv1.V2PolicyRuleIntf, roles ...string) string {
+	control := &iampolicymanagementv1.Control{Grant: &iampolicymanagementv1.Grant{}}
+	for _, role := range roles {
+		control.Grant.Roles = append(control.Grant.Roles, iampolicymanagementv1.Roles{RoleID: core.StringPtr(role)})
+	}
+	options := f.iamPolicyManagement.NewCreateV2PolicyOptions(control, "access")
+	options.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+			{Key: core.StringPtr(subjectKey), Operator: core.StringPtr("stringEquals"), Value: subjectValue},
+		},
+	})
+	options.SetResource(&iampolicymanagementv1.V2PolicyResource{
+		Attributes: append([]iampolicymanagementv1.V2PolicyResourceAttribute{
+			{Key: core.StringPtr("accountId"), Operator: core.StringPtr("stringEquals"), Value: f.server.AccountID()},
+		}, attributes...),
+	})
+	if rule != nil {
+		options.SetRule(rule)
+		options.SetPattern(iampolicymanagementv1.PolicyPatternTimeBasedOnce)
+	}
+	policy, _, err := f.iamPolicyManagement.CreateV2Policy(options)
+	require.Nil(t, err)
+	return *policy.ID
+}
+
+func (f *fixture) newReporter(t *testing.T) *accessreview.Reporter {
+	reporter, err := accessreview.NewReporter(&accessreview.ReporterOptions{
+		IamAccessGroups:     f.iamAccessGroups,
+		IamPolicyManagement: f.iamPolicyManagement,
+		AccountID:           f.server.AccountID(),
+		Now:                 func() time.Time { return reportTime },
+	})
+	require.Nil(t, err)
+	return reporter
+}
+
+func TestGetEffectiveAccess(t *testing.T) {
+	f := newFixture(t)
+	developers := f.createAccessGroup(t, "developers", userID, "IBMid-456")
+	auditors := f.createAccessGroup(t, "auditors", userID)
+	others := f.createAccessGroup(t, "others", "IBMid-456")
+
+	userPolicy := f.createPolicy(t, "iam_id", userID, "cloud-object-storage", nil, viewerRole)
+	developersPolicy := f.createPolicy(t, "access_group_id", developers, "cloud-object-storage", nil, viewerRole, writerRole)
+	rule, err := iampolicymanagementv1.Rule().
+		And(iampolicymanagementv1.DateTimeBetween(reportTime, reportTime.AddDate(0, 1, 0))).
+		Build()
+	require.Nil(t, err)
+	keyPurge := "crn:v1:bluemix:public:kms::::serviceRole:KeyPurge"
+	auditorsPolicy := f.createPolicy(t, "access_group_id", auditors, "kms", rule, viewerRole, keyPurge)
+	f.createPolicy(t, "access_group_id", others, "kms", nil, viewerRole)
+	f.createPolicy(t, "iam_id", "IBMid-456", "kms", nil, viewerRole)
+
+	report, err := f.newReporter(t).GetEffectiveAccess(context.Background(), userID)
+	require.Nil(t, err)
+	assert.Equal(t, f.server.AccountID(), report.AccountID)
+	assert.Equal(t, accessreview.IdentityTypeUser, report.IdentityType)
+	assert.Equal(t, reportTime, report.CreatedAt)
+	assert.Equal(t, []accessreview.AccessGroup{{ID: auditors, Name: "auditors"}, {ID: developers, Name: "developers"}}, report.AccessGroups)
+	assert.Equal(t, []string{keyPurge}, report.UnresolvedRoles)
+	assert.Equal(t, "user 'IBMid-123' has 4 role assignments (2 access groups)", report.String())
+
+	require.Len(t, report.Entries, 4)
+	cosViewer := report.Entries[0]
+	assert.Equal(t, "cloud-object-storage", cosViewer.GetService())
+	assert.Equal(t, "accountId="+f.server.AccountID()+", serviceName=cloud-object-storage", cosViewer.ResourceString())
+	assert.Equal(t, "Viewer", cosViewer.Role.Name)
+	assert.NotEmpty(t, cosViewer.Role.Actions)
+	assert.Nil(t, cosViewer.Rule)
+	assert.Equal(t, []accessreview.Source{
+		{PolicyID: userPolicy},
+		{PolicyID: developersPolicy, AccessGroupID: developers, AccessGroupName: "developers"},
+	}, cosViewer.Sources)
+
+	cosWriter := report.Entries[1]
+	assert.Equal(t, "Writer", cosWriter.Role.Name)
+	assert.Equal(t, []string{"cloud-object-storage.object.get", "cloud-object-storage.object.put"}, cosWriter.Role.Actions)
+
+	kmsKeyPurge := report.Entries[2]
+	assert.Equal(t, "KeyPurge", kmsKeyPurge.Role.Name)
+	assert.Empty(t, kmsKeyPurge.Role.Actions)
+	kmsViewer := report.Entries[3]
+	assert.Equal(t, "Viewer", kmsViewer.Role.Name)
+	assert.Equal(t, iampolicymanagementv1.PolicyPatternTimeBasedOnce, kmsViewer.Pattern)
+	require.NotNil(t, kmsViewer.Rule)
+	assert.Equal(t, "({{environment.attributes.current_date_time}} dateTimeGreaterThanOrEquals 2024-05-01T12:00:00+00:00 and "+
+		"{{environment.attributes.current_date_time}} dateTimeLessThanOrEquals 2024-06-01T12:00:00+00:00)", kmsViewer.Rule.String())
+	assert.Equal(t, []accessreview.Source{{PolicyID: auditorsPolicy, AccessGroupID: auditors, AccessGroupName: "auditors"}}, kmsViewer.Sources)
+
+	// An identity without access groups or policies has an empty report.
+	report, err = f.newReporter(t).GetEffectiveAccess(context.Background(), "iam-ServiceId-1")
+	require.Nil(t, err)
+	assert.Equal(t, accessreview.IdentityTypeServiceID, report.IdentityType)
+	assert.Empty(t, report.AccessGroups)
+	assert.Empty(t, report.Entries)
+}
+
+func TestGetEffectiveAccessDynamicMembership(t *testing.T) {
+	f := newFixture(t)
+	profileID := "iam-Profile-123"
+	federated := f.createAccessGroup(t, "federated")
+	require.True(t, f.server.AddDynamicAccessGroupMember(federated, profileID, "profile"))
+	federatedPolicy := f.createPolicy(t, "access_group_id", federated, "cloud-object-storage", nil, writerRole)
+
+	// Access granted through a dynamic rule of an access group is included.
+	report, err := f.newReporter(t).GetEffectiveAccess(context.Background(), profileID)
+	require.Nil(t, err)
+	assert.Equal(t, []accessreview.AccessGroup{{ID: federated, Name: "federated"}}, report.AccessGroups)
+	require.Len(t, report.Entries, 1)
+	assert.Equal(t, "Writer", report.Entries[0].Role.Name)
+	assert.Equal(t, []accessreview.Source{
+		{PolicyID: federatedPolicy, AccessGroupID: federated, AccessGroupName: "federated"},
+	}, report.Entries[0].Sources)
+}
+
+func TestGetEffectiveAccessErrors(t *testing.T) {
+	f := newFixture(t)
+	_, err := accessreview.NewReporter(&accessreview.ReporterOptions{IamPolicyManagement: f.iamPolicyManagement})
+	assert.NotNil(t, err)
+	_, err = accessreview.NewReporter(&accessreview.ReporterOptions{
+		IamAccessGroups:     f.iamAccessGroups,
+		IamPolicyManagement: f.iamPolicyManagement,
+	})
+	assert.NotNil(t, err)
+
+	reporter := f.newReporter(t)
+	_, err = reporter.GetEffectiveAccess(context.Background(), "")
+	assert.NotNil(t, err)
+
+	group := f.createAccessGroup(t, "developers", userID)
+	f.createPolicy(t, "access_group_id", group, "kms", nil, viewerRole)
+	f.server.Fail(http.MethodGet, "/v2/policies", http.StatusForbidden, 2)
+	_, err = reporter.GetEffectiveAccess(context.Background(), userID)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing the policies of")
+
+	f.server.Fail(http.MethodGet, "/v2/roles", http.StatusInternalServerError, 10)
+	_, err = reporter.GetEffectiveAccess(context.Background(), userID)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing the roles of service")
+}
+
+func TestReportFormats(t *testing.T) {
+	f := newFixture(t)
+	group := f.createAccessGroup(t, "developers", userID)
+	policyID := f.createPolicy(t, "access_group_id", group, "cloud-object-storage", nil, writerRole)
+	report, err := f.newReporter(t).GetEffectiveAccess(context.Background(), userID)
+	require.Nil(t, err)
+
+	var buffer bytes.Buffer
+	require.Nil(t, report.WriteJSON(&buffer))
+	decoded := &accessreview.Report{}
+	require.Nil(t, json.Unmarshal(buffer.Bytes(), decoded))
+	assert.Equal(t, report.IamID, decoded.IamID)
+	require.Len(t, decoded.Entries, 1)
+	assert.Equal(t, report.Entries[0].Role, decoded.Entries[0].Role)
+	assert.Equal(t, report.Entries[0].Sources, decoded.Entries[0].Sources)
+
+	buffer.Reset()
+	require.Nil(t, report.WriteCSV(&buffer))
+	rows, err := csv.NewReader(&buffer).ReadAll()
+	require.Nil(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []string{"iam_id", "service", "resource", "role", "role_crn", "actions", "conditions", "sources"}, rows[0])
+	assert.Equal(t, []string{
+		userID,
+		"cloud-object-storage",
+		"accountId=" + f.server.AccountID() + ", serviceName=cloud-object-storage",
+		"Writer",
+		writerRole,
+		"cloud-object-storage.object.get;cloud-object-storage.object.put",
+		"",
+		"policy " + policyID + " via access group 'developers'",
+	}, rows[1])
+}
@@ -279,7 +279,7 @@ func (atracker *AtrackerV2) CreateTargetWithContext(ctx context.Context, createT
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "CreateTarget", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_target", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -343,7 +343,7 @@ func (atracker *AtrackerV2) ListTargetsWithContext(ctx context.Context, listTarg
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "ListTargets", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_targets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -412,7 +412,7 @@ func (atracker *AtrackerV2) GetTargetWithContext(ctx context.Context, getTargetO
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "GetTarget", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_target", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -504,7 +504,7 @@ func (atracker *AtrackerV2) ReplaceTargetWithContext(ctx context.Context, replac
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "ReplaceTarget", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_target", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -573,7 +573,7 @@ func (atracker *AtrackerV2) DeleteTargetWithContext(ctx context.Context, deleteT
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "DeleteTarget", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_target", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -643,7 +643,7 @@ func (atracker *AtrackerV2) ValidateTargetWithContext(ctx context.Context, valid
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "ValidateTarget", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_target", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -722,7 +722,7 @@ func (atracker *AtrackerV2) CreateRouteWithContext(ctx context.Context, createRo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "CreateRoute", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_route", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -782,7 +782,7 @@ func (atracker *AtrackerV2) ListRoutesWithContext(ctx context.Context, listRoute
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "ListRoutes", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_routes", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -851,7 +851,7 @@ func (atracker *AtrackerV2) GetRouteWithContext(ctx context.Context, getRouteOpt
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "GetRoute", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_route", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -934,7 +934,7 @@ func (atracker *AtrackerV2) ReplaceRouteWithContext(ctx context.Context, replace
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "ReplaceRoute", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_route", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1001,7 +1001,7 @@ func (atracker *AtrackerV2) DeleteRouteWithContext(ctx context.Context, deleteRo
 		return
 	}
 
-	response, err = atracker.Service.Request(request, nil)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "DeleteRoute", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_route", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1053,7 +1053,7 @@ func (atracker *AtrackerV2) GetSettingsWithContext(ctx context.Context, getSetti
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "GetSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_settings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1141,7 +1141,7 @@ func (atracker *AtrackerV2) PutSettingsWithContext(ctx context.Context, putSetti
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = atracker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(atracker.Service, "atracker", "V2", "PutSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "put_settings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
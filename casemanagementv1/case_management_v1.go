@@ -219,7 +219,7 @@ func (caseManagement *CaseManagementV1) GetCasesWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "GetCases", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -312,7 +312,7 @@ func (caseManagement *CaseManagementV1) CreateCaseWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "CreateCase", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -376,7 +376,7 @@ func (caseManagement *CaseManagementV1) GetCaseWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "GetCase", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -442,7 +442,7 @@ func (caseManagement *CaseManagementV1) UpdateCaseStatusWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "UpdateCaseStatus", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -512,7 +512,7 @@ func (caseManagement *CaseManagementV1) AddCommentWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "AddComment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -584,7 +584,7 @@ func (caseManagement *CaseManagementV1) AddWatchlistWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "AddWatchlist", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -655,7 +655,7 @@ func (caseManagement *CaseManagementV1) RemoveWatchlistWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "RemoveWatchlist", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -735,7 +735,7 @@ func (caseManagement *CaseManagementV1) AddResourceWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "AddResource", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -800,7 +800,7 @@ func (caseManagement *CaseManagementV1) UploadFileWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "UploadFile", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -860,7 +860,7 @@ func (caseManagement *CaseManagementV1) DownloadFileWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = caseManagement.Service.Request(request, &result)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "DownloadFile", request, &result)
 
 	return
 }
@@ -911,7 +911,7 @@ func (caseManagement *CaseManagementV1) DeleteFileWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = caseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(caseManagement.Service, "case_management", "V1", "DeleteFile", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2552,3 +2552,28 @@ func (pager *GetCasesPager) GetNext() (page []Case, err error) {
 func (pager *GetCasesPager) GetAll() (allItems []Case, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetCasesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetCasesPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetCasesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetCasesPager returns a new GetCasesPager instance positioned at the checkpoint
+// returned by GetCasesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (caseManagement *CaseManagementV1) ResumeGetCasesPager(options *GetCasesOptions, checkpoint string) (pager *GetCasesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("GetCasesPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = caseManagement.NewGetCasesPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
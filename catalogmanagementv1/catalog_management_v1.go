@@ -216,7 +216,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetCatalogAccount", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -305,7 +305,7 @@ func (catalogManagement *CatalogManagementV1) UpdateCatalogAccountWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "UpdateCatalogAccount", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -375,7 +375,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogAccountAuditsWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListCatalogAccountAudits", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalog_account_audits", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -448,7 +448,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountAuditWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetCatalogAccountAudit", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_account_audit", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -512,7 +512,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAccountFiltersWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetCatalogAccountFilters", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_account_filters", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -588,7 +588,7 @@ func (catalogManagement *CatalogManagementV1) GetShareApprovalListWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetShareApprovalList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_share_approval_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -664,7 +664,7 @@ func (catalogManagement *CatalogManagementV1) DeleteShareApprovalListWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteShareApprovalList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_share_approval_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -740,7 +740,7 @@ func (catalogManagement *CatalogManagementV1) AddShareApprovalListWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "AddShareApprovalList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_share_approval_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -821,7 +821,7 @@ func (catalogManagement *CatalogManagementV1) GetShareApprovalListAsSourceWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetShareApprovalListAsSource", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_share_approval_list_as_source", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -903,7 +903,7 @@ func (catalogManagement *CatalogManagementV1) UpdateShareApprovalListAsSourceWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "UpdateShareApprovalListAsSource", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_share_approval_list_as_source", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -964,7 +964,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogsWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListCatalogs", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalogs", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1077,7 +1077,7 @@ func (catalogManagement *CatalogManagementV1) CreateCatalogWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CreateCatalog", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1146,7 +1146,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetCatalog", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1274,7 +1274,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceCatalogWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ReplaceCatalog", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_catalog", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1341,7 +1341,7 @@ func (catalogManagement *CatalogManagementV1) DeleteCatalogWithContext(ctx conte
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteCatalog", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1412,7 +1412,7 @@ func (catalogManagement *CatalogManagementV1) ListCatalogAuditsWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListCatalogAudits", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_catalog_audits", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1486,7 +1486,7 @@ func (catalogManagement *CatalogManagementV1) GetCatalogAuditWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetCatalogAudit", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_audit", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1565,7 +1565,7 @@ func (catalogManagement *CatalogManagementV1) ListEnterpriseAuditsWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListEnterpriseAudits", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_enterprise_audits", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1639,7 +1639,7 @@ func (catalogManagement *CatalogManagementV1) GetEnterpriseAuditWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetEnterpriseAudit", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_enterprise_audit", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1720,7 +1720,7 @@ func (catalogManagement *CatalogManagementV1) GetConsumptionOfferingsWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetConsumptionOfferings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_consumption_offerings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1809,7 +1809,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingsWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListOfferings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_offerings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2018,7 +2018,7 @@ func (catalogManagement *CatalogManagementV1) CreateOfferingWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CreateOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2163,7 +2163,7 @@ func (catalogManagement *CatalogManagementV1) ImportOfferingVersionWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ImportOfferingVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_offering_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2310,7 +2310,7 @@ func (catalogManagement *CatalogManagementV1) ImportOfferingWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ImportOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2417,7 +2417,7 @@ func (catalogManagement *CatalogManagementV1) ReloadOfferingWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ReloadOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "reload_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2494,7 +2494,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2710,7 +2710,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceOfferingWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ReplaceOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2790,7 +2790,7 @@ func (catalogManagement *CatalogManagementV1) UpdateOfferingWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "UpdateOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2861,7 +2861,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingWithContext(ctx cont
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteOffering", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2923,7 +2923,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingStatsWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingStats", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_stats", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3003,7 +3003,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingAuditsWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListOfferingAudits", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_offering_audits", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3078,7 +3078,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAuditWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingAudit", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_audit", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3165,7 +3165,7 @@ func (catalogManagement *CatalogManagementV1) SetOfferingPublishWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "SetOfferingPublish", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_offering_publish", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3248,7 +3248,7 @@ func (catalogManagement *CatalogManagementV1) DeprecateOfferingWithContext(ctx c
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeprecateOffering", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deprecate_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3327,7 +3327,7 @@ func (catalogManagement *CatalogManagementV1) ShareOfferingWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ShareOffering", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "share_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3398,7 +3398,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAccessWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingAccess", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_access", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3475,7 +3475,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAccessListWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingAccessList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_access_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3552,7 +3552,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingAccessListWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteOfferingAccessList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_offering_access_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3629,7 +3629,7 @@ func (catalogManagement *CatalogManagementV1) AddOfferingAccessListWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "AddOfferingAccessList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_offering_access_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3740,7 +3740,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingUpdatesWithContext(ctx
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingUpdates", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_updates", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3824,7 +3824,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingChangeNoticesWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingChangeNotices", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_change_notices", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3917,7 +3917,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceWithContext(ctx c
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, &result)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingSource", request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_source", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4004,7 +4004,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceArchiveWithContex
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, &result)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingSourceArchive", request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_source_archive", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4077,7 +4077,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingSourceURLWithContext(ct
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, &result)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingSourceURL", request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_source_url", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4154,7 +4154,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetVersions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4222,7 +4222,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingAboutWithContext(ctx co
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, &result)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingAbout", request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_about", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4283,7 +4283,7 @@ func (catalogManagement *CatalogManagementV1) GetIamPermissionsWithContext(ctx c
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetIamPermissions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_iam_permissions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4352,7 +4352,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingLicenseWithContext(ctx
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, &result)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingLicense", request, &result)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_license", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4414,7 +4414,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingContainerImagesWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingContainerImages", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_container_images", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4481,7 +4481,7 @@ func (catalogManagement *CatalogManagementV1) ArchiveVersionWithContext(ctx cont
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ArchiveVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "archive_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4555,7 +4555,7 @@ func (catalogManagement *CatalogManagementV1) SetDeprecateVersionWithContext(ctx
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "SetDeprecateVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_deprecate_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4614,7 +4614,7 @@ func (catalogManagement *CatalogManagementV1) ConsumableVersionWithContext(ctx c
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ConsumableVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "consumable_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4673,7 +4673,7 @@ func (catalogManagement *CatalogManagementV1) PrereleaseVersionWithContext(ctx c
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "PrereleaseVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "prerelease_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4732,7 +4732,7 @@ func (catalogManagement *CatalogManagementV1) SuspendVersionWithContext(ctx cont
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "SuspendVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "suspend_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4791,7 +4791,7 @@ func (catalogManagement *CatalogManagementV1) CommitVersionWithContext(ctx conte
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CommitVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4879,7 +4879,7 @@ func (catalogManagement *CatalogManagementV1) CopyVersionWithContext(ctx context
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CopyVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "copy_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4940,7 +4940,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingWorkingCopyWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingWorkingCopy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_working_copy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5009,7 +5009,7 @@ func (catalogManagement *CatalogManagementV1) CopyFromPreviousVersionWithContext
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CopyFromPreviousVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "copy_from_previous_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5084,7 +5084,7 @@ func (catalogManagement *CatalogManagementV1) ValidateInputsWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ValidateInputs", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_inputs", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5153,7 +5153,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5369,7 +5369,7 @@ func (catalogManagement *CatalogManagementV1) UpdateVersionWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "UpdateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5449,7 +5449,7 @@ func (catalogManagement *CatalogManagementV1) PatchUpdateVersionWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "PatchUpdateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "patch_update_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5517,7 +5517,7 @@ func (catalogManagement *CatalogManagementV1) DeleteVersionWithContext(ctx conte
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5578,7 +5578,7 @@ func (catalogManagement *CatalogManagementV1) GetVersionDependenciesWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetVersionDependencies", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_version_dependencies", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5648,7 +5648,7 @@ func (catalogManagement *CatalogManagementV1) DeprecateVersionWithContext(ctx co
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeprecateVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deprecate_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5714,7 +5714,7 @@ func (catalogManagement *CatalogManagementV1) GetClusterWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetCluster", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_cluster", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5794,7 +5794,7 @@ func (catalogManagement *CatalogManagementV1) GetNamespacesWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetNamespaces", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_namespaces", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5891,7 +5891,7 @@ func (catalogManagement *CatalogManagementV1) DeployOperatorsWithContext(ctx con
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeployOperators", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "deploy_operators", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5963,7 +5963,7 @@ func (catalogManagement *CatalogManagementV1) ListOperatorsWithContext(ctx conte
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListOperators", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_operators", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6060,7 +6060,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceOperatorsWithContext(ctx co
 	}
 
 	var rawResponse []json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ReplaceOperators", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_operators", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6130,7 +6130,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOperatorsWithContext(ctx con
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteOperators", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_operators", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6263,7 +6263,7 @@ func (catalogManagement *CatalogManagementV1) InstallVersionWithContext(ctx cont
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "InstallVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "install_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6396,7 +6396,7 @@ func (catalogManagement *CatalogManagementV1) PreinstallVersionWithContext(ctx c
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "PreinstallVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "preinstall_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6470,7 +6470,7 @@ func (catalogManagement *CatalogManagementV1) GetPreinstallWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetPreinstall", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_preinstall", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6615,7 +6615,7 @@ func (catalogManagement *CatalogManagementV1) ValidateInstallWithContext(ctx con
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ValidateInstall", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_install", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6683,7 +6683,7 @@ func (catalogManagement *CatalogManagementV1) GetValidationStatusWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetValidationStatus", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_validation_status", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6766,7 +6766,7 @@ func (catalogManagement *CatalogManagementV1) SearchObjectsWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "SearchObjects", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "search_objects", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6848,7 +6848,7 @@ func (catalogManagement *CatalogManagementV1) ListObjectsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListObjects", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_objects", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -6976,7 +6976,7 @@ func (catalogManagement *CatalogManagementV1) CreateObjectWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CreateObject", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_object", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7046,7 +7046,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetObject", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7181,7 +7181,7 @@ func (catalogManagement *CatalogManagementV1) ReplaceObjectWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ReplaceObject", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_object", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7249,7 +7249,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectWithContext(ctx contex
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteObject", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_object", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7321,7 +7321,7 @@ func (catalogManagement *CatalogManagementV1) ListObjectAuditsWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListObjectAudits", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_object_audits", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7396,7 +7396,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAuditWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetObjectAudit", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_audit", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7464,7 +7464,7 @@ func (catalogManagement *CatalogManagementV1) ConsumableShareObjectWithContext(c
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ConsumableShareObject", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "consumable_share_object", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7543,7 +7543,7 @@ func (catalogManagement *CatalogManagementV1) ShareObjectWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ShareObject", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "share_object", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7620,7 +7620,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessListWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetObjectAccessList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_access_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7691,7 +7691,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetObjectAccess", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_access", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7763,7 +7763,7 @@ func (catalogManagement *CatalogManagementV1) CreateObjectAccessWithContext(ctx
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CreateObjectAccess", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_object_access", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7827,7 +7827,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectAccessWithContext(ctx
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteObjectAccess", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_object_access", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7899,7 +7899,7 @@ func (catalogManagement *CatalogManagementV1) GetObjectAccessListDeprecatedWithC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetObjectAccessListDeprecated", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_object_access_list_deprecated", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -7976,7 +7976,7 @@ func (catalogManagement *CatalogManagementV1) DeleteObjectAccessListWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteObjectAccessList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_object_access_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8053,7 +8053,7 @@ func (catalogManagement *CatalogManagementV1) AddObjectAccessListWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "AddObjectAccessList", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_object_access_list", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8222,7 +8222,7 @@ func (catalogManagement *CatalogManagementV1) CreateOfferingInstanceWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "CreateOfferingInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_offering_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8291,7 +8291,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingInstanceWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8464,7 +8464,7 @@ func (catalogManagement *CatalogManagementV1) PutOfferingInstanceWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "PutOfferingInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "put_offering_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8534,7 +8534,7 @@ func (catalogManagement *CatalogManagementV1) DeleteOfferingInstanceWithContext(
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeleteOfferingInstance", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_offering_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8605,7 +8605,7 @@ func (catalogManagement *CatalogManagementV1) ListOfferingInstanceAuditsWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListOfferingInstanceAudits", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_offering_instance_audits", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8679,7 +8679,7 @@ func (catalogManagement *CatalogManagementV1) GetOfferingInstanceAuditWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetOfferingInstanceAudit", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_offering_instance_audit", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8748,7 +8748,7 @@ func (catalogManagement *CatalogManagementV1) GetPlanWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "GetPlan", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8815,7 +8815,7 @@ func (catalogManagement *CatalogManagementV1) DeletePlanWithContext(ctx context.
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "DeletePlan", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8874,7 +8874,7 @@ func (catalogManagement *CatalogManagementV1) ConsumablePlanWithContext(ctx cont
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ConsumablePlan", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "consumable_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -8948,7 +8948,7 @@ func (catalogManagement *CatalogManagementV1) SetDeprecatePlanWithContext(ctx co
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "SetDeprecatePlan", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_deprecate_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -9013,7 +9013,7 @@ func (catalogManagement *CatalogManagementV1) PreviewRegionsWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "PreviewRegions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "preview_regions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -9086,7 +9086,7 @@ func (catalogManagement *CatalogManagementV1) ListRegionsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "ListRegions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_regions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -25792,6 +25792,33 @@ func (pager *CatalogAccountAuditsPager) GetAll() (allItems []AuditLogDigest, err
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeCatalogAccountAuditsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *CatalogAccountAuditsPager) GetCheckpoint() string {
+	return common.EncodePageToken("CatalogAccountAuditsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeCatalogAccountAuditsPager returns a new CatalogAccountAuditsPager instance positioned at the checkpoint
+// returned by CatalogAccountAuditsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeCatalogAccountAuditsPager(options *ListCatalogAccountAuditsOptions, checkpoint string) (pager *CatalogAccountAuditsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("CatalogAccountAuditsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewCatalogAccountAuditsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetShareApprovalListPager can be used to simplify the use of the "GetShareApprovalList" method.
 type GetShareApprovalListPager struct {
 	hasNext     bool
@@ -25877,6 +25904,33 @@ func (pager *GetShareApprovalListPager) GetAll() (allItems []ShareApprovalAccess
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetShareApprovalListPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetShareApprovalListPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetShareApprovalListPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetShareApprovalListPager returns a new GetShareApprovalListPager instance positioned at the checkpoint
+// returned by GetShareApprovalListPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetShareApprovalListPager(options *GetShareApprovalListOptions, checkpoint string) (pager *GetShareApprovalListPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetShareApprovalListPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetShareApprovalListPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetShareApprovalListAsSourcePager can be used to simplify the use of the "GetShareApprovalListAsSource" method.
 type GetShareApprovalListAsSourcePager struct {
 	hasNext     bool
@@ -25962,6 +26016,33 @@ func (pager *GetShareApprovalListAsSourcePager) GetAll() (allItems []ShareApprov
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetShareApprovalListAsSourcePager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetShareApprovalListAsSourcePager) GetCheckpoint() string {
+	return common.EncodePageToken("GetShareApprovalListAsSourcePager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetShareApprovalListAsSourcePager returns a new GetShareApprovalListAsSourcePager instance positioned at the checkpoint
+// returned by GetShareApprovalListAsSourcePager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetShareApprovalListAsSourcePager(options *GetShareApprovalListAsSourceOptions, checkpoint string) (pager *GetShareApprovalListAsSourcePager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetShareApprovalListAsSourcePager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetShareApprovalListAsSourcePager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // CatalogAuditsPager can be used to simplify the use of the "ListCatalogAudits" method.
 type CatalogAuditsPager struct {
 	hasNext     bool
@@ -26047,6 +26128,33 @@ func (pager *CatalogAuditsPager) GetAll() (allItems []AuditLogDigest, err error)
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeCatalogAuditsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *CatalogAuditsPager) GetCheckpoint() string {
+	return common.EncodePageToken("CatalogAuditsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeCatalogAuditsPager returns a new CatalogAuditsPager instance positioned at the checkpoint
+// returned by CatalogAuditsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeCatalogAuditsPager(options *ListCatalogAuditsOptions, checkpoint string) (pager *CatalogAuditsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("CatalogAuditsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewCatalogAuditsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // EnterpriseAuditsPager can be used to simplify the use of the "ListEnterpriseAudits" method.
 type EnterpriseAuditsPager struct {
 	hasNext     bool
@@ -26132,6 +26240,33 @@ func (pager *EnterpriseAuditsPager) GetAll() (allItems []AuditLogDigest, err err
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeEnterpriseAuditsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *EnterpriseAuditsPager) GetCheckpoint() string {
+	return common.EncodePageToken("EnterpriseAuditsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeEnterpriseAuditsPager returns a new EnterpriseAuditsPager instance positioned at the checkpoint
+// returned by EnterpriseAuditsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeEnterpriseAuditsPager(options *ListEnterpriseAuditsOptions, checkpoint string) (pager *EnterpriseAuditsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("EnterpriseAuditsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewEnterpriseAuditsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetConsumptionOfferingsPager can be used to simplify the use of the "GetConsumptionOfferings" method.
 type GetConsumptionOfferingsPager struct {
 	hasNext     bool
@@ -26224,6 +26359,33 @@ func (pager *GetConsumptionOfferingsPager) GetAll() (allItems []Offering, err er
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetConsumptionOfferingsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetConsumptionOfferingsPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetConsumptionOfferingsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetConsumptionOfferingsPager returns a new GetConsumptionOfferingsPager instance positioned at the checkpoint
+// returned by GetConsumptionOfferingsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetConsumptionOfferingsPager(options *GetConsumptionOfferingsOptions, checkpoint string) (pager *GetConsumptionOfferingsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("GetConsumptionOfferingsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetConsumptionOfferingsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // OfferingsPager can be used to simplify the use of the "ListOfferings" method.
 type OfferingsPager struct {
 	hasNext     bool
@@ -26316,6 +26478,33 @@ func (pager *OfferingsPager) GetAll() (allItems []Offering, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeOfferingsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *OfferingsPager) GetCheckpoint() string {
+	return common.EncodePageToken("OfferingsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeOfferingsPager returns a new OfferingsPager instance positioned at the checkpoint
+// returned by OfferingsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeOfferingsPager(options *ListOfferingsOptions, checkpoint string) (pager *OfferingsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("OfferingsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewOfferingsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // OfferingAuditsPager can be used to simplify the use of the "ListOfferingAudits" method.
 type OfferingAuditsPager struct {
 	hasNext     bool
@@ -26401,6 +26590,33 @@ func (pager *OfferingAuditsPager) GetAll() (allItems []AuditLogDigest, err error
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeOfferingAuditsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *OfferingAuditsPager) GetCheckpoint() string {
+	return common.EncodePageToken("OfferingAuditsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeOfferingAuditsPager returns a new OfferingAuditsPager instance positioned at the checkpoint
+// returned by OfferingAuditsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeOfferingAuditsPager(options *ListOfferingAuditsOptions, checkpoint string) (pager *OfferingAuditsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("OfferingAuditsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewOfferingAuditsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetOfferingAccessListPager can be used to simplify the use of the "GetOfferingAccessList" method.
 type GetOfferingAccessListPager struct {
 	hasNext     bool
@@ -26486,6 +26702,33 @@ func (pager *GetOfferingAccessListPager) GetAll() (allItems []Access, err error)
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetOfferingAccessListPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetOfferingAccessListPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetOfferingAccessListPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetOfferingAccessListPager returns a new GetOfferingAccessListPager instance positioned at the checkpoint
+// returned by GetOfferingAccessListPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetOfferingAccessListPager(options *GetOfferingAccessListOptions, checkpoint string) (pager *GetOfferingAccessListPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetOfferingAccessListPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetOfferingAccessListPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetVersionsPager can be used to simplify the use of the "GetVersions" method.
 type GetVersionsPager struct {
 	hasNext     bool
@@ -26571,6 +26814,33 @@ func (pager *GetVersionsPager) GetAll() (allItems []Version, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetVersionsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetVersionsPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetVersionsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetVersionsPager returns a new GetVersionsPager instance positioned at the checkpoint
+// returned by GetVersionsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetVersionsPager(options *GetVersionsOptions, checkpoint string) (pager *GetVersionsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetVersionsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetVersionsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetNamespacesPager can be used to simplify the use of the "GetNamespaces" method.
 type GetNamespacesPager struct {
 	hasNext     bool
@@ -26663,6 +26933,33 @@ func (pager *GetNamespacesPager) GetAll() (allItems []string, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetNamespacesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetNamespacesPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetNamespacesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetNamespacesPager returns a new GetNamespacesPager instance positioned at the checkpoint
+// returned by GetNamespacesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetNamespacesPager(options *GetNamespacesOptions, checkpoint string) (pager *GetNamespacesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("GetNamespacesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetNamespacesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // SearchObjectsPager can be used to simplify the use of the "SearchObjects" method.
 type SearchObjectsPager struct {
 	hasNext     bool
@@ -26755,6 +27052,33 @@ func (pager *SearchObjectsPager) GetAll() (allItems []CatalogObject, err error)
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeSearchObjectsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *SearchObjectsPager) GetCheckpoint() string {
+	return common.EncodePageToken("SearchObjectsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeSearchObjectsPager returns a new SearchObjectsPager instance positioned at the checkpoint
+// returned by SearchObjectsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeSearchObjectsPager(options *SearchObjectsOptions, checkpoint string) (pager *SearchObjectsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("SearchObjectsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewSearchObjectsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ObjectsPager can be used to simplify the use of the "ListObjects" method.
 type ObjectsPager struct {
 	hasNext     bool
@@ -26847,6 +27171,33 @@ func (pager *ObjectsPager) GetAll() (allItems []CatalogObject, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeObjectsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ObjectsPager) GetCheckpoint() string {
+	return common.EncodePageToken("ObjectsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeObjectsPager returns a new ObjectsPager instance positioned at the checkpoint
+// returned by ObjectsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeObjectsPager(options *ListObjectsOptions, checkpoint string) (pager *ObjectsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("ObjectsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewObjectsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ObjectAuditsPager can be used to simplify the use of the "ListObjectAudits" method.
 type ObjectAuditsPager struct {
 	hasNext     bool
@@ -26932,6 +27283,33 @@ func (pager *ObjectAuditsPager) GetAll() (allItems []AuditLogDigest, err error)
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeObjectAuditsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ObjectAuditsPager) GetCheckpoint() string {
+	return common.EncodePageToken("ObjectAuditsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeObjectAuditsPager returns a new ObjectAuditsPager instance positioned at the checkpoint
+// returned by ObjectAuditsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeObjectAuditsPager(options *ListObjectAuditsOptions, checkpoint string) (pager *ObjectAuditsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ObjectAuditsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewObjectAuditsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetObjectAccessListPager can be used to simplify the use of the "GetObjectAccessList" method.
 type GetObjectAccessListPager struct {
 	hasNext     bool
@@ -27017,6 +27395,33 @@ func (pager *GetObjectAccessListPager) GetAll() (allItems []Access, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetObjectAccessListPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetObjectAccessListPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetObjectAccessListPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetObjectAccessListPager returns a new GetObjectAccessListPager instance positioned at the checkpoint
+// returned by GetObjectAccessListPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetObjectAccessListPager(options *GetObjectAccessListOptions, checkpoint string) (pager *GetObjectAccessListPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetObjectAccessListPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetObjectAccessListPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // GetObjectAccessListDeprecatedPager can be used to simplify the use of the "GetObjectAccessListDeprecated" method.
 type GetObjectAccessListDeprecatedPager struct {
 	hasNext     bool
@@ -27109,6 +27514,33 @@ func (pager *GetObjectAccessListDeprecatedPager) GetAll() (allItems []Access, er
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetObjectAccessListDeprecatedPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetObjectAccessListDeprecatedPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetObjectAccessListDeprecatedPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetObjectAccessListDeprecatedPager returns a new GetObjectAccessListDeprecatedPager instance positioned at the checkpoint
+// returned by GetObjectAccessListDeprecatedPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeGetObjectAccessListDeprecatedPager(options *GetObjectAccessListDeprecatedOptions, checkpoint string) (pager *GetObjectAccessListDeprecatedPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("GetObjectAccessListDeprecatedPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewGetObjectAccessListDeprecatedPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // OfferingInstanceAuditsPager can be used to simplify the use of the "ListOfferingInstanceAudits" method.
 type OfferingInstanceAuditsPager struct {
 	hasNext     bool
@@ -27193,3 +27625,30 @@ func (pager *OfferingInstanceAuditsPager) GetAll() (allItems []AuditLogDigest, e
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeOfferingInstanceAuditsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *OfferingInstanceAuditsPager) GetCheckpoint() string {
+	return common.EncodePageToken("OfferingInstanceAuditsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeOfferingInstanceAuditsPager returns a new OfferingInstanceAuditsPager instance positioned at the checkpoint
+// returned by OfferingInstanceAuditsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (catalogManagement *CatalogManagementV1) ResumeOfferingInstanceAuditsPager(options *ListOfferingInstanceAuditsOptions, checkpoint string) (pager *OfferingInstanceAuditsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("OfferingInstanceAuditsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = catalogManagement.NewOfferingInstanceAuditsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
@@ -55,7 +55,7 @@ func (catalogManagement *CatalogManagementV1) AddPlanWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = catalogManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "addPlan", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "add_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -119,7 +119,7 @@ func (catalogManagement *CatalogManagementV1) SetValidatePlanWithContext(ctx con
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "setValidatePlan", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_allow_publish_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -174,7 +174,7 @@ func (catalogManagement *CatalogManagementV1) SetAllowPublishPlanWithContext(ctx
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "setAllowPublishPlan", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_allow_publish_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -230,7 +230,7 @@ func (catalogManagement *CatalogManagementV1) SetAllowPublishOfferingWithContext
 		return
 	}
 
-	response, err = catalogManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(catalogManagement.Service, "catalog_management", "V1", "setAllowPublishOffering", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_allow_publish_offering", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
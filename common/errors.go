@@ -0,0 +1,204 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Sentinel errors that classify the error responses returned by the platform services.
+// The errors returned by service methods for the corresponding status codes can be
+// compared with these values using errors.Is(), e.g.:
+//
+//	_, _, err := resourceController.GetResourceInstance(getOptions)
+//	if errors.Is(err, common.ErrNotFound) {
+//		...
+//	}
+var (
+	// ErrUnauthorized classifies "401 Unauthorized" responses.
+	ErrUnauthorized = errors.New("the request was not authenticated")
+
+	// ErrForbidden classifies "403 Forbidden" responses.
+	ErrForbidden = errors.New("the caller is not authorized to perform the operation")
+
+	// ErrNotFound classifies "404 Not Found" responses.
+	ErrNotFound = errors.New("the requested resource was not found")
+
+	// ErrConflict classifies "409 Conflict" responses.
+	ErrConflict = errors.New("the request conflicts with the current state of the resource")
+
+	// ErrPreconditionFailed classifies "412 Precondition Failed" responses, which are returned
+	// when the If-Match header of an update does not match the resource's current ETag.
+	ErrPreconditionFailed = errors.New("the resource was modified since it was retrieved")
+
+	// ErrRateLimited classifies "429 Too Many Requests" responses.
+	ErrRateLimited = errors.New("the request was rate limited")
+)
+
+var statusErrors = map[int]error{
+	http.StatusUnauthorized:       ErrUnauthorized,
+	http.StatusForbidden:          ErrForbidden,
+	http.StatusNotFound:           ErrNotFound,
+	http.StatusConflict:           ErrConflict,
+	http.StatusPreconditionFailed: ErrPreconditionFailed,
+	http.StatusTooManyRequests:    ErrRateLimited,
+}
+
+// apiProblem is the core.HTTPProblem returned by InvokeRequest() for an error response.
+// In addition to the usual comparison by problem ID, an apiProblem matches the sentinel
+// error that corresponds to its status code.
+type apiProblem struct {
+	*core.HTTPProblem
+}
+
+// Is reports whether "target" is the sentinel error for the problem's status code,
+// or is the same problem scenario as the underlying HTTPProblem.
+func (e *apiProblem) Is(target error) bool {
+	if sentinel, ok := statusErrors[e.Response.GetStatusCode()]; ok && target == sentinel {
+		return true
+	}
+	return e.HTTPProblem.Is(target)
+}
+
+// Unwrap returns the underlying HTTPProblem, so that it can be obtained with errors.As().
+func (e *apiProblem) Unwrap() []error {
+	return []error{e.HTTPProblem}
+}
+
+// newAPIProblem returns an error that describes the error response "response" and
+// can be classified with the sentinel errors, or "err" itself if there is no error response.
+func newAPIProblem(err error, response *core.DetailedResponse) error {
+	if err == nil || response == nil || response.StatusCode < 400 {
+		return err
+	}
+	var existing *apiProblem
+	if errors.As(err, &existing) {
+		return err
+	}
+
+	var httpProblem *core.HTTPProblem
+	if !errors.As(err, &httpProblem) {
+		httpProblem = &core.HTTPProblem{
+			IBMProblem: core.IBMErrorf(nil, core.NewProblemComponent("", ""), err.Error(), ""),
+			Response:   response,
+		}
+	}
+	return &apiProblem{httpProblem}
+}
+
+// IsNotFound returns true if "err" was caused by a "404 Not Found" response.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsConflict returns true if "err" was caused by a "409 Conflict" response.
+func IsConflict(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsPreconditionFailed returns true if "err" was caused by a "412 Precondition Failed" response.
+func IsPreconditionFailed(err error) bool {
+	return errors.Is(err, ErrPreconditionFailed)
+}
+
+// IsRateLimited returns true if "err" was caused by a "429 Too Many Requests" response.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// GetErrorStatusCode returns the status code of the error response that caused "err",
+// or 0 if "err" was not caused by an error response.
+func GetErrorStatusCode(err error) int {
+	if response := getErrorResponse(err); response != nil {
+		return response.StatusCode
+	}
+	return 0
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of the error response
+// that caused "err".
+// The header may contain either a number of seconds or an HTTP date.
+// The second return value is false if "err" was not caused by an error response or if
+// the response does not contain a valid Retry-After header.
+func RetryAfter(err error) (time.Duration, bool) {
+	response := getErrorResponse(err)
+	if response == nil || response.Headers == nil {
+		return 0, false
+	}
+	value := strings.TrimSpace(response.Headers.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+	if seconds, parseErr := strconv.ParseInt(value, 10, 64); parseErr == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, parseErr := http.ParseTime(value); parseErr == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+	return 0, false
+}
+
+// DecodeErrorResponse unmarshals the JSON body of the error response that caused "err"
+// into "result" using "unmarshaller", which is one of the generated "Unmarshal..." functions
+// of a service package.
+// This function is invoked by the error decoding helpers of each service package that
+// documents the structure of its error responses.
+// It returns false if "err" was not caused by an error response with a JSON body,
+// or if the body could not be unmarshalled.
+func DecodeErrorResponse(err error, result interface{}, unmarshaller core.ModelUnmarshaller) bool {
+	response := getErrorResponse(err)
+	if response == nil {
+		return false
+	}
+	body, ok := response.Result.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	b, marshalErr := json.Marshal(body)
+	if marshalErr != nil {
+		return false
+	}
+	var rawMap map[string]json.RawMessage
+	if json.Unmarshal(b, &rawMap) != nil {
+		return false
+	}
+	return core.UnmarshalModel(rawMap, "", result, unmarshaller) == nil
+}
+
+// getErrorResponse returns the error response carried by the HTTPProblem in the chain of "err".
+func getErrorResponse(err error) *core.DetailedResponse {
+	var httpProblem *core.HTTPProblem
+	if err == nil || !errors.As(err, &httpProblem) {
+		return nil
+	}
+	return httpProblem.Response
+}
@@ -0,0 +1,143 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// invokeWithStatus sends a request to a server that responds with "statusCode" and "body",
+// and returns the error in the same way as a generated service method.
+func invokeWithStatus(t *testing.T, statusCode int, headers map[string]string, body string) error {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		for name, value := range headers {
+			res.Header().Set(name, value)
+		}
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(statusCode)
+		fmt.Fprint(res, body)
+	}))
+	defer server.Close()
+
+	var result map[string]json.RawMessage
+	_, err := InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "GetThing",
+		newTestRequest(t, context.Background(), server.URL), &result)
+	require.NotNil(t, err)
+	core.EnrichHTTPProblem(err, "get_thing", core.NewProblemComponent("my_service", "1.0.0"))
+	return core.SDKErrorf(err, "", "http-request-err", GetComponentInfo())
+}
+
+func TestErrorClassification(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		sentinel   error
+		check      func(error) bool
+	}{
+		{http.StatusNotFound, ErrNotFound, IsNotFound},
+		{http.StatusConflict, ErrConflict, IsConflict},
+		{http.StatusPreconditionFailed, ErrPreconditionFailed, IsPreconditionFailed},
+		{http.StatusTooManyRequests, ErrRateLimited, IsRateLimited},
+		{http.StatusUnauthorized, ErrUnauthorized, nil},
+		{http.StatusForbidden, ErrForbidden, nil},
+	}
+	for _, tc := range testCases {
+		err := invokeWithStatus(t, tc.statusCode, nil, `{"errors": [{"code": "error", "message": "it failed"}]}`)
+		assert.True(t, errors.Is(err, tc.sentinel), "status code %d", tc.statusCode)
+		assert.Equal(t, tc.statusCode, GetErrorStatusCode(err))
+		if tc.check != nil {
+			assert.True(t, tc.check(err))
+		}
+		for _, other := range testCases {
+			if other.statusCode != tc.statusCode {
+				assert.False(t, errors.Is(err, other.sentinel))
+			}
+		}
+
+		// The error remains a problem with the enriched HTTPProblem in its chain.
+		var httpProblem *core.HTTPProblem
+		require.True(t, errors.As(err, &httpProblem))
+		assert.Equal(t, "get_thing", httpProblem.OperationID)
+		assert.Equal(t, "it failed", err.Error())
+		assert.True(t, errors.Is(err, err))
+	}
+
+	err := invokeWithStatus(t, http.StatusInternalServerError, nil, `{}`)
+	assert.False(t, IsNotFound(err))
+	assert.Equal(t, http.StatusInternalServerError, GetErrorStatusCode(err))
+
+	assert.False(t, IsNotFound(nil))
+	assert.False(t, IsNotFound(fmt.Errorf("not found")))
+	assert.Equal(t, 0, GetErrorStatusCode(fmt.Errorf("not found")))
+}
+
+func TestRetryAfter(t *testing.T) {
+	err := invokeWithStatus(t, http.StatusTooManyRequests, map[string]string{"Retry-After": "5"}, `{}`)
+	delay, ok := RetryAfter(err)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Second, delay)
+
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	err = invokeWithStatus(t, http.StatusServiceUnavailable, map[string]string{"Retry-After": date}, `{}`)
+	delay, ok = RetryAfter(err)
+	assert.True(t, ok)
+	assert.InDelta(t, float64(time.Minute), float64(delay), float64(2*time.Second))
+
+	for _, value := range []string{"", "soon", "-1"} {
+		err = invokeWithStatus(t, http.StatusTooManyRequests, map[string]string{"Retry-After": value}, `{}`)
+		_, ok = RetryAfter(err)
+		assert.False(t, ok, "Retry-After: %q", value)
+	}
+	_, ok = RetryAfter(fmt.Errorf("no response"))
+	assert.False(t, ok)
+}
+
+type testErrorBody struct {
+	Code *string
+}
+
+func unmarshalTestErrorBody(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(testErrorBody)
+	err = core.UnmarshalPrimitive(m, "code", &obj.Code)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+func TestDecodeErrorResponse(t *testing.T) {
+	err := invokeWithStatus(t, http.StatusBadRequest, nil, `{"code": "invalid_name"}`)
+	var body *testErrorBody
+	require.True(t, DecodeErrorResponse(err, &body, unmarshalTestErrorBody))
+	assert.Equal(t, "invalid_name", *body.Code)
+
+	err = invokeWithStatus(t, http.StatusBadRequest, nil, `{"code": 42}`)
+	assert.False(t, DecodeErrorResponse(err, &body, unmarshalTestErrorBody))
+	assert.False(t, DecodeErrorResponse(fmt.Errorf("no response"), &body, unmarshalTestErrorBody))
+}
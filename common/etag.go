@@ -0,0 +1,146 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const (
+	// DefaultUpdateAttempts is the default value of UpdateOptions.MaxAttempts.
+	DefaultUpdateAttempts = 5
+
+	// DefaultUpdateInitialBackoff is the default value of UpdateOptions.InitialBackoff.
+	DefaultUpdateInitialBackoff = 250 * time.Millisecond
+
+	// DefaultUpdateMaxBackoff is the default value of UpdateOptions.MaxBackoff.
+	DefaultUpdateMaxBackoff = 5 * time.Second
+)
+
+// ErrNoUpdate may be returned by a ResourceMutator to indicate that the resource does not
+// need to be updated. UpdateWithETag() then returns the retrieved resource without replacing it.
+var ErrNoUpdate = errors.New("the resource does not need to be updated")
+
+// UpdateOptions : The options that control the retries performed by UpdateWithETag().
+type UpdateOptions struct {
+	// The maximum number of get-mutate-replace attempts (defaults to DefaultUpdateAttempts).
+	MaxAttempts int
+
+	// The delay before the first retry (defaults to DefaultUpdateInitialBackoff).
+	// The delay is doubled after each subsequent attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+
+	// The maximum delay between attempts (defaults to DefaultUpdateMaxBackoff).
+	MaxBackoff time.Duration
+}
+
+// ResourceGetter retrieves the current state of a resource. The returned response must
+// contain the resource's ETag header.
+type ResourceGetter[T any] func(ctx context.Context) (resource T, response *core.DetailedResponse, err error)
+
+// ResourceMutator applies the desired changes to a resource that was retrieved by a ResourceGetter.
+// It may be invoked more than once during an update, each time with a freshly retrieved resource.
+type ResourceMutator[T any] func(resource T) error
+
+// ResourceReplacer replaces a resource with its mutated state, using "etag" as the value of
+// the If-Match header.
+type ResourceReplacer[T any] func(ctx context.Context, resource T, etag string) (result T, response *core.DetailedResponse, err error)
+
+// GetETag returns the value of the ETag header in "response", or "" if there is none.
+func GetETag(response *core.DetailedResponse) string {
+	if response == nil || response.Headers == nil {
+		return ""
+	}
+	return response.Headers.Get("ETag")
+}
+
+// UpdateWithETag performs an optimistic-concurrency (read-modify-write) update of a resource
+// whose updates are protected by an If-Match header:
+//
+//  1. The resource and its ETag are retrieved with "get".
+//  2. "mutate" applies the desired changes to the retrieved resource.
+//  3. "replace" sends the mutated resource with the retrieved ETag.
+//
+// If the replace fails with "412 Precondition Failed" because the resource was modified
+// after it was retrieved, the sequence is retried with exponential backoff as described by
+// "options" (which may be nil). All other errors are returned immediately.
+// If every attempt fails with "412 Precondition Failed", the last error is returned and
+// can be detected with IsPreconditionFailed().
+func UpdateWithETag[T any](ctx context.Context, get ResourceGetter[T], mutate ResourceMutator[T], replace ResourceReplacer[T],
+	options *UpdateOptions) (result T, response *core.DetailedResponse, err error) {
+	maxAttempts, backoff, maxBackoff := DefaultUpdateAttempts, DefaultUpdateInitialBackoff, DefaultUpdateMaxBackoff
+	if options != nil {
+		if options.MaxAttempts > 0 {
+			maxAttempts = options.MaxAttempts
+		}
+		if options.InitialBackoff > 0 {
+			backoff = options.InitialBackoff
+		}
+		if options.MaxBackoff > 0 {
+			maxBackoff = options.MaxBackoff
+		}
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for attempt := 1; ; attempt++ {
+		var resource T
+		resource, response, err = get(ctx)
+		if err != nil {
+			return
+		}
+		etag := GetETag(response)
+		if etag == "" {
+			err = fmt.Errorf("the response does not contain an ETag header")
+			return
+		}
+
+		err = mutate(resource)
+		if errors.Is(err, ErrNoUpdate) {
+			result, err = resource, nil
+			return
+		}
+		if err != nil {
+			return
+		}
+
+		result, response, err = replace(ctx, resource, etag)
+		if err == nil || !IsPreconditionFailed(err) || attempt >= maxAttempts {
+			return
+		}
+
+		core.GetLogger().Debug("Resource was modified during update (attempt %d of %d), retrying in %s", attempt, maxAttempts, backoff)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = ctx.Err()
+			return
+		case <-timer.C:
+		}
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
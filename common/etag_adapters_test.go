@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastUpdateRetries = &common.UpdateOptions{InitialBackoff: time.Millisecond}
+
+func TestModifyV2Policy(t *testing.T) {
+	server := platformfake.NewServer(nil)
+	defer server.Close()
+	policyManagement, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	createOptions := policyManagement.NewCreateV2PolicyOptions(&iampolicymanagementv1.Control{
+		Grant: &iampolicymanagementv1.Grant{
+			Roles: []iampolicymanagementv1.Roles{{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Viewer")}},
+		},
+	}, "access")
+	createOptions.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+			{Key: core.StringPtr("iam_id"), Operator: core.StringPtr("stringEquals"), Value: "IBMid-1"},
+		},
+	})
+	createOptions.SetResource(&iampolicymanagementv1.V2PolicyResource{
+		Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+			{Key: core.StringPtr("accountId"), Operator: core.StringPtr("stringEquals"), Value: server.AccountID()},
+		},
+	})
+	policy, _, err := policyManagement.CreateV2Policy(createOptions)
+	require.Nil(t, err)
+
+	// The first attempt is invalidated by a concurrent update of the policy.
+	attempts := 0
+	result, _, err := policyManagement.ModifyV2Policy(context.Background(), *policy.ID, func(policy *iampolicymanagementv1.V2Policy) error {
+		attempts++
+		if attempts == 1 {
+			_, _, err := policyManagement.ModifyV2Policy(context.Background(), *policy.ID, func(policy *iampolicymanagementv1.V2Policy) error {
+				policy.Description = core.StringPtr("concurrent")
+				return nil
+			}, nil)
+			require.Nil(t, err)
+		}
+		policy.Resource.Attributes = append(policy.Resource.Attributes, iampolicymanagementv1.V2PolicyResourceAttribute{
+			Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: "kms",
+		})
+		return nil
+	}, fastUpdateRetries)
+	require.Nil(t, err)
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "concurrent", *result.Description)
+	assert.Len(t, result.Resource.Attributes, 2)
+	assert.Equal(t, "crn:v1:bluemix:public:iam::::role:Viewer", *result.Control.(*iampolicymanagementv1.ControlResponse).Grant.Roles[0].RoleID)
+
+	_, _, err = policyManagement.ModifyV2Policy(context.Background(), "unknown", func(policy *iampolicymanagementv1.V2Policy) error {
+		return nil
+	}, nil)
+	assert.True(t, common.IsNotFound(err))
+}
+
+func TestModifyAccessGroup(t *testing.T) {
+	server := platformfake.NewServer(nil)
+	defer server.Close()
+	accessGroups, err := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	group, _, err := accessGroups.CreateAccessGroup(accessGroups.NewCreateAccessGroupOptions(server.AccountID(), "admins"))
+	require.Nil(t, err)
+
+	// Every attempt is invalidated by a concurrent update of the group.
+	attempts := 0
+	_, _, err = accessGroups.ModifyAccessGroup(context.Background(), *group.ID, func(group *iamaccessgroupsv2.Group) error {
+		attempts++
+		_, response, err := accessGroups.GetAccessGroup(accessGroups.NewGetAccessGroupOptions(*group.ID))
+		require.Nil(t, err)
+		_, _, err = accessGroups.UpdateAccessGroup(accessGroups.NewUpdateAccessGroupOptions(*group.ID, common.GetETag(response)).
+			SetDescription("concurrent"))
+		require.Nil(t, err)
+		return nil
+	}, &common.UpdateOptions{MaxAttempts: 2, InitialBackoff: time.Millisecond})
+	assert.True(t, common.IsPreconditionFailed(err))
+	assert.Equal(t, 2, attempts)
+
+	result, _, err := accessGroups.ModifyAccessGroup(context.Background(), *group.ID, func(group *iamaccessgroupsv2.Group) error {
+		group.Name = core.StringPtr("administrators")
+		return nil
+	}, fastUpdateRetries)
+	require.Nil(t, err)
+	assert.Equal(t, "administrators", *result.Name)
+	assert.Equal(t, "concurrent", *result.Description)
+}
+
+func TestModifyZone(t *testing.T) {
+	server := platformfake.NewServer(nil)
+	defer server.Close()
+	cbr, err := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	zone, _, err := cbr.CreateZone(cbr.NewCreateZoneOptions().SetName("office").SetAccountID(server.AccountID()).
+		SetAddresses([]contextbasedrestrictionsv1.AddressIntf{
+			&contextbasedrestrictionsv1.AddressIPAddress{Type: core.StringPtr("ipAddress"), Value: core.StringPtr("169.23.56.234")},
+		}))
+	require.Nil(t, err)
+
+	result, _, err := cbr.ModifyZone(context.Background(), *zone.ID, func(zone *contextbasedrestrictionsv1.Zone) error {
+		zone.Addresses = append(zone.Addresses,
+			&contextbasedrestrictionsv1.AddressSubnet{Type: core.StringPtr("subnet"), Value: core.StringPtr("10.0.0.0/24")})
+		return nil
+	}, fastUpdateRetries)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), *result.AddressCount)
+
+	unchanged, _, err := cbr.ModifyZone(context.Background(), *zone.ID, func(zone *contextbasedrestrictionsv1.Zone) error {
+		return common.ErrNoUpdate
+	}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, *result.LastModifiedAt, *unchanged.LastModifiedAt)
+}
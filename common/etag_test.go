@@ -0,0 +1,148 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// versionedResource is a resource protected by an ETag that is derived from its version.
+type versionedResource struct {
+	version int
+	value   string
+}
+
+func (r *versionedResource) response() *core.DetailedResponse {
+	headers := http.Header{}
+	headers.Set("ETag", fmt.Sprintf(`W/"%d"`, r.version))
+	return &core.DetailedResponse{StatusCode: http.StatusOK, Headers: headers}
+}
+
+func newVersionedAccessors(t *testing.T, stored *versionedResource, conflicts int) (ResourceGetter[*versionedResource], ResourceReplacer[*versionedResource]) {
+	preconditionFailed := invokeWithStatus(t, http.StatusPreconditionFailed, nil, `{}`)
+	get := func(ctx context.Context) (*versionedResource, *core.DetailedResponse, error) {
+		copy := *stored
+		return &copy, stored.response(), nil
+	}
+	replace := func(ctx context.Context, resource *versionedResource, etag string) (*versionedResource, *core.DetailedResponse, error) {
+		if conflicts > 0 {
+			// Simulate a concurrent update.
+			conflicts--
+			stored.version++
+		}
+		if etag != GetETag(stored.response()) {
+			return nil, &core.DetailedResponse{StatusCode: http.StatusPreconditionFailed}, preconditionFailed
+		}
+		stored.value = resource.value
+		stored.version++
+		return stored, stored.response(), nil
+	}
+	return get, replace
+}
+
+var fastRetries = &UpdateOptions{InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+func TestUpdateWithETag(t *testing.T) {
+	stored := &versionedResource{version: 1, value: "a"}
+	get, replace := newVersionedAccessors(t, stored, 2)
+
+	mutations := 0
+	result, response, err := UpdateWithETag(context.Background(), get, func(resource *versionedResource) error {
+		mutations++
+		resource.value += "b"
+		return nil
+	}, replace, fastRetries)
+	require.Nil(t, err)
+	assert.Equal(t, 3, mutations)
+	assert.Equal(t, "ab", result.value)
+	assert.Equal(t, `W/"4"`, GetETag(response))
+}
+
+func TestUpdateWithETagExhaustsAttempts(t *testing.T) {
+	stored := &versionedResource{version: 1, value: "a"}
+	get, replace := newVersionedAccessors(t, stored, 10)
+
+	mutations := 0
+	_, _, err := UpdateWithETag(context.Background(), get, func(resource *versionedResource) error {
+		mutations++
+		return nil
+	}, replace, &UpdateOptions{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	assert.True(t, IsPreconditionFailed(err))
+	assert.Equal(t, 3, mutations)
+	assert.Equal(t, "a", stored.value)
+}
+
+func TestUpdateWithETagNoUpdate(t *testing.T) {
+	stored := &versionedResource{version: 1, value: "a"}
+	get, _ := newVersionedAccessors(t, stored, 0)
+
+	result, _, err := UpdateWithETag(context.Background(), get, func(resource *versionedResource) error {
+		return ErrNoUpdate
+	}, func(ctx context.Context, resource *versionedResource, etag string) (*versionedResource, *core.DetailedResponse, error) {
+		t.Fatal("the resource should not be replaced")
+		return nil, nil, nil
+	}, nil)
+	require.Nil(t, err)
+	assert.Equal(t, "a", result.value)
+}
+
+func TestUpdateWithETagErrors(t *testing.T) {
+	stored := &versionedResource{version: 1, value: "a"}
+	get, replace := newVersionedAccessors(t, stored, 0)
+	noop := func(resource *versionedResource) error { return nil }
+
+	// Errors from the mutator are returned without replacing the resource.
+	_, _, err := UpdateWithETag(context.Background(), get, func(resource *versionedResource) error {
+		return fmt.Errorf("invalid value")
+	}, replace, nil)
+	assert.EqualError(t, err, "invalid value")
+	assert.Equal(t, 1, stored.version)
+
+	// Errors other than "412 Precondition Failed" are not retried.
+	attempts := 0
+	_, _, err = UpdateWithETag(context.Background(), get, noop,
+		func(ctx context.Context, resource *versionedResource, etag string) (*versionedResource, *core.DetailedResponse, error) {
+			attempts++
+			return nil, nil, invokeWithStatus(t, http.StatusBadRequest, nil, `{}`)
+		}, fastRetries)
+	assert.Equal(t, http.StatusBadRequest, GetErrorStatusCode(err))
+	assert.Equal(t, 1, attempts)
+
+	// A response without an ETag is rejected.
+	_, _, err = UpdateWithETag(context.Background(),
+		func(ctx context.Context) (*versionedResource, *core.DetailedResponse, error) {
+			return stored, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+		}, noop, replace, nil)
+	assert.ErrorContains(t, err, "ETag")
+
+	// Retries stop when the context is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	get, replace = newVersionedAccessors(t, stored, 10)
+	_, _, err = UpdateWithETag(ctx, get, func(resource *versionedResource) error {
+		cancel()
+		return nil
+	}, replace, &UpdateOptions{InitialBackoff: time.Hour})
+	assert.Equal(t, context.Canceled, err)
+}
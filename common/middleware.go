@@ -0,0 +1,171 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Operation identifies the REST API operation associated with an outgoing request.
+type Operation struct {
+	// The name of the service as defined in the API definition (e.g. "resource_controller").
+	ServiceName string
+
+	// The version of the service as defined in the API definition (e.g. "V2").
+	ServiceVersion string
+
+	// The operationId as defined in the API definition (e.g. "ListResourceInstances").
+	OperationID string
+}
+
+// RequestHandler sends "request" on behalf of "operation" and unmarshals the
+// response body into "result", in the same way as core.BaseService.Request().
+type RequestHandler func(operation *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error)
+
+// Middleware wraps a RequestHandler with additional behavior. A middleware may
+// inspect or modify the request before calling "next", and inspect the response
+// and error returned by "next" before returning them to its caller.
+// A middleware may also short-circuit the chain by not calling "next" at all.
+type Middleware func(next RequestHandler) RequestHandler
+
+var (
+	middlewareMutex  sync.RWMutex
+	globalMiddleware []Middleware
+)
+
+type middlewareContextKey struct{}
+
+// AddMiddleware appends the specified middleware to the chain that is invoked
+// for every request sent by every service client in this SDK.
+// Middleware is invoked in the order in which it was added, so the first
+// middleware added is the outermost one.
+func AddMiddleware(middleware ...Middleware) {
+	middlewareMutex.Lock()
+	defer middlewareMutex.Unlock()
+	globalMiddleware = append(globalMiddleware, middleware...)
+}
+
+// ClearMiddleware removes all middleware previously added with AddMiddleware().
+func ClearMiddleware() {
+	middlewareMutex.Lock()
+	defer middlewareMutex.Unlock()
+	globalMiddleware = nil
+}
+
+// WithMiddleware returns a copy of "ctx" that carries the specified middleware.
+// When the returned Context is passed to a "...WithContext" service method, the
+// middleware is invoked for that request only, after any middleware added with AddMiddleware().
+func WithMiddleware(ctx context.Context, middleware ...Middleware) context.Context {
+	var chain []Middleware
+	if existing, ok := ctx.Value(middlewareContextKey{}).([]Middleware); ok {
+		chain = append(chain, existing...)
+	}
+	chain = append(chain, middleware...)
+	return context.WithValue(ctx, middlewareContextKey{}, chain)
+}
+
+// InvokeRequest sends "request" using "service" after passing it through the middleware chain.
+//
+// This function is invoked by generated service methods in place of core.BaseService.Request().
+// Each request is also reported to the Tracer and Meter configured with SetTracer() and SetMeter().
+// An error caused by an error response can be classified with errors.Is() and the sentinel
+// errors defined in this package (e.g. ErrNotFound).
+// The serviceName, serviceVersion and operationId parameters are the same values that the
+// service method passes to GetSdkHeaders().
+//
+// Parameters:
+//
+//	service - the BaseService instance used to send the request
+//	serviceName - the name of the service as defined in the API definition (e.g. "MyService1")
+//	serviceVersion - the version of the service as defined in the API definition (e.g. "V1")
+//	operationId - the operationId as defined in the API definition (e.g. getContext)
+//	request - the request to be sent
+//	result - a pointer to the operation result, as expected by core.BaseService.Request()
+//
+// Returns:
+//
+//	the DetailedResponse and error returned by the middleware chain
+func InvokeRequest(service *core.BaseService, serviceName string, serviceVersion string, operationId string,
+	request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+	operation := &Operation{
+		ServiceName:    serviceName,
+		ServiceVersion: serviceVersion,
+		OperationID:    operationId,
+	}
+
+	var handler RequestHandler = func(_ *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+		return service.Request(request, result)
+	}
+
+	chain := getMiddlewareChain(request.Context())
+	for i := len(chain) - 1; i >= 0; i-- {
+		handler = chain[i](handler)
+	}
+
+	// Tracing and metrics wrap the entire chain so that they observe the operation
+	// exactly as it was seen by the service method.
+	handler = instrument(handler)
+
+	response, err := handler(operation, request, result)
+	return response, newAPIProblem(err, response)
+}
+
+// getMiddlewareChain returns the global middleware followed by any middleware carried by "ctx".
+func getMiddlewareChain(ctx context.Context) []Middleware {
+	middlewareMutex.RLock()
+	chain := make([]Middleware, 0, len(globalMiddleware))
+	chain = append(chain, globalMiddleware...)
+	middlewareMutex.RUnlock()
+
+	if ctx != nil {
+		if scoped, ok := ctx.Value(middlewareContextKey{}).([]Middleware); ok {
+			chain = append(chain, scoped...)
+		}
+	}
+	return chain
+}
+
+// HeaderMiddleware returns a Middleware that sets the specified headers on each outgoing request.
+func HeaderMiddleware(headers map[string]string) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		return func(operation *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+			for headerName, headerValue := range headers {
+				request.Header.Set(headerName, headerValue)
+			}
+			return next(operation, request, result)
+		}
+	}
+}
+
+// ServiceMiddleware returns a Middleware that applies "middleware" only to requests
+// sent on behalf of the specified service (e.g. "resource_controller").
+// Requests for other services are passed directly to the next handler in the chain.
+func ServiceMiddleware(serviceName string, middleware Middleware) Middleware {
+	return func(next RequestHandler) RequestHandler {
+		wrapped := middleware(next)
+		return func(operation *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+			if operation.ServiceName == serviceName {
+				return wrapped(operation, request, result)
+			}
+			return next(operation, request, result)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestService(t *testing.T, url string) *core.BaseService {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           url,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	return service
+}
+
+func newTestRequest(t *testing.T, ctx context.Context, url string) *http.Request {
+	builder := core.NewRequestBuilder(core.GET)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(url, `/v1/things`, nil)
+	assert.Nil(t, err)
+	builder.AddHeader("Accept", "application/json")
+	request, err := builder.Build()
+	assert.Nil(t, err)
+	return request
+}
+
+func TestInvokeRequestWithoutMiddleware(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprint(res, `{"name": "thing"}`)
+	}))
+	defer server.Close()
+
+	var result map[string]interface{}
+	response, err := InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "ListThings",
+		newTestRequest(t, context.Background(), server.URL), &result)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, "thing", result["name"])
+}
+
+func TestInvokeRequestMiddlewareChain(t *testing.T) {
+	defer ClearMiddleware()
+
+	var receivedHeaders http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		receivedHeaders = req.Header
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprint(res, `{"name": "thing"}`)
+	}))
+	defer server.Close()
+
+	var calls []string
+	tracker := func(name string) Middleware {
+		return func(next RequestHandler) RequestHandler {
+			return func(operation *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+				calls = append(calls, name+":"+operation.ServiceName+"/"+operation.ServiceVersion+"/"+operation.OperationID)
+				response, err := next(operation, request, result)
+				calls = append(calls, fmt.Sprintf("%s:%d", name, response.StatusCode))
+				return response, err
+			}
+		}
+	}
+
+	AddMiddleware(tracker("first"), HeaderMiddleware(map[string]string{"X-Global": "global"}))
+	AddMiddleware(tracker("second"))
+	ctx := WithMiddleware(context.Background(), tracker("scoped"), HeaderMiddleware(map[string]string{"X-Scoped": "scoped"}))
+
+	var result map[string]interface{}
+	response, err := InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "ListThings",
+		newTestRequest(t, ctx, server.URL), &result)
+	assert.Nil(t, err)
+	assert.Equal(t, 200, response.StatusCode)
+	assert.Equal(t, "global", receivedHeaders.Get("X-Global"))
+	assert.Equal(t, "scoped", receivedHeaders.Get("X-Scoped"))
+	assert.Equal(t, []string{
+		"first:my_service/V1/ListThings",
+		"second:my_service/V1/ListThings",
+		"scoped:my_service/V1/ListThings",
+		"scoped:200",
+		"second:200",
+		"first:200",
+	}, calls)
+
+	// Context-scoped middleware must not leak into other requests.
+	calls = nil
+	ClearMiddleware()
+	_, err = InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "ListThings",
+		newTestRequest(t, context.Background(), server.URL), &result)
+	assert.Nil(t, err)
+	assert.Empty(t, calls)
+	assert.Empty(t, receivedHeaders.Get("X-Global"))
+	assert.Empty(t, receivedHeaders.Get("X-Scoped"))
+}
+
+func TestInvokeRequestShortCircuit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		t.Fatal("request should not have been sent")
+	}))
+	defer server.Close()
+
+	blocker := func(next RequestHandler) RequestHandler {
+		return func(operation *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+			return nil, fmt.Errorf("operation %s is not allowed", operation.OperationID)
+		}
+	}
+	ctx := WithMiddleware(context.Background(), ServiceMiddleware("my_service", blocker))
+
+	_, err := InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "DeleteThing",
+		newTestRequest(t, ctx, server.URL), nil)
+	assert.EqualError(t, err, "operation DeleteThing is not allowed")
+}
+
+func TestServiceMiddlewareSkipsOtherServices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Empty(t, req.Header.Get("X-Injected"))
+		res.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	ctx := WithMiddleware(context.Background(),
+		ServiceMiddleware("other_service", HeaderMiddleware(map[string]string{"X-Injected": "true"})))
+
+	response, err := InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "DeleteThing",
+		newTestRequest(t, ctx, server.URL), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 204, response.StatusCode)
+}
@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// ResumablePager is a Pager whose position can be saved with GetCheckpoint() and later
+// restored with the pager's corresponding "Resume...Pager" constructor.
+type ResumablePager[T any] interface {
+	Pager[T]
+
+	// GetCheckpoint returns an opaque token that records the current position of the pager.
+	GetCheckpoint() string
+}
+
+// PageMarker is the type of the page marker kept by a pager: either a "start" token or an "offset".
+type PageMarker interface {
+	string | int64
+}
+
+// pageToken is the serialized form of a pager checkpoint.
+type pageToken[N PageMarker] struct {
+	Pager   string `json:"pager"`
+	Next    *N     `json:"next,omitempty"`
+	HasNext bool   `json:"has_next"`
+}
+
+// EncodePageToken returns an opaque, URL-safe token that records the position of a pager.
+// This function is invoked by the GetCheckpoint() method of each pager.
+//
+// Parameters:
+//
+//	pagerName - the name of the pager type (e.g. "ResourceInstancesPager")
+//	next - the page marker used to retrieve the next page of results (nil for the first page)
+//	hasNext - true if there are potentially more results to be retrieved
+func EncodePageToken[N PageMarker](pagerName string, next *N, hasNext bool) string {
+	b, err := json.Marshal(&pageToken[N]{
+		Pager:   pagerName,
+		Next:    next,
+		HasNext: hasNext,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodePageToken decodes a token produced by EncodePageToken() and returns the page marker
+// and "has next" indicator that it records.
+// An error is returned if the token is malformed or was produced by a different type of pager.
+// This function is invoked by the "Resume...Pager" constructor of each pager.
+func DecodePageToken[N PageMarker](pagerName string, token string) (next *N, hasNext bool, err error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		err = fmt.Errorf("the checkpoint token is not valid: %s", err.Error())
+		return
+	}
+
+	decoded := &pageToken[N]{}
+	err = json.Unmarshal(b, decoded)
+	if err != nil {
+		err = fmt.Errorf("the checkpoint token is not valid: %s", err.Error())
+		return
+	}
+	if decoded.Pager != pagerName {
+		err = fmt.Errorf("the checkpoint token was created by a '%s', not a '%s'", decoded.Pager, pagerName)
+		return
+	}
+
+	next = decoded.Next
+	hasNext = decoded.HasNext
+	return
+}
@@ -0,0 +1,67 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	token := EncodePageToken("ThingsPager", core.StringPtr("abc=="), true)
+	next, hasNext, err := DecodePageToken[string]("ThingsPager", token)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc==", *next)
+	assert.True(t, hasNext)
+
+	token = EncodePageToken("ThingsPager", core.Int64Ptr(200), true)
+	offset, hasNext, err := DecodePageToken[int64]("ThingsPager", token)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(200), *offset)
+	assert.True(t, hasNext)
+
+	// A pager that has not yet retrieved a page, and one that has retrieved all pages.
+	token = EncodePageToken[string]("ThingsPager", nil, true)
+	next, hasNext, err = DecodePageToken[string]("ThingsPager", token)
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	assert.True(t, hasNext)
+
+	token = EncodePageToken[string]("ThingsPager", nil, false)
+	next, hasNext, err = DecodePageToken[string]("ThingsPager", token)
+	assert.Nil(t, err)
+	assert.Nil(t, next)
+	assert.False(t, hasNext)
+}
+
+func TestPageTokenErrors(t *testing.T) {
+	_, _, err := DecodePageToken[string]("ThingsPager", "%%%")
+	assert.NotNil(t, err)
+
+	_, _, err = DecodePageToken[string]("ThingsPager", "bm90IGpzb24")
+	assert.NotNil(t, err)
+
+	token := EncodePageToken("OtherPager", core.StringPtr("abc"), true)
+	_, _, err = DecodePageToken[string]("ThingsPager", token)
+	assert.EqualError(t, err, "the checkpoint token was created by a 'OtherPager', not a 'ThingsPager'")
+
+	token = EncodePageToken("ThingsPager", core.StringPtr("abc"), true)
+	_, _, err = DecodePageToken[int64]("ThingsPager", token)
+	assert.NotNil(t, err)
+}
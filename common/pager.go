@@ -0,0 +1,137 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+)
+
+// Pager is the interface implemented by every pager in this SDK (e.g. ResourceInstancesPager),
+// where T is the type of the items contained in each page of results.
+type Pager[T any] interface {
+	// HasNext returns true if there are potentially more results to be retrieved.
+	HasNext() bool
+
+	// GetNextWithContext returns the next page of results using the specified Context.
+	GetNextWithContext(ctx context.Context) ([]T, error)
+
+	// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+	GetNext() ([]T, error)
+
+	// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+	// until all pages of results have been retrieved.
+	GetAllWithContext(ctx context.Context) ([]T, error)
+
+	// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+	GetAll() ([]T, error)
+}
+
+// Seq2 is an iterator over pairs of values. It has the same underlying type as iter.Seq2
+// (Go 1.23), so with Go 1.23 or later a Seq2 can be used in a range-over-func loop:
+//
+//	for item, err := range common.Items[resourcecontrollerv2.ResourceInstance](ctx, pager) {
+//		if err != nil {
+//			return err
+//		}
+//		...
+//	}
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// Pages returns an iterator over the remaining pages of results available from "pager".
+// If an error occurs while retrieving a page, the iterator yields a nil page and the error, then stops.
+func Pages[T any](ctx context.Context, pager Pager[T]) Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		for pager.HasNext() {
+			page, err := pager.GetNextWithContext(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Items returns an iterator over the individual items contained in the remaining pages
+// of results available from "pager". Pages are retrieved only as the iterator advances.
+// If an error occurs while retrieving a page, the iterator yields the zero value and the error, then stops.
+func Items[T any](ctx context.Context, pager Pager[T]) Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		Pages(ctx, pager)(func(page []T, err error) bool {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return false
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Take returns an iterator that yields at most the first "n" items of "seq".
+// An error yielded by "seq" is passed through and does not count towards "n".
+func Take[T any](seq Seq2[T, error], n int) Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+		count := 0
+		seq(func(item T, err error) bool {
+			if !yield(item, err) {
+				return false
+			}
+			if err == nil {
+				count++
+			}
+			return count < n
+		})
+	}
+}
+
+// Filter returns an iterator that yields only the items of "seq" for which "keep" returns true.
+// Errors yielded by "seq" are always passed through.
+func Filter[T any](seq Seq2[T, error], keep func(T) bool) Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		seq(func(item T, err error) bool {
+			if err != nil || keep(item) {
+				return yield(item, err)
+			}
+			return true
+		})
+	}
+}
+
+// Collect returns the items yielded by "seq" as a slice.
+// If "seq" yields an error, Collect returns the items collected so far along with the error.
+func Collect[T any](seq Seq2[T, error]) (items []T, err error) {
+	seq(func(item T, itemErr error) bool {
+		if itemErr != nil {
+			err = itemErr
+			return false
+		}
+		items = append(items, item)
+		return true
+	})
+	return
+}
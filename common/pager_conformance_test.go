@@ -0,0 +1,130 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/casemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/catalogmanagementv1"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisebillingunitsv1"
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/enterpriseusagereportsv1"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/partnermanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/usagereportsv4"
+	"github.com/IBM/platform-services-go-sdk/usermanagementv1"
+	"github.com/stretchr/testify/assert"
+)
+
+// Each pager in the SDK must satisfy the common.ResumablePager interface.
+var (
+	_ common.ResumablePager[casemanagementv1.Case]                            = (*casemanagementv1.GetCasesPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.AuditLogDigest]               = (*catalogmanagementv1.CatalogAccountAuditsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.ShareApprovalAccess]          = (*catalogmanagementv1.GetShareApprovalListPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.ShareApprovalAccess]          = (*catalogmanagementv1.GetShareApprovalListAsSourcePager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.AuditLogDigest]               = (*catalogmanagementv1.CatalogAuditsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.AuditLogDigest]               = (*catalogmanagementv1.EnterpriseAuditsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.Offering]                     = (*catalogmanagementv1.GetConsumptionOfferingsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.Offering]                     = (*catalogmanagementv1.OfferingsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.AuditLogDigest]               = (*catalogmanagementv1.OfferingAuditsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.Access]                       = (*catalogmanagementv1.GetOfferingAccessListPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.Version]                      = (*catalogmanagementv1.GetVersionsPager)(nil)
+	_ common.ResumablePager[string]                                           = (*catalogmanagementv1.GetNamespacesPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.CatalogObject]                = (*catalogmanagementv1.SearchObjectsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.CatalogObject]                = (*catalogmanagementv1.ObjectsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.AuditLogDigest]               = (*catalogmanagementv1.ObjectAuditsPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.Access]                       = (*catalogmanagementv1.GetObjectAccessListPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.Access]                       = (*catalogmanagementv1.GetObjectAccessListDeprecatedPager)(nil)
+	_ common.ResumablePager[catalogmanagementv1.AuditLogDigest]               = (*catalogmanagementv1.OfferingInstanceAuditsPager)(nil)
+	_ common.ResumablePager[contextbasedrestrictionsv1.ZoneSummary]           = (*contextbasedrestrictionsv1.ZonesPager)(nil)
+	_ common.ResumablePager[contextbasedrestrictionsv1.Rule]                  = (*contextbasedrestrictionsv1.RulesPager)(nil)
+	_ common.ResumablePager[enterprisebillingunitsv1.BillingUnit]             = (*enterprisebillingunitsv1.BillingUnitsPager)(nil)
+	_ common.ResumablePager[enterprisebillingunitsv1.BillingOption]           = (*enterprisebillingunitsv1.BillingOptionsPager)(nil)
+	_ common.ResumablePager[enterprisemanagementv1.Enterprise]                = (*enterprisemanagementv1.EnterprisesPager)(nil)
+	_ common.ResumablePager[enterprisemanagementv1.Account]                   = (*enterprisemanagementv1.AccountsPager)(nil)
+	_ common.ResumablePager[enterprisemanagementv1.AccountGroup]              = (*enterprisemanagementv1.AccountGroupsPager)(nil)
+	_ common.ResumablePager[enterpriseusagereportsv1.ResourceUsageReport]     = (*enterpriseusagereportsv1.GetResourceUsageReportPager)(nil)
+	_ common.ResumablePager[globalcatalogv1.CatalogEntry]                     = (*globalcatalogv1.CatalogEntriesPager)(nil)
+	_ common.ResumablePager[globalsearchv2.ResultItem]                        = (*globalsearchv2.SearchPager)(nil)
+	_ common.ResumablePager[globaltaggingv1.Tag]                              = (*globaltaggingv1.TagsPager)(nil)
+	_ common.ResumablePager[iamaccessgroupsv2.Group]                          = (*iamaccessgroupsv2.AccessGroupsPager)(nil)
+	_ common.ResumablePager[iamaccessgroupsv2.ListGroupMembersResponseMember] = (*iamaccessgroupsv2.AccessGroupMembersPager)(nil)
+	_ common.ResumablePager[iamaccessgroupsv2.GroupTemplate]                  = (*iamaccessgroupsv2.TemplatesPager)(nil)
+	_ common.ResumablePager[iamaccessgroupsv2.ListTemplateVersionResponse]    = (*iamaccessgroupsv2.TemplateVersionsPager)(nil)
+	_ common.ResumablePager[iamidentityv1.APIKey]                             = (*iamidentityv1.APIKeysPager)(nil)
+	_ common.ResumablePager[iamidentityv1.ServiceID]                          = (*iamidentityv1.ServiceIdsPager)(nil)
+	_ common.ResumablePager[iamidentityv1.TrustedProfile]                     = (*iamidentityv1.ProfilesPager)(nil)
+	_ common.ResumablePager[iamidentityv1.TrustedProfileTemplateResponse]     = (*iamidentityv1.ProfileTemplatesPager)(nil)
+	_ common.ResumablePager[iampolicymanagementv1.V2PolicyTemplateMetaData]   = (*iampolicymanagementv1.V2PoliciesPager)(nil)
+	_ common.ResumablePager[iampolicymanagementv1.PolicyTemplate]             = (*iampolicymanagementv1.PolicyTemplatesPager)(nil)
+	_ common.ResumablePager[partnermanagementv1.PartnerUsageReport]           = (*partnermanagementv1.GetResourceUsageReportPager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceInstance]            = (*resourcecontrollerv2.ResourceInstancesPager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceAlias]               = (*resourcecontrollerv2.ResourceAliasesForInstancePager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceKey]                 = (*resourcecontrollerv2.ResourceKeysForInstancePager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceKey]                 = (*resourcecontrollerv2.ResourceKeysPager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceBinding]             = (*resourcecontrollerv2.ResourceBindingsPager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceAlias]               = (*resourcecontrollerv2.ResourceAliasesPager)(nil)
+	_ common.ResumablePager[resourcecontrollerv2.ResourceBinding]             = (*resourcecontrollerv2.ResourceBindingsForAliasPager)(nil)
+	_ common.ResumablePager[usagereportsv4.InstanceUsage]                     = (*usagereportsv4.GetResourceUsageAccountPager)(nil)
+	_ common.ResumablePager[usagereportsv4.InstanceUsage]                     = (*usagereportsv4.GetResourceUsageResourceGroupPager)(nil)
+	_ common.ResumablePager[usagereportsv4.InstanceUsage]                     = (*usagereportsv4.GetResourceUsageOrgPager)(nil)
+	_ common.ResumablePager[usagereportsv4.SnapshotListSnapshotsItem]         = (*usagereportsv4.GetReportsSnapshotPager)(nil)
+	_ common.ResumablePager[usermanagementv1.UserProfile]                     = (*usermanagementv1.UsersPager)(nil)
+)
+
+func TestItemsWithServicePager(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		res.Header().Set("Content-Type", "application/json")
+		res.WriteHeader(200)
+		if req.URL.Query().Get("start") == "" {
+			fmt.Fprint(res, `{"next_url":"/v2/resource_instances?start=1","rows_count":2,"resources":[{"id":"a"},{"id":"b"}]}`)
+		} else {
+			fmt.Fprint(res, `{"rows_count":1,"resources":[{"id":"c"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	resourceController, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	assert.Nil(t, err)
+	pager, err := resourceController.NewResourceInstancesPager(&resourcecontrollerv2.ListResourceInstancesOptions{})
+	assert.Nil(t, err)
+
+	var ids []string
+	common.Items[resourcecontrollerv2.ResourceInstance](context.Background(), pager)(func(item resourcecontrollerv2.ResourceInstance, err error) bool {
+		assert.Nil(t, err)
+		ids = append(ids, *item.ID)
+		return true
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+}
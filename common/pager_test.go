@@ -0,0 +1,123 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// slicePager is a Pager that returns pre-defined pages, optionally failing at a given page.
+type slicePager struct {
+	pages     [][]int
+	next      int
+	failAt    int
+	pageCalls int
+}
+
+func newSlicePager(pages ...[]int) *slicePager {
+	return &slicePager{pages: pages, failAt: -1}
+}
+
+func (pager *slicePager) HasNext() bool {
+	return pager.next < len(pager.pages)
+}
+
+func (pager *slicePager) GetNextWithContext(ctx context.Context) ([]int, error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+	pager.pageCalls++
+	if pager.next == pager.failAt {
+		return nil, errors.New("page failed")
+	}
+	page := pager.pages[pager.next]
+	pager.next++
+	return page, nil
+}
+
+func (pager *slicePager) GetNext() ([]int, error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+func (pager *slicePager) GetAllWithContext(ctx context.Context) (allItems []int, err error) {
+	for pager.HasNext() {
+		var page []int
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, page...)
+	}
+	return
+}
+
+func (pager *slicePager) GetAll() ([]int, error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+func TestItemsAndPages(t *testing.T) {
+	items, err := Collect(Items[int](context.Background(), newSlicePager([]int{1, 2}, []int{}, []int{3})))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	var pages [][]int
+	Pages[int](context.Background(), newSlicePager([]int{1, 2}, []int{3}))(func(page []int, err error) bool {
+		assert.Nil(t, err)
+		pages = append(pages, page)
+		return true
+	})
+	assert.Equal(t, [][]int{{1, 2}, {3}}, pages)
+}
+
+func TestItemsError(t *testing.T) {
+	pager := newSlicePager([]int{1, 2}, []int{3}, []int{4})
+	pager.failAt = 1
+
+	items, err := Collect(Items[int](context.Background(), pager))
+	assert.EqualError(t, err, "page failed")
+	assert.Equal(t, []int{1, 2}, items)
+}
+
+func TestTakeStopsFetchingPages(t *testing.T) {
+	pager := newSlicePager([]int{1, 2}, []int{3, 4}, []int{5, 6})
+
+	items, err := Collect(Take(Items[int](context.Background(), pager), 3))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Equal(t, 2, pager.pageCalls)
+
+	items, err = Collect(Take(Items[int](context.Background(), newSlicePager([]int{1})), 0))
+	assert.Nil(t, err)
+	assert.Empty(t, items)
+}
+
+func TestFilter(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+
+	items, err := Collect(Filter(Items[int](context.Background(), newSlicePager([]int{1, 2, 3}, []int{4, 5, 6})), even))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 4, 6}, items)
+
+	items, err = Collect(Take(Filter(Items[int](context.Background(), newSlicePager([]int{1, 2, 3}, []int{4, 5, 6})), even), 2))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{2, 4}, items)
+}
@@ -0,0 +1,99 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+)
+
+// PageResult is a page of results delivered by Prefetch(), or the error that occurred
+// while retrieving it.
+type PageResult[T any] struct {
+	// The items contained in the page (nil if Err is set).
+	Items []T
+
+	// The error that occurred while retrieving the page.
+	Err error
+
+	// If the pager is a ResumablePager, the checkpoint recorded immediately after this
+	// page was retrieved; resuming from it continues with the following page.
+	Checkpoint string
+}
+
+// Prefetch retrieves pages of results from "pager" in a background goroutine and delivers them
+// on the returned channel, so that the next page is being retrieved while the caller processes
+// the current one.
+//
+// At most "bufferSize" retrieved pages are held in the channel waiting to be consumed
+// (a bufferSize less than 1 is treated as 1), which bounds the memory used regardless of the
+// total number of results.
+//
+// The channel is closed after the last page has been delivered, after a PageResult with a
+// non-nil Err has been delivered, or when "ctx" is done. A caller that stops consuming pages
+// before the channel is closed must cancel "ctx" so that the background goroutine can exit.
+// The pager must not be used by the caller while the channel is open.
+func Prefetch[T any](ctx context.Context, pager Pager[T], bufferSize int) <-chan PageResult[T] {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	results := make(chan PageResult[T], bufferSize)
+
+	go func() {
+		defer close(results)
+
+		resumable, _ := pager.(ResumablePager[T])
+		for pager.HasNext() {
+			if ctx.Err() != nil {
+				return
+			}
+
+			var result PageResult[T]
+			result.Items, result.Err = pager.GetNextWithContext(ctx)
+			if result.Err == nil && resumable != nil {
+				result.Checkpoint = resumable.GetCheckpoint()
+			}
+
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+			if result.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return results
+}
+
+// PrefetchAll returns all results from "pager", retrieving each page in the background
+// while the items of the previous page are appended to the result, as with Prefetch().
+func PrefetchAll[T any](ctx context.Context, pager Pager[T], bufferSize int) (allItems []T, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for result := range Prefetch(ctx, pager, bufferSize) {
+		if result.Err != nil {
+			err = result.Err
+			return
+		}
+		allItems = append(allItems, result.Items...)
+	}
+	err = ctx.Err()
+	return
+}
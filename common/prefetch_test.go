@@ -0,0 +1,125 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// signallingPager is a resumable slicePager that reports each page retrieval on a channel.
+type signallingPager struct {
+	*slicePager
+	fetched chan int
+}
+
+func (pager *signallingPager) GetNextWithContext(ctx context.Context) ([]int, error) {
+	page, err := pager.slicePager.GetNextWithContext(ctx)
+	pager.fetched <- pager.next
+	return page, err
+}
+
+func (pager *signallingPager) GetCheckpoint() string {
+	return fmt.Sprintf("page-%d", pager.next)
+}
+
+func TestPrefetchDeliversPagesInOrder(t *testing.T) {
+	pager := newSlicePager([]int{1, 2}, []int{3}, []int{4, 5})
+
+	var pages [][]int
+	for result := range Prefetch[int](context.Background(), pager, 2) {
+		assert.Nil(t, result.Err)
+		assert.Empty(t, result.Checkpoint)
+		pages = append(pages, result.Items)
+	}
+	assert.Equal(t, [][]int{{1, 2}, {3}, {4, 5}}, pages)
+}
+
+func TestPrefetchCheckpoints(t *testing.T) {
+	pager := &signallingPager{slicePager: newSlicePager([]int{1}, []int{2}), fetched: make(chan int, 10)}
+
+	var checkpoints []string
+	for result := range Prefetch[int](context.Background(), pager, 1) {
+		checkpoints = append(checkpoints, result.Checkpoint)
+	}
+	assert.Equal(t, []string{"page-1", "page-2"}, checkpoints)
+}
+
+func TestPrefetchStopsAfterError(t *testing.T) {
+	pager := newSlicePager([]int{1}, []int{2}, []int{3})
+	pager.failAt = 1
+
+	var results []PageResult[int]
+	for result := range Prefetch[int](context.Background(), pager, 5) {
+		results = append(results, result)
+	}
+	assert.Len(t, results, 2)
+	assert.Equal(t, []int{1}, results[0].Items)
+	assert.EqualError(t, results[1].Err, "page failed")
+	assert.Equal(t, 2, pager.pageCalls)
+
+	pager = newSlicePager([]int{1}, []int{2}, []int{3})
+	pager.failAt = 2
+	items, err := PrefetchAll[int](context.Background(), pager, 1)
+	assert.EqualError(t, err, "page failed")
+	assert.Equal(t, []int{1, 2}, items)
+}
+
+func TestPrefetchBufferIsBounded(t *testing.T) {
+	pages := make([][]int, 10)
+	for i := range pages {
+		pages[i] = []int{i}
+	}
+	pager := &signallingPager{slicePager: newSlicePager(pages...), fetched: make(chan int, len(pages))}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := Prefetch[int](ctx, pager, 2)
+
+	// With nothing consumed, two pages fill the buffer and a third is held by the goroutine.
+	for i := 1; i <= 3; i++ {
+		assert.Equal(t, i, <-pager.fetched)
+	}
+	select {
+	case n := <-pager.fetched:
+		t.Fatalf("page %d was retrieved while the buffer was full", n)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Consuming a page allows the next one to be retrieved.
+	assert.Equal(t, []int{0}, (<-results).Items)
+	assert.Equal(t, 4, <-pager.fetched)
+
+	// Cancelling the context releases the goroutine and closes the channel.
+	cancel()
+	for range results {
+	}
+}
+
+func TestPrefetchAll(t *testing.T) {
+	items, err := PrefetchAll[int](context.Background(), newSlicePager([]int{1, 2}, []int{3}, []int{}), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 2, 3}, items)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = PrefetchAll[int](ctx, newSlicePager([]int{1, 2}), 1)
+	assert.Equal(t, context.Canceled, err)
+}
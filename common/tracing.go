@@ -0,0 +1,350 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+const headerNameTraceParent = "traceparent"
+
+// SpanContext holds the identity of a span, as propagated in the W3C "traceparent" header.
+type SpanContext struct {
+	// The 32-character hex-encoded trace ID.
+	TraceID string
+
+	// The 16-character hex-encoded span ID.
+	SpanID string
+
+	// Indicates whether the trace is sampled.
+	Sampled bool
+}
+
+// IsValid returns true if the SpanContext contains both a trace ID and a span ID.
+func (spanContext SpanContext) IsValid() bool {
+	return spanContext.TraceID != "" && spanContext.SpanID != ""
+}
+
+// TraceParent returns the value of the W3C "traceparent" header for the SpanContext.
+func (spanContext SpanContext) TraceParent() string {
+	flags := "00"
+	if spanContext.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", spanContext.TraceID, spanContext.SpanID, flags)
+}
+
+// Span represents the invocation of a single API operation.
+type Span interface {
+	// SpanContext returns the identity of the span, used to propagate trace context headers.
+	SpanContext() SpanContext
+
+	// SetStatusCode records the HTTP status code of the response.
+	SetStatusCode(statusCode int)
+
+	// End completes the span; "err" is the error returned by the operation, if any.
+	End(err error)
+}
+
+// Tracer creates a Span for each API operation invoked by a service client.
+type Tracer interface {
+	// Start creates a span for "operation". The returned Context carries the new span
+	// and is used for the remainder of the request.
+	Start(ctx context.Context, operation *Operation) (context.Context, Span)
+}
+
+// Meter records metrics for each API operation invoked by a service client.
+type Meter interface {
+	// RecordOperation records the latency, status code and error of a completed operation.
+	// "statusCode" is 0 if no response was received.
+	RecordOperation(operation *Operation, duration time.Duration, statusCode int, err error)
+}
+
+// NoopTracer is a Tracer that creates spans which do nothing. It is the default Tracer.
+type NoopTracer struct{}
+
+// Start returns "ctx" unchanged along with a span which does nothing.
+func (NoopTracer) Start(ctx context.Context, operation *Operation) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SpanContext() SpanContext { return SpanContext{} }
+func (noopSpan) SetStatusCode(int)        {}
+func (noopSpan) End(error)                {}
+
+// NoopMeter is a Meter that discards all metrics. It is the default Meter.
+type NoopMeter struct{}
+
+// RecordOperation discards the metrics for the operation.
+func (NoopMeter) RecordOperation(*Operation, time.Duration, int, error) {}
+
+var (
+	telemetryMutex sync.RWMutex
+	tracer         Tracer = NoopTracer{}
+	meter          Meter  = NoopMeter{}
+)
+
+// SetTracer sets the Tracer used for every request sent by every service client in this SDK.
+// Specifying nil restores the default NoopTracer.
+func SetTracer(t Tracer) {
+	telemetryMutex.Lock()
+	defer telemetryMutex.Unlock()
+	if t == nil {
+		t = NoopTracer{}
+	}
+	tracer = t
+}
+
+// GetTracer returns the Tracer currently in use.
+func GetTracer() Tracer {
+	telemetryMutex.RLock()
+	defer telemetryMutex.RUnlock()
+	return tracer
+}
+
+// SetMeter sets the Meter used for every request sent by every service client in this SDK.
+// Specifying nil restores the default NoopMeter.
+func SetMeter(m Meter) {
+	telemetryMutex.Lock()
+	defer telemetryMutex.Unlock()
+	if m == nil {
+		m = NoopMeter{}
+	}
+	meter = m
+}
+
+// GetMeter returns the Meter currently in use.
+func GetMeter() Meter {
+	telemetryMutex.RLock()
+	defer telemetryMutex.RUnlock()
+	return meter
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan returns a copy of "ctx" that carries "span".
+// Tracer implementations can use this to make a span the parent of spans started with the returned Context.
+func ContextWithSpan(ctx context.Context, span Span) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns the span carried by "ctx", or nil if there is none.
+func SpanFromContext(ctx context.Context) Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanContextKey{}).(Span)
+	return span
+}
+
+// instrument wraps "next" so that a span is created, metrics are recorded and trace context
+// headers are propagated for each request.
+func instrument(next RequestHandler) RequestHandler {
+	return func(operation *Operation, request *http.Request, result interface{}) (*core.DetailedResponse, error) {
+		ctx, span := GetTracer().Start(request.Context(), operation)
+		if ctx != request.Context() {
+			request = request.WithContext(ctx)
+		}
+		if spanContext := span.SpanContext(); spanContext.IsValid() {
+			request.Header.Set(headerNameTraceParent, spanContext.TraceParent())
+		}
+
+		start := time.Now()
+		response, err := next(operation, request, result)
+		duration := time.Since(start)
+
+		var statusCode int
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		span.SetStatusCode(statusCode)
+		span.End(err)
+		GetMeter().RecordOperation(operation, duration, statusCode, err)
+
+		return response, err
+	}
+}
+
+// RecordedSpan is a span captured by a Recorder.
+type RecordedSpan struct {
+	Operation    Operation
+	SpanContext  SpanContext
+	ParentSpanID string
+	StartTime    time.Time
+	EndTime      time.Time
+	StatusCode   int
+	Err          error
+	Ended        bool
+}
+
+// OperationMetrics holds the metrics recorded by a Recorder for a single operation.
+type OperationMetrics struct {
+	// The number of times the operation was invoked.
+	Count int
+
+	// The number of invocations that returned an error.
+	ErrorCount int
+
+	// The number of invocations per HTTP status code (0 if no response was received).
+	StatusCodes map[int]int
+
+	// The total and maximum latency of the invocations.
+	TotalDuration time.Duration
+	MaxDuration   time.Duration
+}
+
+// Recorder is an in-memory Tracer and Meter, intended for use in tests.
+type Recorder struct {
+	mutex   sync.Mutex
+	spans   []*recorderSpan
+	metrics map[Operation]*OperationMetrics
+}
+
+// NewRecorder returns a new, empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		metrics: make(map[Operation]*OperationMetrics),
+	}
+}
+
+type recorderSpan struct {
+	recorder *Recorder
+	data     RecordedSpan
+}
+
+func (span *recorderSpan) SpanContext() SpanContext {
+	return span.data.SpanContext
+}
+
+func (span *recorderSpan) SetStatusCode(statusCode int) {
+	span.recorder.mutex.Lock()
+	defer span.recorder.mutex.Unlock()
+	span.data.StatusCode = statusCode
+}
+
+func (span *recorderSpan) End(err error) {
+	span.recorder.mutex.Lock()
+	defer span.recorder.mutex.Unlock()
+	span.data.Err = err
+	span.data.EndTime = time.Now()
+	span.data.Ended = true
+}
+
+// Start creates a recorded span for "operation". If "ctx" carries a span, the new span
+// becomes its child and shares its trace ID.
+func (recorder *Recorder) Start(ctx context.Context, operation *Operation) (context.Context, Span) {
+	span := &recorderSpan{
+		recorder: recorder,
+		data: RecordedSpan{
+			Operation: *operation,
+			SpanContext: SpanContext{
+				SpanID:  randomHex(8),
+				Sampled: true,
+			},
+			StartTime: time.Now(),
+		},
+	}
+	if parent := SpanFromContext(ctx); parent != nil && parent.SpanContext().IsValid() {
+		span.data.SpanContext.TraceID = parent.SpanContext().TraceID
+		span.data.ParentSpanID = parent.SpanContext().SpanID
+	} else {
+		span.data.SpanContext.TraceID = randomHex(16)
+	}
+
+	recorder.mutex.Lock()
+	recorder.spans = append(recorder.spans, span)
+	recorder.mutex.Unlock()
+
+	return ContextWithSpan(ctx, span), span
+}
+
+// RecordOperation accumulates the metrics for "operation".
+func (recorder *Recorder) RecordOperation(operation *Operation, duration time.Duration, statusCode int, err error) {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	metrics, ok := recorder.metrics[*operation]
+	if !ok {
+		metrics = &OperationMetrics{
+			StatusCodes: make(map[int]int),
+		}
+		recorder.metrics[*operation] = metrics
+	}
+	metrics.Count++
+	if err != nil {
+		metrics.ErrorCount++
+	}
+	metrics.StatusCodes[statusCode]++
+	metrics.TotalDuration += duration
+	if duration > metrics.MaxDuration {
+		metrics.MaxDuration = duration
+	}
+}
+
+// Spans returns a copy of the spans recorded so far, in the order in which they were started.
+func (recorder *Recorder) Spans() []RecordedSpan {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	spans := make([]RecordedSpan, 0, len(recorder.spans))
+	for _, span := range recorder.spans {
+		spans = append(spans, span.data)
+	}
+	return spans
+}
+
+// Metrics returns a copy of the metrics recorded so far, keyed by operation.
+func (recorder *Recorder) Metrics() map[Operation]OperationMetrics {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+
+	metrics := make(map[Operation]OperationMetrics, len(recorder.metrics))
+	for operation, m := range recorder.metrics {
+		statusCodes := make(map[int]int, len(m.StatusCodes))
+		for code, count := range m.StatusCodes {
+			statusCodes[code] = count
+		}
+		copied := *m
+		copied.StatusCodes = statusCodes
+		metrics[operation] = copied
+	}
+	return metrics
+}
+
+// Reset discards all spans and metrics recorded so far.
+func (recorder *Recorder) Reset() {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	recorder.spans = nil
+	recorder.metrics = make(map[Operation]*OperationMetrics)
+}
+
+func randomHex(numBytes int) string {
+	b := make([]byte, numBytes)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
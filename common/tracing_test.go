@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultTelemetryIsNoop(t *testing.T) {
+	assert.Equal(t, NoopTracer{}, GetTracer())
+	assert.Equal(t, NoopMeter{}, GetMeter())
+
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		assert.Empty(t, req.Header.Get(headerNameTraceParent))
+		res.WriteHeader(204)
+	}))
+	defer server.Close()
+
+	_, err := InvokeRequest(newTestService(t, server.URL), "my_service", "V1", "DeleteThing",
+		newTestRequest(t, context.Background(), server.URL), nil)
+	assert.Nil(t, err)
+}
+
+func TestRecorderSpansAndMetrics(t *testing.T) {
+	recorder := NewRecorder()
+	SetTracer(recorder)
+	SetMeter(recorder)
+	defer SetTracer(nil)
+	defer SetMeter(nil)
+
+	var traceParents []string
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		traceParents = append(traceParents, req.Header.Get(headerNameTraceParent))
+		if req.URL.Query().Get("fail") == "true" {
+			res.Header().Set("Content-Type", "application/json")
+			res.WriteHeader(404)
+			fmt.Fprint(res, `{"message": "not found"}`)
+			return
+		}
+		res.WriteHeader(204)
+	}))
+	defer server.Close()
+	service := newTestService(t, server.URL)
+
+	// Start a parent span so that the request spans join its trace.
+	ctx, parent := recorder.Start(context.Background(), &Operation{ServiceName: "app", OperationID: "Provision"})
+
+	_, err := InvokeRequest(service, "my_service", "V1", "DeleteThing", newTestRequest(t, ctx, server.URL), nil)
+	assert.Nil(t, err)
+
+	failing := newTestRequest(t, ctx, server.URL)
+	failing.URL.RawQuery = "fail=true"
+	response, err := InvokeRequest(service, "my_service", "V1", "DeleteThing", failing, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+	parent.End(nil)
+
+	spans := recorder.Spans()
+	assert.Len(t, spans, 3)
+	for i, span := range spans[1:] {
+		assert.Equal(t, "DeleteThing", span.Operation.OperationID)
+		assert.Equal(t, parent.SpanContext().TraceID, span.SpanContext.TraceID)
+		assert.Equal(t, parent.SpanContext().SpanID, span.ParentSpanID)
+		assert.True(t, span.Ended)
+		assert.Equal(t, span.SpanContext.TraceParent(), traceParents[i])
+	}
+	assert.Equal(t, 204, spans[1].StatusCode)
+	assert.Nil(t, spans[1].Err)
+	assert.Equal(t, 404, spans[2].StatusCode)
+	assert.NotNil(t, spans[2].Err)
+
+	metrics := recorder.Metrics()
+	assert.Len(t, metrics, 1)
+	m := metrics[Operation{ServiceName: "my_service", ServiceVersion: "V1", OperationID: "DeleteThing"}]
+	assert.Equal(t, 2, m.Count)
+	assert.Equal(t, 1, m.ErrorCount)
+	assert.Equal(t, map[int]int{204: 1, 404: 1}, m.StatusCodes)
+	assert.True(t, m.MaxDuration > 0)
+	assert.True(t, m.TotalDuration >= m.MaxDuration)
+
+	recorder.Reset()
+	assert.Empty(t, recorder.Spans())
+	assert.Empty(t, recorder.Metrics())
+}
+
+func TestSpanContextTraceParent(t *testing.T) {
+	spanContext := SpanContext{
+		TraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+		SpanID:  "00f067aa0ba902b7",
+		Sampled: true,
+	}
+	assert.True(t, spanContext.IsValid())
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", spanContext.TraceParent())
+	assert.False(t, SpanContext{}.IsValid())
+}
@@ -244,7 +244,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateZoneWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "CreateZone", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -323,7 +323,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListZonesWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "ListZones", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_zones", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -398,7 +398,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetZoneWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "GetZone", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -500,7 +500,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceZoneWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "ReplaceZone", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -573,7 +573,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteZoneWithContex
 		return
 	}
 
-	response, err = contextBasedRestrictions.Service.Request(request, nil)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "DeleteZone", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_zone", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -635,7 +635,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableService
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "ListAvailableServicerefTargets", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_available_serviceref_targets", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -710,7 +710,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetServicerefTargetW
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "GetServicerefTarget", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_serviceref_target", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -799,7 +799,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) CreateRuleWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "CreateRule", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -902,7 +902,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListRulesWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "ListRules", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_rules", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -977,7 +977,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetRuleWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "GetRule", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1079,7 +1079,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ReplaceRuleWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "ReplaceRule", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1152,7 +1152,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) DeleteRuleWithContex
 		return
 	}
 
-	response, err = contextBasedRestrictions.Service.Request(request, nil)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "DeleteRule", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1219,7 +1219,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) GetAccountSettingsWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "GetAccountSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1295,7 +1295,7 @@ func (contextBasedRestrictions *ContextBasedRestrictionsV1) ListAvailableService
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = contextBasedRestrictions.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(contextBasedRestrictions.Service, "context_based_restrictions", "V1", "ListAvailableServiceOperations", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_available_service_operations", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3800,3 +3800,197 @@ func UnmarshalAddressVPC(m map[string]json.RawMessage, result interface{}) (err
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// ZonesPager can be used to simplify the use of the "ListZones" method.
+// The "ListZones" operation returns all results in a single response, so the pager retrieves exactly one page.
+type ZonesPager struct {
+	hasNext bool
+	options *ListZonesOptions
+	client  *ContextBasedRestrictionsV1
+}
+
+// NewZonesPager returns a new ZonesPager instance.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) NewZonesPager(options *ListZonesOptions) (pager *ZonesPager, err error) {
+	var optionsCopy ListZonesOptions = *options
+	pager = &ZonesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  contextBasedRestrictions,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ZonesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ZonesPager) GetNextWithContext(ctx context.Context) (page []ZoneSummary, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListZonesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	pager.hasNext = false
+	page = result.Zones
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ZonesPager) GetAllWithContext(ctx context.Context) (allItems []ZoneSummary, err error) {
+	for pager.HasNext() {
+		var nextPage []ZoneSummary
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ZonesPager) GetNext() (page []ZoneSummary, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ZonesPager) GetAll() (allItems []ZoneSummary, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeZonesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ZonesPager) GetCheckpoint() string {
+	return common.EncodePageToken[string]("ZonesPager", nil, pager.hasNext)
+}
+
+// ResumeZonesPager returns a new ZonesPager instance positioned at the checkpoint
+// returned by ZonesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ResumeZonesPager(options *ListZonesOptions, checkpoint string) (pager *ZonesPager, err error) {
+	_, hasNext, err := common.DecodePageToken[string]("ZonesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = contextBasedRestrictions.NewZonesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.hasNext = hasNext
+	return
+}
+
+// RulesPager can be used to simplify the use of the "ListRules" method.
+// The "ListRules" operation returns all results in a single response, so the pager retrieves exactly one page.
+type RulesPager struct {
+	hasNext bool
+	options *ListRulesOptions
+	client  *ContextBasedRestrictionsV1
+}
+
+// NewRulesPager returns a new RulesPager instance.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) NewRulesPager(options *ListRulesOptions) (pager *RulesPager, err error) {
+	var optionsCopy ListRulesOptions = *options
+	pager = &RulesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  contextBasedRestrictions,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *RulesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *RulesPager) GetNextWithContext(ctx context.Context) (page []Rule, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	result, _, err := pager.client.ListRulesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	pager.hasNext = false
+	page = result.Rules
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *RulesPager) GetAllWithContext(ctx context.Context) (allItems []Rule, err error) {
+	for pager.HasNext() {
+		var nextPage []Rule
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *RulesPager) GetNext() (page []Rule, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *RulesPager) GetAll() (allItems []Rule, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeRulesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *RulesPager) GetCheckpoint() string {
+	return common.EncodePageToken[string]("RulesPager", nil, pager.hasNext)
+}
+
+// ResumeRulesPager returns a new RulesPager instance positioned at the checkpoint
+// returned by RulesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ResumeRulesPager(options *ListRulesOptions, checkpoint string) (pager *RulesPager, err error) {
+	_, hasNext, err := common.DecodePageToken[string]("RulesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = contextBasedRestrictions.NewRulesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.hasNext = hasNext
+	return
+}
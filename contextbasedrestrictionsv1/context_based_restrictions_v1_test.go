@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/platform-services-go-sdk/crn"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -723,6 +725,74 @@ var _ = Describe(`ContextBasedRestrictionsV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listZonesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"count":2,"zones":[{"zone_id":"ZoneID","name":"Name"},{"zone_id":"ZoneID2","name":"Name2"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ZonesPager.GetNext successfully`, func() {
+				contextBasedRestrictionsService, serviceErr := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(contextBasedRestrictionsService).ToNot(BeNil())
+
+				listZonesOptionsModel := &contextbasedrestrictionsv1.ListZonesOptions{
+					AccountID: core.StringPtr("testString"),
+				}
+
+				pager, err := contextBasedRestrictionsService.NewZonesPager(listZonesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []contextbasedrestrictionsv1.ZoneSummary
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ZonesPager.GetAll successfully`, func() {
+				contextBasedRestrictionsService, serviceErr := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(contextBasedRestrictionsService).ToNot(BeNil())
+
+				listZonesOptionsModel := &contextbasedrestrictionsv1.ListZonesOptions{
+					AccountID: core.StringPtr("testString"),
+				}
+
+				pager, err := contextBasedRestrictionsService.NewZonesPager(listZonesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`GetZone(getZoneOptions *GetZoneOptions) - Operation response error`, func() {
 		getZonePath := "/v1/zones/testString"
@@ -2595,6 +2665,74 @@ var _ = Describe(`ContextBasedRestrictionsV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listRulesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"count":2,"rules":[{"id":"ID"},{"id":"ID2"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use RulesPager.GetNext successfully`, func() {
+				contextBasedRestrictionsService, serviceErr := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(contextBasedRestrictionsService).ToNot(BeNil())
+
+				listRulesOptionsModel := &contextbasedrestrictionsv1.ListRulesOptions{
+					AccountID: core.StringPtr("testString"),
+				}
+
+				pager, err := contextBasedRestrictionsService.NewRulesPager(listRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []contextbasedrestrictionsv1.Rule
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use RulesPager.GetAll successfully`, func() {
+				contextBasedRestrictionsService, serviceErr := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(contextBasedRestrictionsService).ToNot(BeNil())
+
+				listRulesOptionsModel := &contextbasedrestrictionsv1.ListRulesOptions{
+					AccountID: core.StringPtr("testString"),
+				}
+
+				pager, err := contextBasedRestrictionsService.NewRulesPager(listRulesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`GetRule(getRuleOptions *GetRuleOptions) - Operation response error`, func() {
 		getRulePath := "/v1/rules/testString"
@@ -3858,6 +3996,46 @@ var _ = Describe(`ContextBasedRestrictionsV1`, func() {
 			})
 		})
 	})
+	Describe(`CRN helpers`, func() {
+		contextBasedRestrictionsService, _ := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+			URL:           "http://contextbasedrestrictionsv1modelgenerator.com",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		getAttributes := func(resource *contextbasedrestrictionsv1.Resource) map[string]string {
+			attributes := map[string]string{}
+			for _, attribute := range resource.Attributes {
+				attributes[*attribute.Name] = *attribute.Value
+			}
+			return attributes
+		}
+		It(`Invoke NewResourceFromCRN successfully`, func() {
+			resource, err := contextBasedRestrictionsService.NewResourceFromCRN(crn.MustParse("crn:v1:bluemix:public:kms:us-south:a/account:instance:key:key-id"))
+			Expect(err).To(BeNil())
+			Expect(getAttributes(resource)).To(Equal(map[string]string{
+				"accountId":       "account",
+				"serviceName":     "kms",
+				"region":          "us-south",
+				"serviceInstance": "instance",
+				"resourceType":    "key",
+				"resource":        "key-id",
+			}))
+			Expect(*resource.Attributes[0].Name).To(Equal("accountId"))
+
+			resource, err = contextBasedRestrictionsService.NewResourceFromCRN(crn.MustParse("crn:v1:bluemix:public:cloud-object-storage:global:a/account:::"))
+			Expect(err).To(BeNil())
+			Expect(getAttributes(resource)).To(Equal(map[string]string{
+				"accountId":   "account",
+				"serviceName": "cloud-object-storage",
+			}))
+		})
+		It(`Invoke NewResourceFromCRN with an invalid CRN`, func() {
+			_, err := contextBasedRestrictionsService.NewResourceFromCRN(crn.MustParse("crn:v1:bluemix:public:cf:eu-gb:o/org::cf-space:space"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("is not scoped to an account"))
+			_, err = contextBasedRestrictionsService.NewResourceFromCRN(&crn.CRN{Version: "v1"})
+			Expect(errors.Is(err, crn.ErrInvalidCRN)).To(BeTrue())
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			contextBasedRestrictionsService, _ := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
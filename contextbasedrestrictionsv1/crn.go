@@ -0,0 +1,63 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package contextbasedrestrictionsv1
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/crn"
+)
+
+// NewResourceFromCRN : Instantiate Resource with the attributes that identify the resource
+// "resourceCRN": its account, service name, region, service instance, resource type and resource.
+// Empty segments of the CRN are omitted, so the CRN of a service instance yields a Resource that
+// covers the instance and everything within it. The CRN must be scoped to an account.
+func (*ContextBasedRestrictionsV1) NewResourceFromCRN(resourceCRN *crn.CRN) (_model *Resource, err error) {
+	err = core.ValidateNotNil(resourceCRN, "resourceCRN cannot be nil")
+	if err == nil {
+		err = resourceCRN.Validate()
+	}
+	if err == nil && resourceCRN.AccountID() == "" {
+		err = fmt.Errorf("the CRN '%s' is not scoped to an account", resourceCRN.String())
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-crn", common.GetComponentInfo())
+		return
+	}
+
+	segments := [][2]string{
+		{"accountId", resourceCRN.AccountID()},
+		{"serviceName", resourceCRN.ServiceName},
+		{"region", resourceCRN.Location},
+		{"serviceInstance", resourceCRN.ServiceInstance},
+		{"resourceType", resourceCRN.ResourceType},
+		{"resource", resourceCRN.Resource},
+	}
+	_model = &Resource{}
+	for _, segment := range segments {
+		if segment[1] == "" || (segment[0] == "region" && segment[1] == "global") {
+			continue
+		}
+		_model.Attributes = append(_model.Attributes, ResourceAttribute{
+			Name:  core.StringPtr(segment[0]),
+			Value: core.StringPtr(segment[1]),
+		})
+	}
+	return
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package contextbasedrestrictionsv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ModifyZone performs a read-modify-write update of the network zone "zoneID".
+// The zone is retrieved, "mutate" is applied to it, and the result is sent to ReplaceZone()
+// with the ETag of the retrieved zone. If the zone is modified concurrently, the update is
+// retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the zone unchanged.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ModifyZone(ctx context.Context, zoneID string, mutate func(*Zone) error,
+	options *common.UpdateOptions) (result *Zone, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*Zone, *core.DetailedResponse, error) {
+		return contextBasedRestrictions.GetZoneWithContext(ctx, contextBasedRestrictions.NewGetZoneOptions(zoneID))
+	}
+	replace := func(ctx context.Context, zone *Zone, etag string) (*Zone, *core.DetailedResponse, error) {
+		replaceOptions := &ReplaceZoneOptions{
+			ZoneID:      core.StringPtr(zoneID),
+			IfMatch:     core.StringPtr(etag),
+			Name:        zone.Name,
+			AccountID:   zone.AccountID,
+			Description: zone.Description,
+			Addresses:   zone.Addresses,
+			Excluded:    zone.Excluded,
+		}
+		return contextBasedRestrictions.ReplaceZoneWithContext(ctx, replaceOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
+
+// ModifyRule performs a read-modify-write update of the rule "ruleID".
+// The rule is retrieved, "mutate" is applied to it, and the result is sent to ReplaceRule()
+// with the ETag of the retrieved rule. If the rule is modified concurrently, the update is
+// retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the rule unchanged.
+func (contextBasedRestrictions *ContextBasedRestrictionsV1) ModifyRule(ctx context.Context, ruleID string, mutate func(*Rule) error,
+	options *common.UpdateOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*Rule, *core.DetailedResponse, error) {
+		return contextBasedRestrictions.GetRuleWithContext(ctx, contextBasedRestrictions.NewGetRuleOptions(ruleID))
+	}
+	replace := func(ctx context.Context, rule *Rule, etag string) (*Rule, *core.DetailedResponse, error) {
+		replaceOptions := &ReplaceRuleOptions{
+			RuleID:          core.StringPtr(ruleID),
+			IfMatch:         core.StringPtr(etag),
+			Description:     rule.Description,
+			Contexts:        rule.Contexts,
+			Resources:       rule.Resources,
+			Operations:      rule.Operations,
+			EnforcementMode: rule.EnforcementMode,
+		}
+		return contextBasedRestrictions.ReplaceRuleWithContext(ctx, replaceOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
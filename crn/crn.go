@@ -0,0 +1,333 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package crn parses, validates, builds and matches Cloud Resource Names (CRNs).
+//
+// A CRN has ten colon-separated segments:
+//
+//	crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+//
+// For example:
+//
+//	c, err := crn.Parse("crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::")
+//	...
+//	accountID := c.AccountID()
+//
+// CRNs are built by setting the segments of a CRN and calling String():
+//
+//	c := crn.New("cloud-object-storage", "global", accountID)
+//	c.ServiceInstance = instanceID
+//	crnString := c.String()
+package crn
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// Prefix is the first segment of every CRN.
+	Prefix = "crn"
+
+	// Version1 is the current CRN version.
+	Version1 = "v1"
+
+	// CNamePublic and CTypePublic identify the public IBM Cloud.
+	CNamePublic = "bluemix"
+	CTypePublic = "public"
+
+	// Wildcard matches any value when it is used as (or within) a segment of a pattern.
+	Wildcard = "*"
+)
+
+// The types of scope, as found before the "/" of the scope segment.
+const (
+	ScopeTypeAccount      = "a"
+	ScopeTypeOrganization = "o"
+	ScopeTypeSpace        = "s"
+	ScopeTypeProject      = "p"
+)
+
+// segmentCount is the number of segments of a CRN, including the "crn" prefix.
+const segmentCount = 10
+
+// ErrInvalidCRN is returned (wrapped) by Parse() and CRN.Validate() when a CRN is not valid.
+// It can be detected with errors.Is().
+var ErrInvalidCRN = errors.New("invalid CRN")
+
+var (
+	versionPattern = regexp.MustCompile(`^v[0-9]+$`)
+	namePattern    = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-._]*$`)
+	scopePattern   = regexp.MustCompile(`^[aops]/[^/\s]+$`)
+)
+
+// CRN : The segments of a Cloud Resource Name.
+type CRN struct {
+	// The version of the CRN format (e.g. "v1").
+	Version string
+
+	// The name of the cloud instance (e.g. "bluemix").
+	CName string
+
+	// The type of the cloud instance (e.g. "public").
+	CType string
+
+	// The name of the service (e.g. "cloud-object-storage").
+	ServiceName string
+
+	// The location of the resource: a region (e.g. "us-south"), a zone, or "global".
+	Location string
+
+	// The scope of the resource: "a/<account ID>", "o/<organization GUID>",
+	// "s/<space GUID>" or "p/<project ID>".
+	Scope string
+
+	// The ID of the service instance.
+	ServiceInstance string
+
+	// The type of the resource within the service instance.
+	ResourceType string
+
+	// The ID of the resource within the service instance. Unlike the other segments, the
+	// resource may contain colons.
+	Resource string
+}
+
+// New returns a version 1 CRN of the public cloud for the service "serviceName" in the
+// specified location and account. The remaining segments can be set on the result.
+func New(serviceName string, location string, accountID string) *CRN {
+	crn := &CRN{
+		Version:     Version1,
+		CName:       CNamePublic,
+		CType:       CTypePublic,
+		ServiceName: serviceName,
+		Location:    location,
+	}
+	if accountID != "" {
+		crn.Scope = AccountScope(accountID)
+	}
+	return crn
+}
+
+// AccountScope returns the scope segment of the resources of an account.
+func AccountScope(accountID string) string {
+	return ScopeTypeAccount + "/" + accountID
+}
+
+// Parse parses and validates the string representation of a CRN.
+// An error wrapping ErrInvalidCRN is returned if the CRN is not valid.
+func Parse(s string) (*CRN, error) {
+	crn, err := split(s)
+	if err != nil {
+		return nil, err
+	}
+	if err = crn.Validate(); err != nil {
+		return nil, err
+	}
+	return crn, nil
+}
+
+// MustParse is like Parse() but panics if the CRN is not valid. It simplifies the
+// initialization of variables that hold well-known CRNs.
+func MustParse(s string) *CRN {
+	crn, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return crn
+}
+
+// IsValid returns true if "s" is a valid CRN.
+func IsValid(s string) bool {
+	_, err := Parse(s)
+	return err == nil
+}
+
+// split splits "s" into its segments without validating them.
+func split(s string) (*CRN, error) {
+	segments := strings.SplitN(s, ":", segmentCount)
+	if len(segments) != segmentCount {
+		return nil, fmt.Errorf("%w '%s': a CRN has %d colon-separated segments, found %d", ErrInvalidCRN, s, segmentCount, len(segments))
+	}
+	if segments[0] != Prefix {
+		return nil, fmt.Errorf("%w '%s': a CRN must start with '%s:'", ErrInvalidCRN, s, Prefix)
+	}
+	return &CRN{
+		Version:         segments[1],
+		CName:           segments[2],
+		CType:           segments[3],
+		ServiceName:     segments[4],
+		Location:        segments[5],
+		Scope:           segments[6],
+		ServiceInstance: segments[7],
+		ResourceType:    segments[8],
+		Resource:        segments[9],
+	}, nil
+}
+
+// Validate returns an error wrapping ErrInvalidCRN if a segment of the CRN is not valid.
+// The version, cname and ctype segments are required; the other segments may be empty.
+func (crn *CRN) Validate() error {
+	invalid := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w '%s': %s", ErrInvalidCRN, crn.String(), fmt.Sprintf(format, args...))
+	}
+	if !versionPattern.MatchString(crn.Version) {
+		return invalid("the version '%s' is not valid", crn.Version)
+	}
+	if !namePattern.MatchString(crn.CName) {
+		return invalid("the cname '%s' is not valid", crn.CName)
+	}
+	if !namePattern.MatchString(crn.CType) {
+		return invalid("the ctype '%s' is not valid", crn.CType)
+	}
+	if crn.ServiceName != "" && !namePattern.MatchString(crn.ServiceName) {
+		return invalid("the service name '%s' is not valid", crn.ServiceName)
+	}
+	if crn.Scope != "" && !scopePattern.MatchString(crn.Scope) {
+		return invalid("the scope '%s' is not valid (expected 'a/', 'o/', 's/' or 'p/' followed by an ID)", crn.Scope)
+	}
+	segments := [][2]string{
+		{"location", crn.Location},
+		{"service instance", crn.ServiceInstance},
+		{"resource type", crn.ResourceType},
+	}
+	for _, segment := range segments {
+		if strings.ContainsAny(segment[1], ": \t\r\n") {
+			return invalid("the %s '%s' contains a colon or whitespace", segment[0], segment[1])
+		}
+	}
+	if strings.ContainsAny(crn.Resource, " \t\r\n") {
+		return invalid("the resource '%s' contains whitespace", crn.Resource)
+	}
+	return nil
+}
+
+// String returns the string representation of the CRN.
+func (crn *CRN) String() string {
+	return strings.Join([]string{Prefix, crn.Version, crn.CName, crn.CType, crn.ServiceName, crn.Location,
+		crn.Scope, crn.ServiceInstance, crn.ResourceType, crn.Resource}, ":")
+}
+
+// MarshalText implements encoding.TextMarshaler, so that a CRN is serialized as a string.
+func (crn *CRN) MarshalText() ([]byte, error) {
+	return []byte(crn.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler by parsing and validating the CRN.
+func (crn *CRN) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*crn = *parsed
+	return nil
+}
+
+// ScopeType returns the type of the scope of the CRN (one of the ScopeType constants), or ""
+// if the CRN has no scope.
+func (crn *CRN) ScopeType() string {
+	scopeType, _, _ := strings.Cut(crn.Scope, "/")
+	return scopeType
+}
+
+// ScopeID returns the ID found in the scope of the CRN (e.g. the account ID), or "" if the
+// CRN has no scope.
+func (crn *CRN) ScopeID() string {
+	_, scopeID, _ := strings.Cut(crn.Scope, "/")
+	return scopeID
+}
+
+// AccountID returns the ID of the account that owns the resource, or "" if the scope of the
+// CRN is not an account.
+func (crn *CRN) AccountID() string {
+	if crn.ScopeType() != ScopeTypeAccount {
+		return ""
+	}
+	return crn.ScopeID()
+}
+
+// Matches returns true if the CRN matches "pattern". Each segment of the pattern is compared
+// with the corresponding segment of the CRN:
+//
+//   - An empty segment of the pattern, after the ctype segment, matches any value, as the CRN
+//     of a service, account or service instance covers all the resources within it.
+//   - Within a segment, Wildcard ("*") matches any sequence of characters.
+//   - Other characters must match exactly.
+func (crn *CRN) Matches(pattern *CRN) bool {
+	required := [][2]string{
+		{pattern.Version, crn.Version},
+		{pattern.CName, crn.CName},
+		{pattern.CType, crn.CType},
+	}
+	for _, segment := range required {
+		if !matchSegment(segment[0], segment[1]) {
+			return false
+		}
+	}
+	optional := [][2]string{
+		{pattern.ServiceName, crn.ServiceName},
+		{pattern.Location, crn.Location},
+		{pattern.Scope, crn.Scope},
+		{pattern.ServiceInstance, crn.ServiceInstance},
+		{pattern.ResourceType, crn.ResourceType},
+		{pattern.Resource, crn.Resource},
+	}
+	for _, segment := range optional {
+		if segment[0] != "" && !matchSegment(segment[0], segment[1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match reports whether the CRN "s" matches "pattern" (see CRN.Matches()). An error is
+// returned if either string is not a CRN. The segments of the pattern are not validated, so
+// that they may contain wildcards.
+func Match(pattern string, s string) (bool, error) {
+	parsedPattern, err := split(pattern)
+	if err != nil {
+		return false, err
+	}
+	crn, err := Parse(s)
+	if err != nil {
+		return false, err
+	}
+	return crn.Matches(parsedPattern), nil
+}
+
+// matchSegment reports whether "value" matches "pattern", in which Wildcard matches any
+// sequence of characters.
+func matchSegment(pattern string, value string) bool {
+	if !strings.Contains(pattern, Wildcard) {
+		return pattern == value
+	}
+	parts := strings.Split(pattern, Wildcard)
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		index := strings.Index(value, part)
+		if index < 0 {
+			return false
+		}
+		value = value[index+len(part):]
+	}
+	return len(value) >= len(last) && strings.HasSuffix(value, last)
+}
@@ -0,0 +1,150 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crn_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/crn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const instanceCRN = "crn:v1:bluemix:public:cloud-object-storage:global:a/59bcbfa6ea2f006b4ed7094c1a08dcdd:1a0ec336-f391-4091-a6fb-5e084a4c56f4::"
+
+func TestParse(t *testing.T) {
+	c, err := crn.Parse(instanceCRN)
+	require.Nil(t, err)
+	assert.Equal(t, &crn.CRN{
+		Version:         "v1",
+		CName:           "bluemix",
+		CType:           "public",
+		ServiceName:     "cloud-object-storage",
+		Location:        "global",
+		Scope:           "a/59bcbfa6ea2f006b4ed7094c1a08dcdd",
+		ServiceInstance: "1a0ec336-f391-4091-a6fb-5e084a4c56f4",
+	}, c)
+	assert.Equal(t, instanceCRN, c.String())
+	assert.Equal(t, crn.ScopeTypeAccount, c.ScopeType())
+	assert.Equal(t, "59bcbfa6ea2f006b4ed7094c1a08dcdd", c.ScopeID())
+	assert.Equal(t, "59bcbfa6ea2f006b4ed7094c1a08dcdd", c.AccountID())
+
+	// The resource segment may contain colons.
+	c, err = crn.Parse("crn:v1:bluemix:public:cf:eu-gb:s/space-guid::cf-route:route:with:colons")
+	require.Nil(t, err)
+	assert.Equal(t, "cf-route", c.ResourceType)
+	assert.Equal(t, "route:with:colons", c.Resource)
+	assert.Equal(t, crn.ScopeTypeSpace, c.ScopeType())
+	assert.Equal(t, "", c.AccountID())
+
+	c, err = crn.Parse("crn:v1:bluemix:public:::::resource-group:")
+	require.Nil(t, err)
+	assert.Equal(t, "", c.ScopeType())
+	assert.Equal(t, "", c.ScopeID())
+}
+
+func TestParseInvalid(t *testing.T) {
+	invalid := map[string]string{
+		"": "a CRN has 10 colon-separated segments, found 1",
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/account":       "a CRN has 10 colon-separated segments, found 7",
+		"urn:v1:bluemix:public:cloud-object-storage:global:a/account:::":    "a CRN must start with 'crn:'",
+		"crn:1:bluemix:public:cloud-object-storage:global:a/account:::":     "the version '1' is not valid",
+		"crn:v1::public:cloud-object-storage:global:a/account:::":           "the cname '' is not valid",
+		"crn:v1:bluemix::cloud-object-storage:global:a/account:::":          "the ctype '' is not valid",
+		"crn:v1:bluemix:public:cloud object storage:global:a/account:::":    "the service name 'cloud object storage' is not valid",
+		"crn:v1:bluemix:public:cloud-object-storage:global:account:::":      "the scope 'account' is not valid",
+		"crn:v1:bluemix:public:cloud-object-storage:global:x/account:::":    "the scope 'x/account' is not valid",
+		"crn:v1:bluemix:public:cloud-object-storage:us south:a/account:::":  "the location 'us south' contains a colon or whitespace",
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/account:::a b": "the resource 'a b' contains whitespace",
+	}
+	for s, message := range invalid {
+		_, err := crn.Parse(s)
+		require.NotNil(t, err, s)
+		assert.True(t, errors.Is(err, crn.ErrInvalidCRN), s)
+		assert.Contains(t, err.Error(), message, s)
+		assert.False(t, crn.IsValid(s), s)
+	}
+	assert.True(t, crn.IsValid(instanceCRN))
+	assert.Panics(t, func() { crn.MustParse("crn:v1") })
+	assert.Equal(t, instanceCRN, crn.MustParse(instanceCRN).String())
+}
+
+func TestNew(t *testing.T) {
+	c := crn.New("iam-identity", "", "account")
+	c.ResourceType = "serviceid"
+	c.Resource = "ServiceId-1234"
+	assert.Nil(t, c.Validate())
+	assert.Equal(t, "crn:v1:bluemix:public:iam-identity::a/account::serviceid:ServiceId-1234", c.String())
+
+	c = crn.New("cloud-object-storage", "global", "")
+	assert.Equal(t, "", c.Scope)
+	assert.Equal(t, "a/account", crn.AccountScope("account"))
+
+	c.Scope = "account"
+	assert.True(t, errors.Is(c.Validate(), crn.ErrInvalidCRN))
+}
+
+func TestMatch(t *testing.T) {
+	matches := []struct {
+		pattern string
+		crn     string
+		matches bool
+	}{
+		{instanceCRN, instanceCRN, true},
+		{"crn:v1:bluemix:public:cloud-object-storage::a/59bcbfa6ea2f006b4ed7094c1a08dcdd:::", instanceCRN, true},
+		{"crn:v1:bluemix:public::::::", instanceCRN, true},
+		{"crn:v1:bluemix:public:cloud-object-storage:*:a/*:*::", instanceCRN, true},
+		{"crn:v1:bluemix:public:cloud-*:global::::", instanceCRN, true},
+		{"crn:v1:bluemix:public:*-storage:global::::", instanceCRN, true},
+		{"crn:v1:bluemix:public:cloud-*-storage:global::::", instanceCRN, true},
+		{"crn:v1:bluemix:public:cloud-*-storage-*:global::::", instanceCRN, false},
+		{"crn:v1:bluemix:public:kms::::::", instanceCRN, false},
+		{"crn:v1:bluemix:public:cloud-object-storage:us-south::::", instanceCRN, false},
+		{"crn:v1:bluemix:public:cloud-object-storage::a/other:::", instanceCRN, false},
+		{"crn:v1:staging:public::::::", instanceCRN, false},
+		{"crn:v1:bluemix:public::::::bucket", instanceCRN, false},
+		{"crn:*:*:*::::::", instanceCRN, true},
+	}
+	for _, m := range matches {
+		matched, err := crn.Match(m.pattern, m.crn)
+		require.Nil(t, err, m.pattern)
+		assert.Equal(t, m.matches, matched, m.pattern)
+	}
+
+	_, err := crn.Match("crn:v1", instanceCRN)
+	assert.True(t, errors.Is(err, crn.ErrInvalidCRN))
+	_, err = crn.Match(instanceCRN, "not a crn")
+	assert.True(t, errors.Is(err, crn.ErrInvalidCRN))
+}
+
+func TestJSON(t *testing.T) {
+	type document struct {
+		Target *crn.CRN `json:"target"`
+	}
+	buffer, err := json.Marshal(&document{Target: crn.MustParse(instanceCRN)})
+	require.Nil(t, err)
+	assert.Equal(t, `{"target":"`+instanceCRN+`"}`, string(buffer))
+
+	var doc document
+	require.Nil(t, json.Unmarshal(buffer, &doc))
+	assert.Equal(t, instanceCRN, doc.Target.String())
+
+	err = json.Unmarshal([]byte(`{"target":"crn:v1"}`), &doc)
+	assert.True(t, errors.Is(err, crn.ErrInvalidCRN))
+}
@@ -205,7 +205,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetBillingUnitWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseBillingUnits.Service, "enterprise_billing_units", "V1", "GetBillingUnit", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -274,7 +274,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ListBillingUnitsWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseBillingUnits.Service, "enterprise_billing_units", "V1", "ListBillingUnits", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -339,7 +339,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ListBillingOptionsWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseBillingUnits.Service, "enterprise_billing_units", "V1", "ListBillingOptions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -411,7 +411,7 @@ func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) GetCreditPoolsWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseBillingUnits.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseBillingUnits.Service, "enterprise_billing_units", "V1", "GetCreditPools", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1195,6 +1195,31 @@ func (pager *BillingUnitsPager) GetAll() (allItems []BillingUnit, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeBillingUnitsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *BillingUnitsPager) GetCheckpoint() string {
+	return common.EncodePageToken("BillingUnitsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeBillingUnitsPager returns a new BillingUnitsPager instance positioned at the checkpoint
+// returned by BillingUnitsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ResumeBillingUnitsPager(options *ListBillingUnitsOptions, checkpoint string) (pager *BillingUnitsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("BillingUnitsPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = enterpriseBillingUnits.NewBillingUnitsPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // BillingOptionsPager can be used to simplify the use of the "ListBillingOptions" method.
 //
@@ -1281,3 +1306,28 @@ func (pager *BillingOptionsPager) GetNext() (page []BillingOption, err error) {
 func (pager *BillingOptionsPager) GetAll() (allItems []BillingOption, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeBillingOptionsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *BillingOptionsPager) GetCheckpoint() string {
+	return common.EncodePageToken("BillingOptionsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeBillingOptionsPager returns a new BillingOptionsPager instance positioned at the checkpoint
+// returned by BillingOptionsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (enterpriseBillingUnits *EnterpriseBillingUnitsV1) ResumeBillingOptionsPager(options *ListBillingOptionsOptions, checkpoint string) (pager *BillingOptionsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("BillingOptionsPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = enterpriseBillingUnits.NewBillingOptionsPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
@@ -243,7 +243,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateEnterpriseWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "CreateEnterprise", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_enterprise", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -326,7 +326,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListEnterprisesWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "ListEnterprises", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_enterprises", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -396,7 +396,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetEnterpriseWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "GetEnterprise", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_enterprise", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -481,7 +481,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateEnterpriseWithContext(
 		return
 	}
 
-	response, err = enterpriseManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "UpdateEnterprise", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_enterprise", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -561,7 +561,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ImportAccountToEnterpriseWit
 		return
 	}
 
-	response, err = enterpriseManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "ImportAccountToEnterprise", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "import_account_to_enterprise", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -644,7 +644,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateAccountWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "CreateAccount", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -731,7 +731,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListAccountsWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "ListAccounts", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_accounts", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -801,7 +801,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetAccountWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "GetAccount", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -879,7 +879,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountWithContext(ctx
 		return
 	}
 
-	response, err = enterpriseManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "UpdateAccount", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -939,7 +939,7 @@ func (enterpriseManagement *EnterpriseManagementV1) DeleteAccountWithContext(ctx
 		return
 	}
 
-	response, err = enterpriseManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "DeleteAccount", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1015,7 +1015,7 @@ func (enterpriseManagement *EnterpriseManagementV1) CreateAccountGroupWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "CreateAccountGroup", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1103,7 +1103,7 @@ func (enterpriseManagement *EnterpriseManagementV1) ListAccountGroupsWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "ListAccountGroups", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_account_groups", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1173,7 +1173,7 @@ func (enterpriseManagement *EnterpriseManagementV1) GetAccountGroupWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "GetAccountGroup", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1255,7 +1255,7 @@ func (enterpriseManagement *EnterpriseManagementV1) UpdateAccountGroupWithContex
 		return
 	}
 
-	response, err = enterpriseManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "UpdateAccountGroup", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1316,7 +1316,7 @@ func (enterpriseManagement *EnterpriseManagementV1) DeleteAccountGroupWithContex
 		return
 	}
 
-	response, err = enterpriseManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(enterpriseManagement.Service, "enterprise_management", "V1", "DeleteAccountGroup", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2826,6 +2826,33 @@ func (pager *EnterprisesPager) GetAll() (allItems []Enterprise, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeEnterprisesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *EnterprisesPager) GetCheckpoint() string {
+	return common.EncodePageToken("EnterprisesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeEnterprisesPager returns a new EnterprisesPager instance positioned at the checkpoint
+// returned by EnterprisesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (enterpriseManagement *EnterpriseManagementV1) ResumeEnterprisesPager(options *ListEnterprisesOptions, checkpoint string) (pager *EnterprisesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("EnterprisesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = enterpriseManagement.NewEnterprisesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // AccountsPager can be used to simplify the use of the "ListAccounts" method.
 type AccountsPager struct {
 	hasNext     bool
@@ -2918,6 +2945,33 @@ func (pager *AccountsPager) GetAll() (allItems []Account, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeAccountsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *AccountsPager) GetCheckpoint() string {
+	return common.EncodePageToken("AccountsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeAccountsPager returns a new AccountsPager instance positioned at the checkpoint
+// returned by AccountsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (enterpriseManagement *EnterpriseManagementV1) ResumeAccountsPager(options *ListAccountsOptions, checkpoint string) (pager *AccountsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("AccountsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = enterpriseManagement.NewAccountsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // AccountGroupsPager can be used to simplify the use of the "ListAccountGroups" method.
 type AccountGroupsPager struct {
 	hasNext     bool
@@ -3009,3 +3063,30 @@ func (pager *AccountGroupsPager) GetAll() (allItems []AccountGroup, err error) {
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeAccountGroupsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *AccountGroupsPager) GetCheckpoint() string {
+	return common.EncodePageToken("AccountGroupsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeAccountGroupsPager returns a new AccountGroupsPager instance positioned at the checkpoint
+// returned by AccountGroupsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (enterpriseManagement *EnterpriseManagementV1) ResumeAccountGroupsPager(options *ListAccountGroupsOptions, checkpoint string) (pager *AccountGroupsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("AccountGroupsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = enterpriseManagement.NewAccountGroupsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
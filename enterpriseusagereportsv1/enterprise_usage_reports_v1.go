@@ -222,7 +222,7 @@ func (enterpriseUsageReports *EnterpriseUsageReportsV1) GetResourceUsageReportWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = enterpriseUsageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(enterpriseUsageReports.Service, "enterprise_usage_reports", "V1", "GetResourceUsageReport", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -779,3 +779,28 @@ func (pager *GetResourceUsageReportPager) GetNext() (page []ResourceUsageReport,
 func (pager *GetResourceUsageReportPager) GetAll() (allItems []ResourceUsageReport, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetResourceUsageReportPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetResourceUsageReportPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetResourceUsageReportPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetResourceUsageReportPager returns a new GetResourceUsageReportPager instance positioned at the checkpoint
+// returned by GetResourceUsageReportPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (enterpriseUsageReports *EnterpriseUsageReportsV1) ResumeGetResourceUsageReportPager(options *GetResourceUsageReportOptions, checkpoint string) (pager *GetResourceUsageReportPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetResourceUsageReportPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = enterpriseUsageReports.NewGetResourceUsageReportPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
@@ -234,7 +234,7 @@ func (globalCatalog *GlobalCatalogV1) ListCatalogEntriesWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "ListCatalogEntries", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -339,7 +339,7 @@ func (globalCatalog *GlobalCatalogV1) CreateCatalogEntryWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "CreateCatalogEntry", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -417,7 +417,7 @@ func (globalCatalog *GlobalCatalogV1) GetCatalogEntryWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetCatalogEntry", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -525,7 +525,7 @@ func (globalCatalog *GlobalCatalogV1) UpdateCatalogEntryWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "UpdateCatalogEntry", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -592,7 +592,7 @@ func (globalCatalog *GlobalCatalogV1) DeleteCatalogEntryWithContext(ctx context.
 		return
 	}
 
-	response, err = globalCatalog.Service.Request(request, nil)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "DeleteCatalogEntry", request, nil)
 
 	return
 }
@@ -672,7 +672,7 @@ func (globalCatalog *GlobalCatalogV1) GetChildObjectsWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetChildObjects", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -734,7 +734,7 @@ func (globalCatalog *GlobalCatalogV1) RestoreCatalogEntryWithContext(ctx context
 		return
 	}
 
-	response, err = globalCatalog.Service.Request(request, nil)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "RestoreCatalogEntry", request, nil)
 
 	return
 }
@@ -790,7 +790,7 @@ func (globalCatalog *GlobalCatalogV1) GetVisibilityWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetVisibility", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -869,7 +869,7 @@ func (globalCatalog *GlobalCatalogV1) UpdateVisibilityWithContext(ctx context.Co
 		return
 	}
 
-	response, err = globalCatalog.Service.Request(request, nil)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "UpdateVisibility", request, nil)
 
 	return
 }
@@ -924,7 +924,7 @@ func (globalCatalog *GlobalCatalogV1) GetPricingWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetPricing", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -990,7 +990,7 @@ func (globalCatalog *GlobalCatalogV1) GetPricingDeploymentsWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetPricingDeployments", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1066,7 +1066,7 @@ func (globalCatalog *GlobalCatalogV1) GetAuditLogsWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetAuditLogs", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1130,7 +1130,7 @@ func (globalCatalog *GlobalCatalogV1) ListArtifactsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalCatalog.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "ListArtifacts", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1197,7 +1197,7 @@ func (globalCatalog *GlobalCatalogV1) GetArtifactWithContext(ctx context.Context
 		return
 	}
 
-	response, err = globalCatalog.Service.Request(request, &result)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "GetArtifact", request, &result)
 
 	return
 }
@@ -1258,7 +1258,7 @@ func (globalCatalog *GlobalCatalogV1) UploadArtifactWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = globalCatalog.Service.Request(request, nil)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "UploadArtifact", request, nil)
 
 	return
 }
@@ -1311,7 +1311,7 @@ func (globalCatalog *GlobalCatalogV1) DeleteArtifactWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = globalCatalog.Service.Request(request, nil)
+	response, err = common.InvokeRequest(globalCatalog.Service, "global_catalog", "V1", "DeleteArtifact", request, nil)
 
 	return
 }
@@ -4875,3 +4875,113 @@ func UnmarshalVisibilityDetailAccounts(m map[string]json.RawMessage, result inte
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// CatalogEntriesPager can be used to simplify the use of the "ListCatalogEntries" method.
+type CatalogEntriesPager struct {
+	hasNext     bool
+	options     *ListCatalogEntriesOptions
+	client      *GlobalCatalogV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewCatalogEntriesPager returns a new CatalogEntriesPager instance.
+func (globalCatalog *GlobalCatalogV1) NewCatalogEntriesPager(options *ListCatalogEntriesOptions) (pager *CatalogEntriesPager, err error) {
+	if options.Offset != nil && *options.Offset != 0 {
+		err = fmt.Errorf("the 'options.Offset' field should not be set")
+		return
+	}
+
+	var optionsCopy ListCatalogEntriesOptions = *options
+	pager = &CatalogEntriesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalCatalog,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *CatalogEntriesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *CatalogEntriesPager) GetNextWithContext(ctx context.Context) (page []CatalogEntry, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListCatalogEntriesWithContext(ctx, pager.options)
+	if err != nil {
+		return
+	}
+
+	var next *int64
+	if result.Next != nil {
+		var offset *int64
+		offset, err = core.GetQueryParamAsInt(result.Next, "_offset")
+		if err != nil {
+			err = fmt.Errorf("error retrieving '_offset' query parameter from URL '%s': %s", *result.Next, err.Error())
+			return
+		}
+		next = offset
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Resources
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *CatalogEntriesPager) GetAllWithContext(ctx context.Context) (allItems []CatalogEntry, err error) {
+	for pager.HasNext() {
+		var nextPage []CatalogEntry
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *CatalogEntriesPager) GetNext() (page []CatalogEntry, err error) {
+	return pager.GetNextWithContext(context.Background())
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *CatalogEntriesPager) GetAll() (allItems []CatalogEntry, err error) {
+	return pager.GetAllWithContext(context.Background())
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeCatalogEntriesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *CatalogEntriesPager) GetCheckpoint() string {
+	return common.EncodePageToken("CatalogEntriesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeCatalogEntriesPager returns a new CatalogEntriesPager instance positioned at the checkpoint
+// returned by CatalogEntriesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (globalCatalog *GlobalCatalogV1) ResumeCatalogEntriesPager(options *ListCatalogEntriesOptions, checkpoint string) (pager *CatalogEntriesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("CatalogEntriesPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = globalCatalog.NewCatalogEntriesPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
@@ -442,6 +442,78 @@ var _ = Describe(`GlobalCatalogV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listCatalogEntriesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"offset":0,"limit":1,"resource_count":2,"next":"https://myhost.com/somePath?_offset=1","resources":[{"id":"ID","name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"offset":1,"limit":1,"resource_count":2,"resources":[{"id":"ID","name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use CatalogEntriesPager.GetNext successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				listCatalogEntriesOptionsModel := &globalcatalogv1.ListCatalogEntriesOptions{
+					Account: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := globalCatalogService.NewCatalogEntriesPager(listCatalogEntriesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalcatalogv1.CatalogEntry
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use CatalogEntriesPager.GetAll successfully`, func() {
+				globalCatalogService, serviceErr := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalCatalogService).ToNot(BeNil())
+
+				listCatalogEntriesOptionsModel := &globalcatalogv1.ListCatalogEntriesOptions{
+					Account: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := globalCatalogService.NewCatalogEntriesPager(listCatalogEntriesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateCatalogEntry(createCatalogEntryOptions *CreateCatalogEntryOptions) - Operation response error`, func() {
 		createCatalogEntryPath := "/"
@@ -0,0 +1,32 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globalsearchv2
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/crn"
+)
+
+// ParseCRN parses the CRN of the resource found by the search.
+func (o *ResultItem) ParseCRN() (*crn.CRN, error) {
+	parsed, err := crn.Parse(core.StringNilMapper(o.CRN))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-crn", common.GetComponentInfo())
+	}
+	return parsed, nil
+}
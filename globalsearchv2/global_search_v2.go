@@ -292,7 +292,7 @@ func (globalSearch *GlobalSearchV2) SearchWithContext(ctx context.Context, searc
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalSearch.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalSearch.Service, "global_search", "V2", "Search", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "search", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -651,3 +651,115 @@ func (options *SearchOptions) SetHeaders(param map[string]string) *SearchOptions
 	options.Headers = param
 	return options
 }
+
+// SearchPager can be used to simplify the use of the "Search" method.
+type SearchPager struct {
+	hasNext     bool
+	options     *SearchOptions
+	client      *GlobalSearchV2
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewSearchPager returns a new SearchPager instance.
+func (globalSearch *GlobalSearchV2) NewSearchPager(options *SearchOptions) (pager *SearchPager, err error) {
+	if options.SearchCursor != nil && *options.SearchCursor != "" {
+		err = core.SDKErrorf(nil, "the 'options.SearchCursor' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy SearchOptions = *options
+	pager = &SearchPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalSearch,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *SearchPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *SearchPager) GetNextWithContext(ctx context.Context) (page []ResultItem, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.SearchCursor = pager.pageContext.next
+
+	result, _, err := pager.client.SearchWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if len(result.Items) > 0 && (result.Limit == nil || int64(len(result.Items)) >= *result.Limit) {
+		next = result.SearchCursor
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Items
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *SearchPager) GetAllWithContext(ctx context.Context) (allItems []ResultItem, err error) {
+	for pager.HasNext() {
+		var nextPage []ResultItem
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetNext() (page []ResultItem, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *SearchPager) GetAll() (allItems []ResultItem, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeSearchPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *SearchPager) GetCheckpoint() string {
+	return common.EncodePageToken("SearchPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeSearchPager returns a new SearchPager instance positioned at the checkpoint
+// returned by SearchPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (globalSearch *GlobalSearchV2) ResumeSearchPager(options *SearchOptions, checkpoint string) (pager *SearchPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("SearchPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = globalSearch.NewSearchPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
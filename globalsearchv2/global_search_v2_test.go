@@ -508,6 +508,78 @@ var _ = Describe(`GlobalSearchV2`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(searchPath))
+					Expect(req.Method).To(Equal("POST"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"search_cursor":"cursor1","limit":1,"items":[{"crn":"CRN"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"search_cursor":"cursor2","limit":2,"items":[{"crn":"CRN"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use SearchPager.GetNext successfully`, func() {
+				globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalSearchService).ToNot(BeNil())
+
+				searchOptionsModel := &globalsearchv2.SearchOptions{
+					Query: core.StringPtr("name:Name"),
+					Limit: core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := globalSearchService.NewSearchPager(searchOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globalsearchv2.ResultItem
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use SearchPager.GetAll successfully`, func() {
+				globalSearchService, serviceErr := globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalSearchService).ToNot(BeNil())
+
+				searchOptionsModel := &globalsearchv2.SearchOptions{
+					Query: core.StringPtr("name:Name"),
+					Limit: core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := globalSearchService.NewSearchPager(searchOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
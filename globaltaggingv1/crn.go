@@ -0,0 +1,40 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package globaltaggingv1
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/crn"
+)
+
+// NewResourceFromCRN : Instantiate Resource for the resource identified by "resourceCRN".
+// An error is returned if the CRN is not valid.
+func (*GlobalTaggingV1) NewResourceFromCRN(resourceCRN *crn.CRN) (_model *Resource, err error) {
+	err = core.ValidateNotNil(resourceCRN, "resourceCRN cannot be nil")
+	if err == nil {
+		err = resourceCRN.Validate()
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-crn", common.GetComponentInfo())
+		return
+	}
+	_model = &Resource{
+		ResourceID: core.StringPtr(resourceCRN.String()),
+	}
+	return
+}
@@ -257,7 +257,7 @@ func (globalTagging *GlobalTaggingV1) ListTagsWithContext(ctx context.Context, l
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalTagging.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalTagging.Service, "global_tagging", "V1", "ListTags", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_tags", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -348,7 +348,7 @@ func (globalTagging *GlobalTaggingV1) CreateTagWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalTagging.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalTagging.Service, "global_tagging", "V1", "CreateTag", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_tag", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -424,7 +424,7 @@ func (globalTagging *GlobalTaggingV1) DeleteTagAllWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalTagging.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalTagging.Service, "global_tagging", "V1", "DeleteTagAll", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tag_all", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -509,7 +509,7 @@ func (globalTagging *GlobalTaggingV1) DeleteTagWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalTagging.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalTagging.Service, "global_tagging", "V1", "DeleteTag", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_tag", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -611,7 +611,7 @@ func (globalTagging *GlobalTaggingV1) AttachTagWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalTagging.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalTagging.Service, "global_tagging", "V1", "AttachTag", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "attach_tag", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -706,7 +706,7 @@ func (globalTagging *GlobalTaggingV1) DetachTagWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = globalTagging.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(globalTagging.Service, "global_tagging", "V1", "DetachTag", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "detach_tag", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1743,3 +1743,122 @@ func UnmarshalTagResultsItem(m map[string]json.RawMessage, result interface{}) (
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// TagsPager can be used to simplify the use of the "ListTags" method.
+type TagsPager struct {
+	hasNext     bool
+	options     *ListTagsOptions
+	client      *GlobalTaggingV1
+	pageContext struct {
+		next *int64
+	}
+}
+
+// NewTagsPager returns a new TagsPager instance.
+func (globalTagging *GlobalTaggingV1) NewTagsPager(options *ListTagsOptions) (pager *TagsPager, err error) {
+	if options.Offset != nil && *options.Offset != 0 {
+		err = core.SDKErrorf(nil, "the 'options.Offset' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListTagsOptions = *options
+	pager = &TagsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  globalTagging,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *TagsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *TagsPager) GetNextWithContext(ctx context.Context) (page []Tag, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Offset = pager.pageContext.next
+
+	result, _, err := pager.client.ListTagsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *int64
+	if result.TotalCount != nil && len(result.Items) > 0 {
+		var offset int64
+		if result.Offset != nil {
+			offset = *result.Offset
+		}
+		offset += int64(len(result.Items))
+		if offset < *result.TotalCount {
+			next = core.Int64Ptr(offset)
+		}
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Items
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *TagsPager) GetAllWithContext(ctx context.Context) (allItems []Tag, err error) {
+	for pager.HasNext() {
+		var nextPage []Tag
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *TagsPager) GetNext() (page []Tag, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *TagsPager) GetAll() (allItems []Tag, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeTagsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *TagsPager) GetCheckpoint() string {
+	return common.EncodePageToken("TagsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeTagsPager returns a new TagsPager instance positioned at the checkpoint
+// returned by TagsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (globalTagging *GlobalTaggingV1) ResumeTagsPager(options *ListTagsOptions, checkpoint string) (pager *TagsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("TagsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = globalTagging.NewTagsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
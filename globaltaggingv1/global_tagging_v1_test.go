@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -28,6 +29,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/crn"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -462,6 +464,78 @@ var _ = Describe(`GlobalTaggingV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listTagsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"total_count":2,"offset":0,"limit":1,"items":[{"name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"total_count":2,"offset":1,"limit":1,"items":[{"name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use TagsPager.GetNext successfully`, func() {
+				globalTaggingService, serviceErr := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalTaggingService).ToNot(BeNil())
+
+				listTagsOptionsModel := &globaltaggingv1.ListTagsOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := globalTaggingService.NewTagsPager(listTagsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []globaltaggingv1.Tag
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use TagsPager.GetAll successfully`, func() {
+				globalTaggingService, serviceErr := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(globalTaggingService).ToNot(BeNil())
+
+				listTagsOptionsModel := &globaltaggingv1.ListTagsOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(1)),
+				}
+
+				pager, err := globalTaggingService.NewTagsPager(listTagsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateTag(createTagOptions *CreateTagOptions) - Operation response error`, func() {
 		createTagPath := "/v3/tags"
@@ -1896,6 +1970,26 @@ var _ = Describe(`GlobalTaggingV1`, func() {
 			})
 		})
 	})
+	Describe(`CRN helpers`, func() {
+		globalTaggingService, _ := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+			URL:           "http://globaltaggingv1modelgenerator.com",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		It(`Invoke NewResourceFromCRN successfully`, func() {
+			resourceCRN := crn.New("cloud-object-storage", "global", "account")
+			resourceCRN.ServiceInstance = "instance"
+			resource, err := globalTaggingService.NewResourceFromCRN(resourceCRN)
+			Expect(err).To(BeNil())
+			Expect(*resource.ResourceID).To(Equal("crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance::"))
+			Expect(resource.ResourceType).To(BeNil())
+		})
+		It(`Invoke NewResourceFromCRN with an invalid CRN`, func() {
+			_, err := globalTaggingService.NewResourceFromCRN(&crn.CRN{Version: "v1"})
+			Expect(errors.Is(err, crn.ErrInvalidCRN)).To(BeTrue())
+			_, err = globalTaggingService.NewResourceFromCRN(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			globalTaggingService, _ := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
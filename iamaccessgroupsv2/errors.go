@@ -0,0 +1,67 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iamaccessgroupsv2
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ErrorResponse : The body of an error response returned by the IAM Access Groups service.
+type ErrorResponse struct {
+	// The unique transaction id for the request.
+	Trace *string `json:"trace,omitempty"`
+
+	// The errors encountered during the request.
+	Errors []Error `json:"errors,omitempty"`
+
+	// The http error code of the response.
+	StatusCode *int64 `json:"status_code,omitempty"`
+}
+
+// UnmarshalErrorResponse unmarshals an instance of ErrorResponse from the specified map of raw messages.
+func UnmarshalErrorResponse(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(ErrorResponse)
+	err = core.UnmarshalPrimitive(m, "trace", &obj.Trace)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalModel(m, "errors", &obj.Errors, UnmarshalError)
+	if err != nil {
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "status_code", &obj.StatusCode)
+	if err != nil {
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
+// GetErrorResponse returns the ErrorResponse contained in the body of the error response
+// that caused "err", which is an error returned by one of the IamAccessGroupsV2 methods.
+// It returns nil if "err" was not caused by an error response with an ErrorResponse body.
+func GetErrorResponse(err error) *ErrorResponse {
+	var result *ErrorResponse
+	if !common.DecodeErrorResponse(err, &result, UnmarshalErrorResponse) {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package iamaccessgroupsv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ModifyAccessGroup performs a read-modify-write update of the access group "accessGroupID".
+// The group is retrieved, "mutate" is applied to it, and its name and description are sent to
+// UpdateAccessGroup() with the ETag of the retrieved group. If the group is modified concurrently,
+// the update is retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the group unchanged.
+func (iamAccessGroups *IamAccessGroupsV2) ModifyAccessGroup(ctx context.Context, accessGroupID string, mutate func(*Group) error,
+	options *common.UpdateOptions) (result *Group, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*Group, *core.DetailedResponse, error) {
+		return iamAccessGroups.GetAccessGroupWithContext(ctx, iamAccessGroups.NewGetAccessGroupOptions(accessGroupID))
+	}
+	replace := func(ctx context.Context, group *Group, etag string) (*Group, *core.DetailedResponse, error) {
+		updateOptions := &UpdateAccessGroupOptions{
+			AccessGroupID: core.StringPtr(accessGroupID),
+			IfMatch:       core.StringPtr(etag),
+			Name:          group.Name,
+			Description:   group.Description,
+		}
+		return iamAccessGroups.UpdateAccessGroupWithContext(ctx, updateOptions)
+	}
+	return common.UpdateWithETag(ctx, get, mutate, replace, options)
+}
+
+// ModifyAccessGroupRule performs a read-modify-write update of the rule "ruleID" of the access
+// group "accessGroupID".
+// The rule is retrieved, "mutate" is applied to it, and the result is sent to ReplaceAccessGroupRule()
+// with the ETag of the retrieved rule. If the rule is modified concurrently, the update is
+// retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the rule unchanged.
+func (iamAccessGroups *IamAccessGroupsV2) ModifyAccessGroupRule(ctx context.Context, accessGroupID string, ruleID string, mutate func(*Rule) error,
+	options *common.UpdateOptions) (result *Rule, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*Rule, *core.DetailedResponse, error) {
+		return iamAccessGroups.GetAccessGroupRuleWithContext(ctx, iamAccessGroups.NewGetAccessGroupRuleOptions(accessGroupID, ruleID))
+	}
+	replace := func(ctx context.Context, rule *Rule, etag string) (*Rule, *core.DetailedResponse, error) {
+		replaceOptions := &ReplaceAccessGroupRuleOptions{
+			AccessGroupID: core.StringPtr(accessGroupID),
+			RuleID:        core.StringPtr(ruleID),
+			IfMatch:       core.StringPtr(etag),
+			Expiration:    rule.Expiration,
+			RealmName:     rule.RealmName,
+			Conditions:    rule.Conditions,
+			Name:          rule.Name,
+		}
+		return iamAccessGroups.ReplaceAccessGroupRuleWithContext(ctx, replaceOptions)
+	}
+	return common.UpdateWithETag(ctx, get, mutate, replace, options)
+}
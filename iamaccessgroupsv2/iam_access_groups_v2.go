@@ -224,7 +224,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateAccessGroupWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "CreateAccessGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -312,7 +312,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupsWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ListAccessGroups", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -381,7 +381,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "GetAccessGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -461,7 +461,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAccessGroupWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "UpdateAccessGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -528,7 +528,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteAccessGroupWithContext(ctx conte
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "DeleteAccessGroup", request, nil)
 
 	return
 }
@@ -582,7 +582,7 @@ func (iamAccessGroups *IamAccessGroupsV2) IsMemberOfAccessGroupWithContext(ctx c
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "IsMemberOfAccessGroup", request, nil)
 
 	return
 }
@@ -649,7 +649,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddMembersToAccessGroupWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "AddMembersToAccessGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -734,7 +734,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupMembersWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ListAccessGroupMembers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -798,7 +798,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAccessGroupWithContext
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "RemoveMemberFromAccessGroup", request, nil)
 
 	return
 }
@@ -864,7 +864,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMembersFromAccessGroupWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "RemoveMembersFromAccessGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -931,7 +931,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveMemberFromAllAccessGroupsWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "RemoveMemberFromAllAccessGroups", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1010,7 +1010,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddMemberToMultipleAccessGroupsWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "AddMemberToMultipleAccessGroups", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1096,7 +1096,7 @@ func (iamAccessGroups *IamAccessGroupsV2) AddAccessGroupRuleWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "AddAccessGroupRule", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1160,7 +1160,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAccessGroupRulesWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ListAccessGroupRules", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1225,7 +1225,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccessGroupRuleWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "GetAccessGroupRule", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1312,7 +1312,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ReplaceAccessGroupRuleWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ReplaceAccessGroupRule", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1375,7 +1375,7 @@ func (iamAccessGroups *IamAccessGroupsV2) RemoveAccessGroupRuleWithContext(ctx c
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "RemoveAccessGroupRule", request, nil)
 
 	return
 }
@@ -1426,7 +1426,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAccountSettingsWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "GetAccountSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1499,7 +1499,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAccountSettingsWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "UpdateAccountSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1582,7 +1582,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateTemplateWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "CreateTemplate", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1652,7 +1652,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListTemplatesWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ListTemplates", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1734,7 +1734,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateTemplateVersionWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "CreateTemplateVersion", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1801,7 +1801,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListTemplateVersionsWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ListTemplateVersions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1869,7 +1869,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetTemplateVersionWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "GetTemplateVersion", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -1956,7 +1956,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateTemplateVersionWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "UpdateTemplateVersion", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2018,7 +2018,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteTemplateVersionWithContext(ctx c
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "DeleteTemplateVersion", request, nil)
 
 	return
 }
@@ -2074,7 +2074,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CommitTemplateWithContext(ctx context.
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "CommitTemplate", request, nil)
 
 	return
 }
@@ -2131,7 +2131,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetLatestTemplateVersionWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "GetLatestTemplateVersion", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2193,7 +2193,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteTemplateWithContext(ctx context.
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "DeleteTemplate", request, nil)
 
 	return
 }
@@ -2262,7 +2262,7 @@ func (iamAccessGroups *IamAccessGroupsV2) CreateAssignmentWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "CreateAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2341,7 +2341,7 @@ func (iamAccessGroups *IamAccessGroupsV2) ListAssignmentsWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "ListAssignments", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2408,7 +2408,7 @@ func (iamAccessGroups *IamAccessGroupsV2) GetAssignmentWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "GetAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2481,7 +2481,7 @@ func (iamAccessGroups *IamAccessGroupsV2) UpdateAssignmentWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamAccessGroups.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "UpdateAssignment", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -2542,7 +2542,7 @@ func (iamAccessGroups *IamAccessGroupsV2) DeleteAssignmentWithContext(ctx contex
 		return
 	}
 
-	response, err = iamAccessGroups.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamAccessGroups.Service, "iam_access_groups", "V2", "DeleteAssignment", request, nil)
 
 	return
 }
@@ -6912,6 +6912,31 @@ func (pager *AccessGroupsPager) GetAll() (allItems []Group, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeAccessGroupsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *AccessGroupsPager) GetCheckpoint() string {
+	return common.EncodePageToken("AccessGroupsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeAccessGroupsPager returns a new AccessGroupsPager instance positioned at the checkpoint
+// returned by AccessGroupsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamAccessGroups *IamAccessGroupsV2) ResumeAccessGroupsPager(options *ListAccessGroupsOptions, checkpoint string) (pager *AccessGroupsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("AccessGroupsPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = iamAccessGroups.NewAccessGroupsPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // AccessGroupMembersPager can be used to simplify the use of the "ListAccessGroupMembers" method.
 //
@@ -6999,6 +7024,31 @@ func (pager *AccessGroupMembersPager) GetAll() (allItems []ListGroupMembersRespo
 	return pager.GetAllWithContext(context.Background())
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeAccessGroupMembersPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *AccessGroupMembersPager) GetCheckpoint() string {
+	return common.EncodePageToken("AccessGroupMembersPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeAccessGroupMembersPager returns a new AccessGroupMembersPager instance positioned at the checkpoint
+// returned by AccessGroupMembersPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamAccessGroups *IamAccessGroupsV2) ResumeAccessGroupMembersPager(options *ListAccessGroupMembersOptions, checkpoint string) (pager *AccessGroupMembersPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("AccessGroupMembersPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = iamAccessGroups.NewAccessGroupMembersPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // TemplatesPager can be used to simplify the use of the "ListTemplates" method.
 //
@@ -7086,6 +7136,31 @@ func (pager *TemplatesPager) GetAll() (allItems []GroupTemplate, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeTemplatesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *TemplatesPager) GetCheckpoint() string {
+	return common.EncodePageToken("TemplatesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeTemplatesPager returns a new TemplatesPager instance positioned at the checkpoint
+// returned by TemplatesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamAccessGroups *IamAccessGroupsV2) ResumeTemplatesPager(options *ListTemplatesOptions, checkpoint string) (pager *TemplatesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("TemplatesPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = iamAccessGroups.NewTemplatesPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // TemplateVersionsPager can be used to simplify the use of the "ListTemplateVersions" method.
 //
@@ -7172,3 +7247,28 @@ func (pager *TemplateVersionsPager) GetNext() (page []ListTemplateVersionRespons
 func (pager *TemplateVersionsPager) GetAll() (allItems []ListTemplateVersionResponse, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeTemplateVersionsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *TemplateVersionsPager) GetCheckpoint() string {
+	return common.EncodePageToken("TemplateVersionsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeTemplateVersionsPager returns a new TemplateVersionsPager instance positioned at the checkpoint
+// returned by TemplateVersionsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamAccessGroups *IamAccessGroupsV2) ResumeTemplateVersionsPager(options *ListTemplateVersionsOptions, checkpoint string) (pager *TemplateVersionsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[int64]("TemplateVersionsPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = iamAccessGroups.NewTemplateVersionsPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
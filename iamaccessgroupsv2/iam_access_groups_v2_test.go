@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -8900,6 +8901,50 @@ var _ = Describe(`IamAccessGroupsV2`, func() {
 			})
 		})
 	})
+	Describe(`GetErrorResponse(err error)`, func() {
+		Context(`Using mock server endpoint with an error response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal("/v2/groups/testString"))
+					Expect(req.Method).To(Equal("DELETE"))
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"trace": "testString", "errors": [{"code": "not_found", "message": "Group not found."}], "status_code": 404}`)
+				}))
+			})
+			It(`Invoke GetErrorResponse successfully`, func() {
+				iamAccessGroupsService, serviceErr := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamAccessGroupsService).ToNot(BeNil())
+
+				response, operationErr := iamAccessGroupsService.DeleteAccessGroup(iamAccessGroupsService.NewDeleteAccessGroupOptions("testString"))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(common.IsNotFound(operationErr)).To(BeTrue())
+
+				errorResponse := iamaccessgroupsv2.GetErrorResponse(operationErr)
+				Expect(errorResponse).ToNot(BeNil())
+				Expect(*errorResponse.Trace).To(Equal("testString"))
+				Expect(*errorResponse.StatusCode).To(Equal(int64(404)))
+				Expect(errorResponse.Errors).To(HaveLen(1))
+				Expect(*errorResponse.Errors[0].Code).To(Equal("not_found"))
+			})
+			It(`Invoke GetErrorResponse with an error that has no error response`, func() {
+				Expect(iamaccessgroupsv2.GetErrorResponse(nil)).To(BeNil())
+				Expect(iamaccessgroupsv2.GetErrorResponse(fmt.Errorf("not an error response"))).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			iamAccessGroupsService, _ := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
@@ -0,0 +1,101 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package iamidentityv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ModifyAPIKey performs a read-modify-write update of the API key "id".
+// The API key is retrieved, "mutate" is applied to it, and its updatable properties are sent to
+// UpdateAPIKey() with the ETag of the retrieved API key. If the API key is modified concurrently,
+// the update is retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the API key unchanged.
+func (iamIdentity *IamIdentityV1) ModifyAPIKey(ctx context.Context, id string, mutate func(*APIKey) error,
+	options *common.UpdateOptions) (result *APIKey, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*APIKey, *core.DetailedResponse, error) {
+		return iamIdentity.GetAPIKeyWithContext(ctx, iamIdentity.NewGetAPIKeyOptions(id))
+	}
+	replace := func(ctx context.Context, apiKey *APIKey, etag string) (*APIKey, *core.DetailedResponse, error) {
+		updateOptions := &UpdateAPIKeyOptions{
+			ID:               core.StringPtr(id),
+			IfMatch:          core.StringPtr(etag),
+			Name:             apiKey.Name,
+			Description:      apiKey.Description,
+			SupportSessions:  apiKey.SupportSessions,
+			ActionWhenLeaked: apiKey.ActionWhenLeaked,
+		}
+		return iamIdentity.UpdateAPIKeyWithContext(ctx, updateOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
+
+// ModifyServiceID performs a read-modify-write update of the service ID "id".
+// The service ID is retrieved, "mutate" is applied to it, and its updatable properties are sent to
+// UpdateServiceID() with the ETag of the retrieved service ID. If the service ID is modified
+// concurrently, the update is retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the service ID unchanged.
+func (iamIdentity *IamIdentityV1) ModifyServiceID(ctx context.Context, id string, mutate func(*ServiceID) error,
+	options *common.UpdateOptions) (result *ServiceID, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*ServiceID, *core.DetailedResponse, error) {
+		return iamIdentity.GetServiceIDWithContext(ctx, iamIdentity.NewGetServiceIDOptions(id))
+	}
+	replace := func(ctx context.Context, serviceID *ServiceID, etag string) (*ServiceID, *core.DetailedResponse, error) {
+		updateOptions := &UpdateServiceIDOptions{
+			ID:                 core.StringPtr(id),
+			IfMatch:            core.StringPtr(etag),
+			Name:               serviceID.Name,
+			Description:        serviceID.Description,
+			UniqueInstanceCrns: serviceID.UniqueInstanceCrns,
+		}
+		return iamIdentity.UpdateServiceIDWithContext(ctx, updateOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
+
+// ModifyProfile performs a read-modify-write update of the trusted profile "profileID".
+// The profile is retrieved, "mutate" is applied to it, and its name and description are sent to
+// UpdateProfile() with the ETag of the retrieved profile. If the profile is modified concurrently,
+// the update is retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the profile unchanged.
+func (iamIdentity *IamIdentityV1) ModifyProfile(ctx context.Context, profileID string, mutate func(*TrustedProfile) error,
+	options *common.UpdateOptions) (result *TrustedProfile, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*TrustedProfile, *core.DetailedResponse, error) {
+		return iamIdentity.GetProfileWithContext(ctx, iamIdentity.NewGetProfileOptions(profileID))
+	}
+	replace := func(ctx context.Context, profile *TrustedProfile, etag string) (*TrustedProfile, *core.DetailedResponse, error) {
+		updateOptions := &UpdateProfileOptions{
+			ProfileID:   core.StringPtr(profileID),
+			IfMatch:     core.StringPtr(etag),
+			Name:        profile.Name,
+			Description: profile.Description,
+		}
+		return iamIdentity.UpdateProfileWithContext(ctx, updateOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
@@ -242,7 +242,7 @@ func (iamIdentity *IamIdentityV1) ListAPIKeysWithContext(ctx context.Context, li
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListAPIKeys", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_api_keys", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -346,7 +346,7 @@ func (iamIdentity *IamIdentityV1) CreateAPIKeyWithContext(ctx context.Context, c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateAPIKey", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -414,7 +414,7 @@ func (iamIdentity *IamIdentityV1) GetAPIKeysDetailsWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetAPIKeysDetails", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_api_keys_details", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -491,7 +491,7 @@ func (iamIdentity *IamIdentityV1) GetAPIKeyWithContext(ctx context.Context, getA
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetAPIKey", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -586,7 +586,7 @@ func (iamIdentity *IamIdentityV1) UpdateAPIKeyWithContext(ctx context.Context, u
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateAPIKey", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -654,7 +654,7 @@ func (iamIdentity *IamIdentityV1) DeleteAPIKeyWithContext(ctx context.Context, d
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteAPIKey", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -714,7 +714,7 @@ func (iamIdentity *IamIdentityV1) LockAPIKeyWithContext(ctx context.Context, loc
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "LockAPIKey", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "lock_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -774,7 +774,7 @@ func (iamIdentity *IamIdentityV1) UnlockAPIKeyWithContext(ctx context.Context, u
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UnlockAPIKey", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "unlock_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -834,7 +834,7 @@ func (iamIdentity *IamIdentityV1) DisableAPIKeyWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DisableAPIKey", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "disable_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -894,7 +894,7 @@ func (iamIdentity *IamIdentityV1) EnableAPIKeyWithContext(ctx context.Context, e
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "EnableAPIKey", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "enable_api_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -970,7 +970,7 @@ func (iamIdentity *IamIdentityV1) ListServiceIdsWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListServiceIds", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_service_ids", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1062,7 +1062,7 @@ func (iamIdentity *IamIdentityV1) CreateServiceIDWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateServiceID", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_service_id", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1140,7 +1140,7 @@ func (iamIdentity *IamIdentityV1) GetServiceIDWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetServiceID", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_service_id", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1233,7 +1233,7 @@ func (iamIdentity *IamIdentityV1) UpdateServiceIDWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateServiceID", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_service_id", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1303,7 +1303,7 @@ func (iamIdentity *IamIdentityV1) DeleteServiceIDWithContext(ctx context.Context
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteServiceID", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_service_id", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1363,7 +1363,7 @@ func (iamIdentity *IamIdentityV1) LockServiceIDWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "LockServiceID", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "lock_service_id", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1423,7 +1423,7 @@ func (iamIdentity *IamIdentityV1) UnlockServiceIDWithContext(ctx context.Context
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UnlockServiceID", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "unlock_service_id", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1497,7 +1497,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateProfile", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1583,7 +1583,7 @@ func (iamIdentity *IamIdentityV1) ListProfilesWithContext(ctx context.Context, l
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListProfiles", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profiles", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1657,7 +1657,7 @@ func (iamIdentity *IamIdentityV1) GetProfileWithContext(ctx context.Context, get
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetProfile", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1743,7 +1743,7 @@ func (iamIdentity *IamIdentityV1) UpdateProfileWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateProfile", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_profile", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1811,7 +1811,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileWithContext(ctx context.Context,
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteProfile", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1901,7 +1901,7 @@ func (iamIdentity *IamIdentityV1) CreateClaimRuleWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateClaimRule", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_claim_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1971,7 +1971,7 @@ func (iamIdentity *IamIdentityV1) ListClaimRulesWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListClaimRules", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_claim_rules", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2041,7 +2041,7 @@ func (iamIdentity *IamIdentityV1) GetClaimRuleWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetClaimRule", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_claim_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2143,7 +2143,7 @@ func (iamIdentity *IamIdentityV1) UpdateClaimRuleWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateClaimRule", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_claim_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2212,7 +2212,7 @@ func (iamIdentity *IamIdentityV1) DeleteClaimRuleWithContext(ctx context.Context
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteClaimRule", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_claim_rule", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2291,7 +2291,7 @@ func (iamIdentity *IamIdentityV1) CreateLinkWithContext(ctx context.Context, cre
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateLink", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_link", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2360,7 +2360,7 @@ func (iamIdentity *IamIdentityV1) ListLinksWithContext(ctx context.Context, list
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListLinks", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_links", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2430,7 +2430,7 @@ func (iamIdentity *IamIdentityV1) GetLinkWithContext(ctx context.Context, getLin
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetLink", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_link", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2498,7 +2498,7 @@ func (iamIdentity *IamIdentityV1) DeleteLinkWithContext(ctx context.Context, del
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteLink", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_link", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2559,7 +2559,7 @@ func (iamIdentity *IamIdentityV1) GetProfileIdentitiesWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetProfileIdentities", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_identities", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2642,7 +2642,7 @@ func (iamIdentity *IamIdentityV1) SetProfileIdentitiesWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "SetProfileIdentities", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_profile_identities", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2732,7 +2732,7 @@ func (iamIdentity *IamIdentityV1) SetProfileIdentityWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "SetProfileIdentity", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "set_profile_identity", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2803,7 +2803,7 @@ func (iamIdentity *IamIdentityV1) GetProfileIdentityWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetProfileIdentity", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_identity", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2872,7 +2872,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileIdentityWithContext(ctx context.C
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteProfileIdentity", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile_identity", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2937,7 +2937,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetAccountSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "getAccountSettings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3048,7 +3048,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateAccountSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "updateAccountSettings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3119,7 +3119,7 @@ func (iamIdentity *IamIdentityV1) GetMfaStatusWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetMfaStatus", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_mfa_status", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3193,7 +3193,7 @@ func (iamIdentity *IamIdentityV1) CreateMfaReportWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateMfaReport", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_mfa_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3264,7 +3264,7 @@ func (iamIdentity *IamIdentityV1) GetMfaReportWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetMfaReport", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_mfa_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3355,7 +3355,7 @@ func (iamIdentity *IamIdentityV1) ListAccountSettingsAssignmentsWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListAccountSettingsAssignments", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_account_settings_assignments", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3440,7 +3440,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateAccountSettingsAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_settings_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3513,7 +3513,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsAssignmentWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetAccountSettingsAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3583,7 +3583,7 @@ func (iamIdentity *IamIdentityV1) DeleteAccountSettingsAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteAccountSettingsAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account_settings_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3667,7 +3667,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateAccountSettingsAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_settings_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3746,7 +3746,7 @@ func (iamIdentity *IamIdentityV1) ListAccountSettingsTemplatesWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListAccountSettingsTemplates", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_account_settings_templates", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3831,7 +3831,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsTemplateWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateAccountSettingsTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_settings_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3904,7 +3904,7 @@ func (iamIdentity *IamIdentityV1) GetLatestAccountSettingsTemplateVersionWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetLatestAccountSettingsTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_latest_account_settings_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -3972,7 +3972,7 @@ func (iamIdentity *IamIdentityV1) DeleteAllVersionsOfAccountSettingsTemplateWith
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteAllVersionsOfAccountSettingsTemplate", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_all_versions_of_account_settings_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4049,7 +4049,7 @@ func (iamIdentity *IamIdentityV1) ListVersionsOfAccountSettingsTemplateWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListVersionsOfAccountSettingsTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_versions_of_account_settings_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4138,7 +4138,7 @@ func (iamIdentity *IamIdentityV1) CreateAccountSettingsTemplateVersionWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateAccountSettingsTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_account_settings_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4212,7 +4212,7 @@ func (iamIdentity *IamIdentityV1) GetAccountSettingsTemplateVersionWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetAccountSettingsTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_settings_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4305,7 +4305,7 @@ func (iamIdentity *IamIdentityV1) UpdateAccountSettingsTemplateVersionWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateAccountSettingsTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_account_settings_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4373,7 +4373,7 @@ func (iamIdentity *IamIdentityV1) DeleteAccountSettingsTemplateVersionWithContex
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteAccountSettingsTemplateVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_account_settings_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4434,7 +4434,7 @@ func (iamIdentity *IamIdentityV1) CommitAccountSettingsTemplateWithContext(ctx c
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CommitAccountSettingsTemplate", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_account_settings_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4503,7 +4503,7 @@ func (iamIdentity *IamIdentityV1) CreateReportWithContext(ctx context.Context, c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateReport", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4574,7 +4574,7 @@ func (iamIdentity *IamIdentityV1) GetReportWithContext(ctx context.Context, getR
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetReport", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4650,7 +4650,7 @@ func (iamIdentity *IamIdentityV1) GetEffectiveAccountSettingsWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetEffectiveAccountSettings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_effective_account_settings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4741,7 +4741,7 @@ func (iamIdentity *IamIdentityV1) ListTrustedProfileAssignmentsWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListTrustedProfileAssignments", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_trusted_profile_assignments", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4826,7 +4826,7 @@ func (iamIdentity *IamIdentityV1) CreateTrustedProfileAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateTrustedProfileAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_trusted_profile_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4899,7 +4899,7 @@ func (iamIdentity *IamIdentityV1) GetTrustedProfileAssignmentWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetTrustedProfileAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_trusted_profile_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4968,7 +4968,7 @@ func (iamIdentity *IamIdentityV1) DeleteTrustedProfileAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteTrustedProfileAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_trusted_profile_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5052,7 +5052,7 @@ func (iamIdentity *IamIdentityV1) UpdateTrustedProfileAssignmentWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateTrustedProfileAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_trusted_profile_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5131,7 +5131,7 @@ func (iamIdentity *IamIdentityV1) ListProfileTemplatesWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListProfileTemplates", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_profile_templates", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5222,7 +5222,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileTemplateWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateProfileTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5295,7 +5295,7 @@ func (iamIdentity *IamIdentityV1) GetLatestProfileTemplateVersionWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetLatestProfileTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_latest_profile_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5363,7 +5363,7 @@ func (iamIdentity *IamIdentityV1) DeleteAllVersionsOfProfileTemplateWithContext(
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteAllVersionsOfProfileTemplate", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_all_versions_of_profile_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5440,7 +5440,7 @@ func (iamIdentity *IamIdentityV1) ListVersionsOfProfileTemplateWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "ListVersionsOfProfileTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_versions_of_profile_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5535,7 +5535,7 @@ func (iamIdentity *IamIdentityV1) CreateProfileTemplateVersionWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CreateProfileTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_profile_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5609,7 +5609,7 @@ func (iamIdentity *IamIdentityV1) GetProfileTemplateVersionWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "GetProfileTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_profile_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5708,7 +5708,7 @@ func (iamIdentity *IamIdentityV1) UpdateProfileTemplateVersionWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamIdentity.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "UpdateProfileTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_profile_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5777,7 +5777,7 @@ func (iamIdentity *IamIdentityV1) DeleteProfileTemplateVersionWithContext(ctx co
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "DeleteProfileTemplateVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_profile_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5838,7 +5838,7 @@ func (iamIdentity *IamIdentityV1) CommitProfileTemplateWithContext(ctx context.C
 		return
 	}
 
-	response, err = iamIdentity.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamIdentity.Service, "iam_identity", "V1", "CommitProfileTemplate", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_profile_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -14387,3 +14387,479 @@ func UnmarshalUserReportMfaEnrollmentStatus(m map[string]json.RawMessage, result
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// APIKeysPager can be used to simplify the use of the "ListAPIKeys" method.
+type APIKeysPager struct {
+	hasNext     bool
+	options     *ListAPIKeysOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewAPIKeysPager returns a new APIKeysPager instance.
+func (iamIdentity *IamIdentityV1) NewAPIKeysPager(options *ListAPIKeysOptions) (pager *APIKeysPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListAPIKeysOptions = *options
+	pager = &APIKeysPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *APIKeysPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *APIKeysPager) GetNextWithContext(ctx context.Context) (page []APIKey, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListAPIKeysWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Apikeys
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *APIKeysPager) GetAllWithContext(ctx context.Context) (allItems []APIKey, err error) {
+	for pager.HasNext() {
+		var nextPage []APIKey
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *APIKeysPager) GetNext() (page []APIKey, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *APIKeysPager) GetAll() (allItems []APIKey, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeAPIKeysPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *APIKeysPager) GetCheckpoint() string {
+	return common.EncodePageToken("APIKeysPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeAPIKeysPager returns a new APIKeysPager instance positioned at the checkpoint
+// returned by APIKeysPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamIdentity *IamIdentityV1) ResumeAPIKeysPager(options *ListAPIKeysOptions, checkpoint string) (pager *APIKeysPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("APIKeysPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = iamIdentity.NewAPIKeysPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
+// ServiceIdsPager can be used to simplify the use of the "ListServiceIds" method.
+type ServiceIdsPager struct {
+	hasNext     bool
+	options     *ListServiceIdsOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewServiceIdsPager returns a new ServiceIdsPager instance.
+func (iamIdentity *IamIdentityV1) NewServiceIdsPager(options *ListServiceIdsOptions) (pager *ServiceIdsPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListServiceIdsOptions = *options
+	pager = &ServiceIdsPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ServiceIdsPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ServiceIdsPager) GetNextWithContext(ctx context.Context) (page []ServiceID, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListServiceIdsWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Serviceids
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ServiceIdsPager) GetAllWithContext(ctx context.Context) (allItems []ServiceID, err error) {
+	for pager.HasNext() {
+		var nextPage []ServiceID
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ServiceIdsPager) GetNext() (page []ServiceID, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ServiceIdsPager) GetAll() (allItems []ServiceID, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeServiceIdsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ServiceIdsPager) GetCheckpoint() string {
+	return common.EncodePageToken("ServiceIdsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeServiceIdsPager returns a new ServiceIdsPager instance positioned at the checkpoint
+// returned by ServiceIdsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamIdentity *IamIdentityV1) ResumeServiceIdsPager(options *ListServiceIdsOptions, checkpoint string) (pager *ServiceIdsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ServiceIdsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = iamIdentity.NewServiceIdsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
+// ProfilesPager can be used to simplify the use of the "ListProfiles" method.
+type ProfilesPager struct {
+	hasNext     bool
+	options     *ListProfilesOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewProfilesPager returns a new ProfilesPager instance.
+func (iamIdentity *IamIdentityV1) NewProfilesPager(options *ListProfilesOptions) (pager *ProfilesPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListProfilesOptions = *options
+	pager = &ProfilesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ProfilesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ProfilesPager) GetNextWithContext(ctx context.Context) (page []TrustedProfile, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListProfilesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Profiles
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ProfilesPager) GetAllWithContext(ctx context.Context) (allItems []TrustedProfile, err error) {
+	for pager.HasNext() {
+		var nextPage []TrustedProfile
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ProfilesPager) GetNext() (page []TrustedProfile, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ProfilesPager) GetAll() (allItems []TrustedProfile, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeProfilesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ProfilesPager) GetCheckpoint() string {
+	return common.EncodePageToken("ProfilesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeProfilesPager returns a new ProfilesPager instance positioned at the checkpoint
+// returned by ProfilesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamIdentity *IamIdentityV1) ResumeProfilesPager(options *ListProfilesOptions, checkpoint string) (pager *ProfilesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ProfilesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = iamIdentity.NewProfilesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
+// ProfileTemplatesPager can be used to simplify the use of the "ListProfileTemplates" method.
+type ProfileTemplatesPager struct {
+	hasNext     bool
+	options     *ListProfileTemplatesOptions
+	client      *IamIdentityV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewProfileTemplatesPager returns a new ProfileTemplatesPager instance.
+func (iamIdentity *IamIdentityV1) NewProfileTemplatesPager(options *ListProfileTemplatesOptions) (pager *ProfileTemplatesPager, err error) {
+	if options.Pagetoken != nil && *options.Pagetoken != "" {
+		err = core.SDKErrorf(nil, "the 'options.Pagetoken' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListProfileTemplatesOptions = *options
+	pager = &ProfileTemplatesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamIdentity,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *ProfileTemplatesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *ProfileTemplatesPager) GetNextWithContext(ctx context.Context) (page []TrustedProfileTemplateResponse, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Pagetoken = pager.pageContext.next
+
+	result, _, err := pager.client.ListProfileTemplatesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		var pagetoken *string
+		pagetoken, err = core.GetQueryParam(result.Next, "pagetoken")
+		if err != nil {
+			errMsg := fmt.Sprintf("error retrieving 'pagetoken' query parameter from URL '%s': %s", *result.Next, err.Error())
+			err = core.SDKErrorf(err, errMsg, "get-query-error", common.GetComponentInfo())
+			return
+		}
+		next = pagetoken
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.ProfileTemplates
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *ProfileTemplatesPager) GetAllWithContext(ctx context.Context) (allItems []TrustedProfileTemplateResponse, err error) {
+	for pager.HasNext() {
+		var nextPage []TrustedProfileTemplateResponse
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *ProfileTemplatesPager) GetNext() (page []TrustedProfileTemplateResponse, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *ProfileTemplatesPager) GetAll() (allItems []TrustedProfileTemplateResponse, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeProfileTemplatesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ProfileTemplatesPager) GetCheckpoint() string {
+	return common.EncodePageToken("ProfileTemplatesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeProfileTemplatesPager returns a new ProfileTemplatesPager instance positioned at the checkpoint
+// returned by ProfileTemplatesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamIdentity *IamIdentityV1) ResumeProfileTemplatesPager(options *ListProfileTemplatesOptions, checkpoint string) (pager *ProfileTemplatesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ProfileTemplatesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = iamIdentity.NewProfileTemplatesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
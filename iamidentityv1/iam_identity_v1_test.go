@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -433,6 +434,78 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listAPIKeysPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","apikeys":[{"id":"ID","name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"apikeys":[{"id":"ID","name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use APIKeysPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAPIKeysOptionsModel := &iamidentityv1.ListAPIKeysOptions{
+					AccountID: core.StringPtr("testString"),
+					Pagesize: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamIdentityService.NewAPIKeysPager(listAPIKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.APIKey
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use APIKeysPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listAPIKeysOptionsModel := &iamidentityv1.ListAPIKeysOptions{
+					AccountID: core.StringPtr("testString"),
+					Pagesize: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamIdentityService.NewAPIKeysPager(listAPIKeysOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateAPIKey(createAPIKeyOptions *CreateAPIKeyOptions) - Operation response error`, func() {
 		createAPIKeyPath := "/v1/apikeys"
@@ -2052,6 +2125,78 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listServiceIdsPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","serviceids":[{"id":"ID","name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"serviceids":[{"id":"ID","name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ServiceIdsPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listServiceIdsOptionsModel := &iamidentityv1.ListServiceIdsOptions{
+					AccountID: core.StringPtr("testString"),
+					Pagesize: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamIdentityService.NewServiceIdsPager(listServiceIdsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.ServiceID
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ServiceIdsPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listServiceIdsOptionsModel := &iamidentityv1.ListServiceIdsOptions{
+					AccountID: core.StringPtr("testString"),
+					Pagesize: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamIdentityService.NewServiceIdsPager(listServiceIdsOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateServiceID(createServiceIDOptions *CreateServiceIDOptions) - Operation response error`, func() {
 		createServiceIDPath := "/v1/serviceids/"
@@ -3581,6 +3726,78 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listProfilesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","profiles":[{"id":"ID","name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"profiles":[{"id":"ID","name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ProfilesPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfilesOptionsModel := &iamidentityv1.ListProfilesOptions{
+					AccountID: core.StringPtr("testString"),
+					Pagesize: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamIdentityService.NewProfilesPager(listProfilesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfile
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfilesPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfilesOptionsModel := &iamidentityv1.ListProfilesOptions{
+					AccountID: core.StringPtr("testString"),
+					Pagesize: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamIdentityService.NewProfilesPager(listProfilesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`GetProfile(getProfileOptions *GetProfileOptions) - Operation response error`, func() {
 		getProfilePath := "/v1/profiles/testString"
@@ -14074,6 +14291,78 @@ var _ = Describe(`IamIdentityV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listProfileTemplatesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"next":"https://myhost.com/somePath?pagetoken=1","profile_templates":[{"id":"ID","name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"profile_templates":[{"id":"ID","name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use ProfileTemplatesPager.GetNext successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfileTemplatesOptionsModel := &iamidentityv1.ListProfileTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.StringPtr("20"),
+				}
+
+				pager, err := iamIdentityService.NewProfileTemplatesPager(listProfileTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iamidentityv1.TrustedProfileTemplateResponse
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use ProfileTemplatesPager.GetAll successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				listProfileTemplatesOptionsModel := &iamidentityv1.ListProfileTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.StringPtr("20"),
+				}
+
+				pager, err := iamIdentityService.NewProfileTemplatesPager(listProfileTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateProfileTemplate(createProfileTemplateOptions *CreateProfileTemplateOptions) - Operation response error`, func() {
 		createProfileTemplatePath := "/v1/profile_templates"
@@ -16616,6 +16905,50 @@ var _ = Describe(`IamIdentityV1`, func() {
 			})
 		})
 	})
+	Describe(`GetExceptionResponse(err error)`, func() {
+		Context(`Using mock server endpoint with an error response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal("/v1/apikeys/testString"))
+					Expect(req.Method).To(Equal("DELETE"))
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"context": {"transaction_id": "testString"}, "status_code": "404", "errors": [{"code": "not_found", "message_code": "BXNIM0102E", "message": "API key not found."}], "trace": "testString"}`)
+				}))
+			})
+			It(`Invoke GetExceptionResponse successfully`, func() {
+				iamIdentityService, serviceErr := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamIdentityService).ToNot(BeNil())
+
+				response, operationErr := iamIdentityService.DeleteAPIKey(iamIdentityService.NewDeleteAPIKeyOptions("testString"))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(common.IsNotFound(operationErr)).To(BeTrue())
+
+				errorResponse := iamidentityv1.GetExceptionResponse(operationErr)
+				Expect(errorResponse).ToNot(BeNil())
+				Expect(*errorResponse.Trace).To(Equal("testString"))
+				Expect(*errorResponse.StatusCode).To(Equal("404"))
+				Expect(errorResponse.Errors).To(HaveLen(1))
+				Expect(*errorResponse.Errors[0].MessageCode).To(Equal("BXNIM0102E"))
+			})
+			It(`Invoke GetExceptionResponse with an error that has no error response`, func() {
+				Expect(iamidentityv1.GetExceptionResponse(nil)).To(BeNil())
+				Expect(iamidentityv1.GetExceptionResponse(fmt.Errorf("not an error response"))).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			iamIdentityService, _ := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
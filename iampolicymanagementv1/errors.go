@@ -0,0 +1,32 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// GetErrorResponse returns the ErrorResponse contained in the body of the error response
+// that caused "err", which is an error returned by one of the IamPolicyManagementV1 methods.
+// It returns nil if "err" was not caused by an error response with an ErrorResponse body.
+func GetErrorResponse(err error) *ErrorResponse {
+	var result *ErrorResponse
+	if !common.DecodeErrorResponse(err, &result, UnmarshalErrorResponse) {
+		return nil
+	}
+	return result
+}
@@ -0,0 +1,169 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ModifyV2Policy performs a read-modify-write update of the v2 policy "policyID".
+// The policy is retrieved, "mutate" is applied to it, and the result is sent to ReplaceV2Policy()
+// with the ETag of the retrieved policy. If the policy is modified concurrently, the update is
+// retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the policy unchanged.
+func (iamPolicyManagement *IamPolicyManagementV1) ModifyV2Policy(ctx context.Context, policyID string, mutate func(*V2Policy) error,
+	options *common.UpdateOptions) (result *V2Policy, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*V2Policy, *core.DetailedResponse, error) {
+		policy, response, err := iamPolicyManagement.GetV2PolicyWithContext(ctx, iamPolicyManagement.NewGetV2PolicyOptions(policyID))
+		if err != nil {
+			return nil, response, err
+		}
+		return &V2Policy{
+			Type:                policy.Type,
+			Description:         policy.Description,
+			Subject:             policy.Subject,
+			Resource:            policy.Resource,
+			Pattern:             policy.Pattern,
+			Rule:                policy.Rule,
+			ID:                  policy.ID,
+			Href:                policy.Href,
+			Control:             policy.Control,
+			CreatedAt:           policy.CreatedAt,
+			CreatedByID:         policy.CreatedByID,
+			LastModifiedAt:      policy.LastModifiedAt,
+			LastModifiedByID:    policy.LastModifiedByID,
+			State:               policy.State,
+			LastPermitAt:        policy.LastPermitAt,
+			LastPermitFrequency: policy.LastPermitFrequency,
+		}, response, nil
+	}
+	replace := func(ctx context.Context, policy *V2Policy, etag string) (*V2Policy, *core.DetailedResponse, error) {
+		replaceOptions := &ReplaceV2PolicyOptions{
+			ID:          core.StringPtr(policyID),
+			IfMatch:     core.StringPtr(etag),
+			Control:     getControl(policy.Control),
+			Type:        policy.Type,
+			Description: policy.Description,
+			Subject:     policy.Subject,
+			Resource:    policy.Resource,
+			Pattern:     policy.Pattern,
+			Rule:        policy.Rule,
+		}
+		return iamPolicyManagement.ReplaceV2PolicyWithContext(ctx, replaceOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
+
+// ModifyPolicy performs a read-modify-write update of the v1 policy "policyID".
+// The policy is retrieved, "mutate" is applied to it, and the result is sent to ReplacePolicy()
+// with the ETag of the retrieved policy. If the policy is modified concurrently, the update is
+// retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the policy unchanged.
+func (iamPolicyManagement *IamPolicyManagementV1) ModifyPolicy(ctx context.Context, policyID string, mutate func(*Policy) error,
+	options *common.UpdateOptions) (result *Policy, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*Policy, *core.DetailedResponse, error) {
+		policy, response, err := iamPolicyManagement.GetPolicyWithContext(ctx, iamPolicyManagement.NewGetPolicyOptions(policyID))
+		if err != nil {
+			return nil, response, err
+		}
+		return &Policy{
+			ID:               policy.ID,
+			Type:             policy.Type,
+			Description:      policy.Description,
+			Subjects:         policy.Subjects,
+			Roles:            policy.Roles,
+			Resources:        policy.Resources,
+			Href:             policy.Href,
+			CreatedAt:        policy.CreatedAt,
+			CreatedByID:      policy.CreatedByID,
+			LastModifiedAt:   policy.LastModifiedAt,
+			LastModifiedByID: policy.LastModifiedByID,
+			State:            policy.State,
+		}, response, nil
+	}
+	replace := func(ctx context.Context, policy *Policy, etag string) (*Policy, *core.DetailedResponse, error) {
+		replaceOptions := &ReplacePolicyOptions{
+			PolicyID:    core.StringPtr(policyID),
+			IfMatch:     core.StringPtr(etag),
+			Type:        policy.Type,
+			Subjects:    policy.Subjects,
+			Roles:       policy.Roles,
+			Resources:   policy.Resources,
+			Description: policy.Description,
+		}
+		return iamPolicyManagement.ReplacePolicyWithContext(ctx, replaceOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
+
+// ModifyRole performs a read-modify-write update of the custom role "roleID".
+// The role is retrieved, "mutate" is applied to it, and the result is sent to ReplaceRole()
+// with the ETag of the retrieved role. If the role is modified concurrently, the update is
+// retried as described by "options" (see common.UpdateWithETag()).
+// The "mutate" function may return common.ErrNoUpdate to leave the role unchanged.
+func (iamPolicyManagement *IamPolicyManagementV1) ModifyRole(ctx context.Context, roleID string, mutate func(*CustomRole) error,
+	options *common.UpdateOptions) (result *CustomRole, response *core.DetailedResponse, err error) {
+	get := func(ctx context.Context) (*CustomRole, *core.DetailedResponse, error) {
+		return iamPolicyManagement.GetRoleWithContext(ctx, iamPolicyManagement.NewGetRoleOptions(roleID))
+	}
+	replace := func(ctx context.Context, role *CustomRole, etag string) (*CustomRole, *core.DetailedResponse, error) {
+		replaceOptions := &ReplaceRoleOptions{
+			RoleID:      core.StringPtr(roleID),
+			IfMatch:     core.StringPtr(etag),
+			DisplayName: role.DisplayName,
+			Actions:     role.Actions,
+			Description: role.Description,
+		}
+		return iamPolicyManagement.ReplaceRoleWithContext(ctx, replaceOptions)
+	}
+
+	result, response, err = common.UpdateWithETag(ctx, get, mutate, replace, options)
+	err = core.RepurposeSDKProblem(err, "modify-error")
+	return
+}
+
+// getControl returns the Control that corresponds to a control returned by the service,
+// so that it can be sent back in a replace request.
+func getControl(control ControlResponseIntf) *Control {
+	var grant *Grant
+	switch c := control.(type) {
+	case *ControlResponse:
+		grant = c.Grant
+	case *ControlResponseControl:
+		grant = c.Grant
+	case *ControlResponseControlWithEnrichedRoles:
+		if c.Grant != nil {
+			grant = &Grant{}
+			for _, role := range c.Grant.Roles {
+				grant.Roles = append(grant.Roles, Roles{RoleID: role.RoleID})
+			}
+		}
+	}
+	if grant == nil {
+		return nil
+	}
+	return &Control{Grant: grant}
+}
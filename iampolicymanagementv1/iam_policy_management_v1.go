@@ -253,7 +253,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPoliciesWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ListPolicies", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policies", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -386,7 +386,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CreatePolicy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -518,7 +518,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplacePolicyWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ReplacePolicy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -587,7 +587,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "GetPolicy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -655,7 +655,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyWithContext(ctx co
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "DeletePolicy", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -731,7 +731,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyStateWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "UpdatePolicyState", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_policy_state", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -814,7 +814,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListRolesWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ListRoles", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_roles", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -911,7 +911,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateRoleWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CreateRole", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_role", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1001,7 +1001,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceRoleWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ReplaceRole", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_role", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1070,7 +1070,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetRoleWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "GetRole", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_role", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1137,7 +1137,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteRoleWithContext(ctx cont
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "DeleteRole", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_role", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1222,6 +1222,12 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListV2PoliciesWithContext(ctx
 	if listV2PoliciesOptions.State != nil {
 		builder.AddQuery("state", fmt.Sprint(*listV2PoliciesOptions.State))
 	}
+	if listV2PoliciesOptions.Limit != nil {
+		builder.AddQuery("limit", fmt.Sprint(*listV2PoliciesOptions.Limit))
+	}
+	if listV2PoliciesOptions.Start != nil {
+		builder.AddQuery("start", fmt.Sprint(*listV2PoliciesOptions.Start))
+	}
 
 	request, err := builder.Build()
 	if err != nil {
@@ -1230,7 +1236,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListV2PoliciesWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ListV2Policies", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_v2_policies", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1443,7 +1449,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreateV2PolicyWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CreateV2Policy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_v2_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1658,7 +1664,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplaceV2PolicyWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ReplaceV2Policy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_v2_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1731,7 +1737,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetV2PolicyWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "GetV2Policy", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_v2_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1799,7 +1805,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeleteV2PolicyWithContext(ctx
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "DeleteV2Policy", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_v2_policy", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1876,6 +1882,12 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplatesWithContext
 	if listPolicyTemplatesOptions.PolicyType != nil {
 		builder.AddQuery("policy_type", fmt.Sprint(*listPolicyTemplatesOptions.PolicyType))
 	}
+	if listPolicyTemplatesOptions.Limit != nil {
+		builder.AddQuery("limit", fmt.Sprint(*listPolicyTemplatesOptions.Limit))
+	}
+	if listPolicyTemplatesOptions.Start != nil {
+		builder.AddQuery("start", fmt.Sprint(*listPolicyTemplatesOptions.Start))
+	}
 
 	request, err := builder.Build()
 	if err != nil {
@@ -1884,7 +1896,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplatesWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ListPolicyTemplates", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policy_templates", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1976,7 +1988,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CreatePolicyTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2049,7 +2061,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyTemplateWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "GetPolicyTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2118,7 +2130,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyTemplateWithContex
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "DeletePolicyTemplate", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2200,7 +2212,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateVersionWit
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CreatePolicyTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2273,7 +2285,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyTemplateVersionsWith
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ListPolicyTemplateVersions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policy_template_versions", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2366,7 +2378,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ReplacePolicyTemplateWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ReplacePolicyTemplate", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "replace_policy_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2436,7 +2448,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyTemplateVersionWit
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "DeletePolicyTemplateVersion", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2498,7 +2510,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyTemplateVersionWithCo
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "GetPolicyTemplateVersion", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy_template_version", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2567,7 +2579,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CommitPolicyTemplateWithContex
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CommitPolicyTemplate", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "commit_policy_template", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2640,7 +2652,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) ListPolicyAssignmentsWithConte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "ListPolicyAssignments", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_policy_assignments", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2725,7 +2737,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) CreatePolicyTemplateAssignment
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "CreatePolicyTemplateAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_policy_template_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2796,7 +2808,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) GetPolicyAssignmentWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "GetPolicyAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_policy_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2881,7 +2893,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) UpdatePolicyAssignmentWithCont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = iamPolicyManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "UpdatePolicyAssignment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_policy_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2949,7 +2961,7 @@ func (iamPolicyManagement *IamPolicyManagementV1) DeletePolicyAssignmentWithCont
 		return
 	}
 
-	response, err = iamPolicyManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(iamPolicyManagement.Service, "iam_policy_management", "V1", "DeletePolicyAssignment", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_policy_assignment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4141,6 +4153,24 @@ func UnmarshalErrorResponse(m map[string]json.RawMessage, result interface{}) (e
 	return
 }
 
+// First : Details with linking href to first page of requested collection.
+type First struct {
+	// The href linking to the page of requested collection.
+	Href *string `json:"href,omitempty"`
+}
+
+// UnmarshalFirst unmarshals an instance of First from the specified map of raw messages.
+func UnmarshalFirst(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(First)
+	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
 // GetPolicyAssignmentOptions : The GetPolicyAssignment options.
 type GetPolicyAssignmentOptions struct {
 	// The policy template assignment ID.
@@ -5007,6 +5037,12 @@ type ListPolicyTemplatesOptions struct {
 	// Policy type, Optional.
 	PolicyType *string `json:"policy_type,omitempty"`
 
+	// The number of documents to include per each page of the collection.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Page token that refers to the page of the collection to return.
+	Start *string `json:"start,omitempty"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -5087,6 +5123,18 @@ func (_options *ListPolicyTemplatesOptions) SetPolicyType(policyType string) *Li
 	return _options
 }
 
+// SetLimit : Allow user to set Limit
+func (_options *ListPolicyTemplatesOptions) SetLimit(limit int64) *ListPolicyTemplatesOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetStart : Allow user to set Start
+func (_options *ListPolicyTemplatesOptions) SetStart(start string) *ListPolicyTemplatesOptions {
+	_options.Start = core.StringPtr(start)
+	return _options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListPolicyTemplatesOptions) SetHeaders(param map[string]string) *ListPolicyTemplatesOptions {
 	options.Headers = param
@@ -5240,6 +5288,12 @@ type ListV2PoliciesOptions struct {
 	// * `deleted` - returns non-active policies.
 	State *string `json:"state,omitempty"`
 
+	// The number of documents to include per each page of the collection.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Page token that refers to the page of the collection to return.
+	Start *string `json:"start,omitempty"`
+
 	// Allows users to set headers on API requests
 	Headers map[string]string
 }
@@ -5351,6 +5405,18 @@ func (_options *ListV2PoliciesOptions) SetState(state string) *ListV2PoliciesOpt
 	return _options
 }
 
+// SetLimit : Allow user to set Limit
+func (_options *ListV2PoliciesOptions) SetLimit(limit int64) *ListV2PoliciesOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetStart : Allow user to set Start
+func (_options *ListV2PoliciesOptions) SetStart(start string) *ListV2PoliciesOptions {
+	_options.Start = core.StringPtr(start)
+	return _options
+}
+
 // SetHeaders : Allow user to set Headers
 func (options *ListV2PoliciesOptions) SetHeaders(param map[string]string) *ListV2PoliciesOptions {
 	options.Headers = param
@@ -5435,6 +5501,32 @@ func UnmarshalNestedCondition(m map[string]json.RawMessage, result interface{})
 	return
 }
 
+// Next : Details with href linking to the following page of requested collection.
+type Next struct {
+	// The href linking to the page of requested collection.
+	Href *string `json:"href,omitempty"`
+
+	// Page token that refers to the page of the collection.
+	Start *string `json:"start,omitempty"`
+}
+
+// UnmarshalNext unmarshals an instance of Next from the specified map of raw messages.
+func UnmarshalNext(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Next)
+	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "start", &obj.Start)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "start-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
 // Policy : The core set of properties associated with a policy.
 type Policy struct {
 	// The policy ID.
@@ -6213,6 +6305,18 @@ func UnmarshalPolicyTemplateAssignmentItems(m map[string]json.RawMessage, result
 
 // PolicyTemplateCollection : A collection of policy Templates.
 type PolicyTemplateCollection struct {
+	// The number of documents to include per each page of the collection.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Details with linking href to first page of requested collection.
+	First *First `json:"first,omitempty"`
+
+	// Details with href linking to the following page of requested collection.
+	Next *Next `json:"next,omitempty"`
+
+	// Details with linking href to previous page of requested collection.
+	Previous *Previous `json:"previous,omitempty"`
+
 	// List of policy templates.
 	PolicyTemplates []PolicyTemplate `json:"policy_templates,omitempty"`
 }
@@ -6220,6 +6324,26 @@ type PolicyTemplateCollection struct {
 // UnmarshalPolicyTemplateCollection unmarshals an instance of PolicyTemplateCollection from the specified map of raw messages.
 func UnmarshalPolicyTemplateCollection(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(PolicyTemplateCollection)
+	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalFirst)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "first-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalNext)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "previous", &obj.Previous, UnmarshalPrevious)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "previous-error", common.GetComponentInfo())
+		return
+	}
 	err = core.UnmarshalModel(m, "policy_templates", &obj.PolicyTemplates, UnmarshalPolicyTemplate)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "policy_templates-error", common.GetComponentInfo())
@@ -6229,6 +6353,14 @@ func UnmarshalPolicyTemplateCollection(m map[string]json.RawMessage, result inte
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *PolicyTemplateCollection) GetNextStart() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	return resp.Next.Start, nil
+}
+
 // PolicyTemplateLimitData : The core set of properties associated with the policy template.
 type PolicyTemplateLimitData struct {
 	// Required field when creating a new template. Otherwise this field is optional. If the field is included it will
@@ -6500,6 +6632,32 @@ func UnmarshalPolicyTemplateVersionsCollection(m map[string]json.RawMessage, res
 	return
 }
 
+// Previous : Details with linking href to previous page of requested collection.
+type Previous struct {
+	// The href linking to the page of requested collection.
+	Href *string `json:"href,omitempty"`
+
+	// Page token that refers to the page of the collection.
+	Start *string `json:"start,omitempty"`
+}
+
+// UnmarshalPrevious unmarshals an instance of Previous from the specified map of raw messages.
+func UnmarshalPrevious(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(Previous)
+	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalPrimitive(m, "start", &obj.Start)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "start-error", common.GetComponentInfo())
+		return
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	return
+}
+
 // ReplacePolicyOptions : The ReplacePolicy options.
 type ReplacePolicyOptions struct {
 	// The policy ID.
@@ -7653,6 +7811,18 @@ func UnmarshalV2Policy(m map[string]json.RawMessage, result interface{}) (err er
 
 // V2PolicyCollection : A collection of policies.
 type V2PolicyCollection struct {
+	// The number of documents to include per each page of the collection.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Details with linking href to first page of requested collection.
+	First *First `json:"first,omitempty"`
+
+	// Details with href linking to the following page of requested collection.
+	Next *Next `json:"next,omitempty"`
+
+	// Details with linking href to previous page of requested collection.
+	Previous *Previous `json:"previous,omitempty"`
+
 	// List of policies.
 	Policies []V2PolicyTemplateMetaData `json:"policies,omitempty"`
 }
@@ -7660,6 +7830,26 @@ type V2PolicyCollection struct {
 // UnmarshalV2PolicyCollection unmarshals an instance of V2PolicyCollection from the specified map of raw messages.
 func UnmarshalV2PolicyCollection(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(V2PolicyCollection)
+	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalFirst)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "first-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalNext)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
+		return
+	}
+	err = core.UnmarshalModel(m, "previous", &obj.Previous, UnmarshalPrevious)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "previous-error", common.GetComponentInfo())
+		return
+	}
 	err = core.UnmarshalModel(m, "policies", &obj.Policies, UnmarshalV2PolicyTemplateMetaData)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "policies-error", common.GetComponentInfo())
@@ -7669,6 +7859,14 @@ func UnmarshalV2PolicyCollection(m map[string]json.RawMessage, result interface{
 	return
 }
 
+// Retrieve the value to be passed to a request to access the next page of results
+func (resp *V2PolicyCollection) GetNextStart() (*string, error) {
+	if core.IsNil(resp.Next) {
+		return nil, nil
+	}
+	return resp.Next.Start, nil
+}
+
 // V2PolicyResource : The resource attributes to which the policy grants access.
 type V2PolicyResource struct {
 	// List of resource attributes to which the policy grants access.
@@ -8980,3 +9178,227 @@ func UnmarshalV2PolicyRuleRuleWithNestedConditions(m map[string]json.RawMessage,
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
+
+// V2PoliciesPager can be used to simplify the use of the "ListV2Policies" method.
+type V2PoliciesPager struct {
+	hasNext     bool
+	options     *ListV2PoliciesOptions
+	client      *IamPolicyManagementV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewV2PoliciesPager returns a new V2PoliciesPager instance.
+func (iamPolicyManagement *IamPolicyManagementV1) NewV2PoliciesPager(options *ListV2PoliciesOptions) (pager *V2PoliciesPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = core.SDKErrorf(nil, "the 'options.Start' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListV2PoliciesOptions = *options
+	pager = &V2PoliciesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamPolicyManagement,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *V2PoliciesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *V2PoliciesPager) GetNextWithContext(ctx context.Context) (page []V2PolicyTemplateMetaData, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListV2PoliciesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		next = result.Next.Start
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.Policies
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *V2PoliciesPager) GetAllWithContext(ctx context.Context) (allItems []V2PolicyTemplateMetaData, err error) {
+	for pager.HasNext() {
+		var nextPage []V2PolicyTemplateMetaData
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *V2PoliciesPager) GetNext() (page []V2PolicyTemplateMetaData, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *V2PoliciesPager) GetAll() (allItems []V2PolicyTemplateMetaData, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeV2PoliciesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *V2PoliciesPager) GetCheckpoint() string {
+	return common.EncodePageToken("V2PoliciesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeV2PoliciesPager returns a new V2PoliciesPager instance positioned at the checkpoint
+// returned by V2PoliciesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamPolicyManagement *IamPolicyManagementV1) ResumeV2PoliciesPager(options *ListV2PoliciesOptions, checkpoint string) (pager *V2PoliciesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("V2PoliciesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = iamPolicyManagement.NewV2PoliciesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
+// PolicyTemplatesPager can be used to simplify the use of the "ListPolicyTemplates" method.
+type PolicyTemplatesPager struct {
+	hasNext     bool
+	options     *ListPolicyTemplatesOptions
+	client      *IamPolicyManagementV1
+	pageContext struct {
+		next *string
+	}
+}
+
+// NewPolicyTemplatesPager returns a new PolicyTemplatesPager instance.
+func (iamPolicyManagement *IamPolicyManagementV1) NewPolicyTemplatesPager(options *ListPolicyTemplatesOptions) (pager *PolicyTemplatesPager, err error) {
+	if options.Start != nil && *options.Start != "" {
+		err = core.SDKErrorf(nil, "the 'options.Start' field should not be set", "no-query-setting", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy ListPolicyTemplatesOptions = *options
+	pager = &PolicyTemplatesPager{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  iamPolicyManagement,
+	}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *PolicyTemplatesPager) HasNext() bool {
+	return pager.hasNext
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *PolicyTemplatesPager) GetNextWithContext(ctx context.Context) (page []PolicyTemplate, err error) {
+	if !pager.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	pager.options.Start = pager.pageContext.next
+
+	result, _, err := pager.client.ListPolicyTemplatesWithContext(ctx, pager.options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+		return
+	}
+
+	var next *string
+	if result.Next != nil {
+		next = result.Next.Start
+	}
+	pager.pageContext.next = next
+	pager.hasNext = (pager.pageContext.next != nil)
+	page = result.PolicyTemplates
+
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *PolicyTemplatesPager) GetAllWithContext(ctx context.Context) (allItems []PolicyTemplate, err error) {
+	for pager.HasNext() {
+		var nextPage []PolicyTemplate
+		nextPage, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "error-getting-next-page")
+			return
+		}
+		allItems = append(allItems, nextPage...)
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *PolicyTemplatesPager) GetNext() (page []PolicyTemplate, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *PolicyTemplatesPager) GetAll() (allItems []PolicyTemplate, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumePolicyTemplatesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *PolicyTemplatesPager) GetCheckpoint() string {
+	return common.EncodePageToken("PolicyTemplatesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumePolicyTemplatesPager returns a new PolicyTemplatesPager instance positioned at the checkpoint
+// returned by PolicyTemplatesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (iamPolicyManagement *IamPolicyManagementV1) ResumePolicyTemplatesPager(options *ListPolicyTemplatesOptions, checkpoint string) (pager *PolicyTemplatesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("PolicyTemplatesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = iamPolicyManagement.NewPolicyTemplatesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
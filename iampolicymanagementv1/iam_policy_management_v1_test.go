@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -29,6 +30,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
 	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -3236,6 +3238,78 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listV2PoliciesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"limit":1,"next":{"href":"https://myhost.com/somePath?start=1","start":"1"},"policies":[{"id":"ID","type":"access"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"limit":1,"policies":[{"id":"ID","type":"access"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use V2PoliciesPager.GetNext successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listV2PoliciesOptionsModel := &iampolicymanagementv1.ListV2PoliciesOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewV2PoliciesPager(listV2PoliciesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.V2PolicyTemplateMetaData
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use V2PoliciesPager.GetAll successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listV2PoliciesOptionsModel := &iampolicymanagementv1.ListV2PoliciesOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewV2PoliciesPager(listV2PoliciesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreateV2Policy(createV2PolicyOptions *CreateV2PolicyOptions) - Operation response error`, func() {
 		createV2PolicyPath := "/v2/policies"
@@ -4823,6 +4897,78 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 				testServer.Close()
 			})
 		})
+		Context(`Using mock server endpoint - paginated response`, func() {
+			BeforeEach(func() {
+				var requestNumber int = 0
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal(listPolicyTemplatesPath))
+					Expect(req.Method).To(Equal("GET"))
+
+					// Set mock response
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(200)
+					requestNumber++
+					if requestNumber == 1 {
+						fmt.Fprintf(res, "%s", `{"limit":1,"next":{"href":"https://myhost.com/somePath?start=1","start":"1"},"policy_templates":[{"id":"ID","name":"Name"}]}`)
+					} else if requestNumber == 2 {
+						fmt.Fprintf(res, "%s", `{"limit":1,"policy_templates":[{"id":"ID","name":"Name"}]}`)
+					} else {
+						res.WriteHeader(400)
+					}
+				}))
+			})
+			It(`Use PolicyTemplatesPager.GetNext successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listPolicyTemplatesOptionsModel := &iampolicymanagementv1.ListPolicyTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewPolicyTemplatesPager(listPolicyTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				var allResults []iampolicymanagementv1.PolicyTemplate
+				for pager.HasNext() {
+					nextPage, err := pager.GetNext()
+					Expect(err).To(BeNil())
+					Expect(nextPage).ToNot(BeNil())
+					allResults = append(allResults, nextPage...)
+				}
+				Expect(len(allResults)).To(Equal(2))
+			})
+			It(`Use PolicyTemplatesPager.GetAll successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				listPolicyTemplatesOptionsModel := &iampolicymanagementv1.ListPolicyTemplatesOptions{
+					AccountID: core.StringPtr("testString"),
+					Limit: core.Int64Ptr(int64(10)),
+				}
+
+				pager, err := iamPolicyManagementService.NewPolicyTemplatesPager(listPolicyTemplatesOptionsModel)
+				Expect(err).To(BeNil())
+				Expect(pager).ToNot(BeNil())
+
+				allResults, err := pager.GetAll()
+				Expect(err).To(BeNil())
+				Expect(allResults).ToNot(BeNil())
+				Expect(len(allResults)).To(Equal(2))
+			})
+		})
 	})
 	Describe(`CreatePolicyTemplate(createPolicyTemplateOptions *CreatePolicyTemplateOptions) - Operation response error`, func() {
 		createPolicyTemplatePath := "/v1/policy_templates"
@@ -8457,6 +8603,462 @@ var _ = Describe(`IamPolicyManagementV1`, func() {
 			})
 		})
 	})
+	Describe(`GetErrorResponse(err error)`, func() {
+		Context(`Using mock server endpoint with an error response`, func() {
+			BeforeEach(func() {
+				testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+					defer GinkgoRecover()
+
+					// Verify the contents of the request
+					Expect(req.URL.EscapedPath()).To(Equal("/v1/policy_assignments/testString"))
+					Expect(req.Method).To(Equal("DELETE"))
+
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(404)
+					fmt.Fprintf(res, "%s", `{"trace": "testString", "errors": [{"code": "policy_assignment_not_found", "message": "Policy assignment was not found."}], "status_code": 404}`)
+				}))
+			})
+			It(`Invoke GetErrorResponse successfully`, func() {
+				iamPolicyManagementService, serviceErr := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+					URL:           testServer.URL,
+					Authenticator: &core.NoAuthAuthenticator{},
+				})
+				Expect(serviceErr).To(BeNil())
+				Expect(iamPolicyManagementService).ToNot(BeNil())
+
+				response, operationErr := iamPolicyManagementService.DeletePolicyAssignment(iamPolicyManagementService.NewDeletePolicyAssignmentOptions("testString"))
+				Expect(operationErr).ToNot(BeNil())
+				Expect(response).ToNot(BeNil())
+				Expect(common.IsNotFound(operationErr)).To(BeTrue())
+
+				errorResponse := iampolicymanagementv1.GetErrorResponse(operationErr)
+				Expect(errorResponse).ToNot(BeNil())
+				Expect(*errorResponse.Trace).To(Equal("testString"))
+				Expect(*errorResponse.StatusCode).To(Equal(int64(404)))
+				Expect(errorResponse.Errors).To(HaveLen(1))
+				Expect(*errorResponse.Errors[0].Code).To(Equal("policy_assignment_not_found"))
+			})
+			It(`Invoke GetErrorResponse with an error that has no error response`, func() {
+				Expect(iampolicymanagementv1.GetErrorResponse(nil)).To(BeNil())
+				Expect(iampolicymanagementv1.GetErrorResponse(fmt.Errorf("not an error response"))).To(BeNil())
+			})
+			AfterEach(func() {
+				testServer.Close()
+			})
+		})
+	})
+	Describe(`Policy evaluator`, func() {
+		viewer := "crn:v1:bluemix:public:iam::::role:Viewer"
+		writer := "crn:v1:bluemix:public:iam::::serviceRole:Writer"
+		newPolicy := func(id string, subject []iampolicymanagementv1.V2PolicySubjectAttribute,
+			resource []iampolicymanagementv1.V2PolicyResourceAttribute, roles ...string) iampolicymanagementv1.V2Policy {
+			grant := &iampolicymanagementv1.Grant{}
+			for _, role := range roles {
+				grant.Roles = append(grant.Roles, iampolicymanagementv1.Roles{RoleID: core.StringPtr(role)})
+			}
+			return iampolicymanagementv1.V2Policy{
+				ID:       core.StringPtr(id),
+				Type:     core.StringPtr("access"),
+				State:    core.StringPtr("active"),
+				Subject:  &iampolicymanagementv1.V2PolicySubject{Attributes: subject},
+				Resource: &iampolicymanagementv1.V2PolicyResource{Attributes: resource},
+				Control:  &iampolicymanagementv1.ControlResponseControl{Grant: grant},
+			}
+		}
+		subjectAttribute := func(key string, value interface{}) iampolicymanagementv1.V2PolicySubjectAttribute {
+			return iampolicymanagementv1.V2PolicySubjectAttribute{Key: core.StringPtr(key), Operator: core.StringPtr("stringEquals"), Value: value}
+		}
+		resourceAttribute := func(key string, operator string, value interface{}) iampolicymanagementv1.V2PolicyResourceAttribute {
+			return iampolicymanagementv1.V2PolicyResourceAttribute{Key: core.StringPtr(key), Operator: core.StringPtr(operator), Value: value}
+		}
+		accountResource := []iampolicymanagementv1.V2PolicyResourceAttribute{
+			resourceAttribute("accountId", "stringEquals", "acct"),
+			resourceAttribute("serviceName", "stringEquals", "cloud-object-storage"),
+		}
+		// Monday, 2024-01-15 at 10:30 UTC.
+		monday := time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC)
+		request := func() *iampolicymanagementv1.AccessRequest {
+			return &iampolicymanagementv1.AccessRequest{
+				IamID:          "IBMid-123",
+				AccessGroupIDs: []string{"AccessGroupId-dev"},
+				Resource: map[string]string{
+					"accountId":       "acct",
+					"serviceName":     "cloud-object-storage",
+					"serviceInstance": "bucket-instance",
+				},
+				Time: monday,
+			}
+		}
+
+		It(`Invoke Evaluate with subject, resource and role matching`, func() {
+			policies := []iampolicymanagementv1.V2Policy{
+				newPolicy("user-policy", []iampolicymanagementv1.V2PolicySubjectAttribute{subjectAttribute("iam_id", "IBMid-123")},
+					accountResource, viewer),
+				newPolicy("group-policy", []iampolicymanagementv1.V2PolicySubjectAttribute{subjectAttribute("access_group_id", "AccessGroupId-dev")},
+					[]iampolicymanagementv1.V2PolicyResourceAttribute{
+						resourceAttribute("accountId", "stringEquals", "acct"),
+						resourceAttribute("serviceInstance", "stringMatchAnyOf", []interface{}{"other", "bucket-*"}),
+					}, writer),
+				newPolicy("other-user", []iampolicymanagementv1.V2PolicySubjectAttribute{subjectAttribute("iam_id", "IBMid-456")},
+					accountResource, viewer),
+			}
+			evaluator := iampolicymanagementv1.NewPolicyEvaluator(policies, &iampolicymanagementv1.PolicyEvaluatorOptions{
+				RoleActions: map[string][]string{writer: {"cloud-object-storage.object.put"}},
+			})
+
+			decision, err := evaluator.Evaluate(request())
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeTrue())
+			Expect(decision.Matches).To(HaveLen(2))
+			Expect(decision.Evaluations).To(HaveLen(3))
+			Expect(decision.Evaluations[2].Reason).To(ContainSubstring("the subject does not match iam_id"))
+			Expect(decision.String()).To(Equal("allowed by policy 'user-policy' (roles: " + viewer + "); policy 'group-policy' (roles: " + writer + ")"))
+
+			req := request()
+			req.Role = "Writer"
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Matches).To(HaveLen(1))
+			Expect(decision.Matches[0].PolicyID).To(Equal("group-policy"))
+
+			req = request()
+			req.Action = "cloud-object-storage.object.put"
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Matches).To(HaveLen(1))
+			Expect(decision.Matches[0].Roles).To(Equal([]string{writer}))
+
+			req = request()
+			req.Resource["accountId"] = "other-account"
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+			Expect(decision.Evaluations[0].Reason).To(ContainSubstring("the resource does not match accountId"))
+			Expect(decision.String()).To(Equal("denied: none of the 3 policies grants the request"))
+		})
+		It(`Invoke Evaluate with resource tags and inactive policies`, func() {
+			tagged := newPolicy("tagged", nil, accountResource, viewer)
+			tagged.Resource.Tags = []iampolicymanagementv1.V2PolicyResourceTag{
+				{Key: core.StringPtr("env"), Value: core.StringPtr("dev*"), Operator: core.StringPtr("stringMatch")},
+			}
+			deleted := newPolicy("deleted", nil, accountResource, viewer)
+			deleted.State = core.StringPtr("deleted")
+			evaluator := iampolicymanagementv1.NewPolicyEvaluator([]iampolicymanagementv1.V2Policy{tagged, deleted}, nil)
+
+			req := request()
+			req.ResourceTags = []string{"team:a", "env:prod"}
+			decision, err := evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+			Expect(decision.Evaluations[0].Reason).To(ContainSubstring("the resource tags do not match env"))
+			Expect(decision.Evaluations[1].Reason).To(Equal("the policy state is 'deleted'"))
+
+			req.ResourceTags = []string{"env:development"}
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeTrue())
+		})
+		It(`Invoke Evaluate with time-based conditions`, func() {
+			rule := &iampolicymanagementv1.V2PolicyRuleRuleWithNestedConditions{
+				Operator: core.StringPtr("and"),
+				Conditions: []iampolicymanagementv1.NestedConditionIntf{
+					&iampolicymanagementv1.NestedConditionRuleAttribute{
+						Key:      core.StringPtr("{{environment.attributes.day_of_week}}"),
+						Operator: core.StringPtr("dayOfWeekAnyOf"),
+						Value:    []interface{}{"1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"},
+					},
+					&iampolicymanagementv1.NestedConditionRuleAttribute{
+						Key:      core.StringPtr("{{environment.attributes.current_time}}"),
+						Operator: core.StringPtr("timeGreaterThanOrEquals"),
+						Value:    "09:00:00+00:00",
+					},
+					&iampolicymanagementv1.NestedConditionRuleAttribute{
+						Key:      core.StringPtr("{{environment.attributes.current_time}}"),
+						Operator: core.StringPtr("timeLessThan"),
+						Value:    "17:00:00+00:00",
+					},
+				},
+			}
+			policy := newPolicy("office-hours", nil, accountResource, viewer)
+			policy.Rule = rule
+			evaluator := iampolicymanagementv1.NewPolicyEvaluator([]iampolicymanagementv1.V2Policy{policy}, nil)
+
+			decision, err := evaluator.Evaluate(request())
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeTrue())
+			Expect(decision.Matches[0].Conditions).To(HaveLen(3))
+			Expect(decision.Matches[0].Conditions[1].String()).To(Equal("{{environment.attributes.current_time}} timeGreaterThanOrEquals 09:00:00+00:00: satisfied"))
+
+			req := request()
+			req.Time = monday.Add(8 * time.Hour)
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+			Expect(decision.Evaluations[0].Reason).To(Equal("the rule conditions are not satisfied"))
+			Expect(decision.Evaluations[0].Conditions[2].Satisfied).To(BeFalse())
+
+			// Saturday.
+			req.Time = monday.AddDate(0, 0, 5)
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+			Expect(decision.Evaluations[0].Conditions[0].Satisfied).To(BeFalse())
+
+			// Conditions in other time zones are evaluated in their zone: 10:30 UTC is 19:30 in Tokyo.
+			rule.Conditions[2].(*iampolicymanagementv1.NestedConditionRuleAttribute).Value = "17:00:00+09:00"
+			decision, err = evaluator.Evaluate(request())
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+		})
+		It(`Invoke Evaluate with policies read from JSON`, func() {
+			policyJSON := `{
+				"id": "temporary", "type": "access", "state": "active",
+				"subject": {"attributes": [{"key": "iam_id", "operator": "stringEquals", "value": "IBMid-123"}]},
+				"resource": {"attributes": [{"key": "accountId", "operator": "stringEquals", "value": "acct"}]},
+				"control": {"grant": {"roles": [{"role_id": "crn:v1:bluemix:public:iam::::role:Viewer"}]}},
+				"rule": {"operator": "or", "conditions": [
+					{"operator": "and", "conditions": [
+						{"key": "{{environment.attributes.current_date_time}}", "operator": "dateTimeGreaterThanOrEquals", "value": "2024-01-01T00:00:00+00:00"},
+						{"key": "{{environment.attributes.current_date_time}}", "operator": "dateTimeLessThan", "value": "2024-01-15T00:00:00+00:00"}
+					]},
+					{"key": "{{environment.attributes.ip_address}}", "operator": "stringEquals", "value": "10.0.0.1"}
+				]}
+			}`
+			var raw map[string]json.RawMessage
+			Expect(json.Unmarshal([]byte(policyJSON), &raw)).To(BeNil())
+			var policy *iampolicymanagementv1.V2Policy
+			Expect(iampolicymanagementv1.UnmarshalV2Policy(raw, &policy)).To(BeNil())
+			evaluator := iampolicymanagementv1.NewPolicyEvaluator([]iampolicymanagementv1.V2Policy{*policy}, nil)
+
+			decision, err := evaluator.Evaluate(request())
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+			Expect(decision.Evaluations[0].Conditions).To(HaveLen(2))
+			Expect(decision.Evaluations[0].Conditions[0].Operator).To(Equal("and"))
+
+			req := request()
+			req.Time = monday.AddDate(0, 0, -1)
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeTrue())
+
+			req = request()
+			req.Environment = map[string]string{"ip_address": "10.0.0.1"}
+			decision, err = evaluator.Evaluate(req)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeTrue())
+		})
+		It(`Invoke Evaluate with invalid policies`, func() {
+			policy := newPolicy("broken", nil, accountResource, viewer)
+			policy.Rule = &iampolicymanagementv1.V2PolicyRuleRuleAttribute{
+				Key:      core.StringPtr("{{environment.attributes.current_time}}"),
+				Operator: core.StringPtr("timeLessThan"),
+				Value:    "5pm",
+			}
+			evaluator := iampolicymanagementv1.NewPolicyEvaluator([]iampolicymanagementv1.V2Policy{policy}, nil)
+			_, err := evaluator.Evaluate(request())
+			Expect(err).ToNot(BeNil())
+			Expect(errors.Is(err, iampolicymanagementv1.ErrInvalidPolicy)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("policy 'broken'"))
+
+			policy.Rule = nil
+			policy.Resource.Attributes = append(policy.Resource.Attributes, resourceAttribute("region", "stringSomething", "us-south"))
+			_, err = evaluator.Evaluate(request())
+			Expect(errors.Is(err, iampolicymanagementv1.ErrInvalidPolicy)).To(BeTrue())
+
+			_, err = evaluator.Evaluate(nil)
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Invoke IsSameRole`, func() {
+			Expect(iampolicymanagementv1.IsSameRole(viewer, viewer)).To(BeTrue())
+			Expect(iampolicymanagementv1.IsSameRole(viewer, "Viewer")).To(BeTrue())
+			Expect(iampolicymanagementv1.IsSameRole(viewer, "Editor")).To(BeFalse())
+			Expect(iampolicymanagementv1.IsSameRole(writer, "crn:v1:bluemix:public:iam::::role:Writer")).To(BeFalse())
+		})
+	})
+	Describe(`Policy rule builder`, func() {
+		zone := time.FixedZone("", 2*60*60)
+		start := time.Date(2024, time.January, 1, 9, 0, 0, 0, zone)
+		end := time.Date(2024, time.January, 1, 17, 30, 0, 0, zone)
+
+		It(`Invoke Build with a weekly rule`, func() {
+			builder := iampolicymanagementv1.Rule().
+				And(iampolicymanagementv1.DayOfWeekAnyOf(zone, time.Monday, time.Sunday), iampolicymanagementv1.TimeBetween(start, end))
+			Expect(builder.Pattern()).To(Equal(iampolicymanagementv1.PolicyPatternTimeBasedWeeklyCustomHours))
+
+			rule, err := builder.Build()
+			Expect(err).To(BeNil())
+			nested, ok := rule.(*iampolicymanagementv1.V2PolicyRuleRuleWithNestedConditions)
+			Expect(ok).To(BeTrue())
+			Expect(*nested.Operator).To(Equal("and"))
+			Expect(nested.Conditions).To(HaveLen(3))
+
+			day := nested.Conditions[0].(*iampolicymanagementv1.NestedConditionRuleAttribute)
+			Expect(*day.Key).To(Equal("{{environment.attributes.day_of_week}}"))
+			Expect(*day.Operator).To(Equal(iampolicymanagementv1.RuleAttributeOperatorDayofweekanyofConst))
+			Expect(day.Value).To(Equal([]interface{}{"1+02:00", "7+02:00"}))
+			after := nested.Conditions[1].(*iampolicymanagementv1.NestedConditionRuleAttribute)
+			Expect(*after.Operator).To(Equal(iampolicymanagementv1.RuleAttributeOperatorTimegreaterthanorequalsConst))
+			Expect(after.Value).To(Equal("09:00:00+02:00"))
+			before := nested.Conditions[2].(*iampolicymanagementv1.NestedConditionRuleAttribute)
+			Expect(before.Value).To(Equal("17:30:00+02:00"))
+
+			// The rule serializes to the JSON expected by IAM.
+			body, err := json.Marshal(rule)
+			Expect(err).To(BeNil())
+			Expect(string(body)).To(ContainSubstring(`"operator":"and"`))
+			Expect(string(body)).To(ContainSubstring(`"value":"09:00:00+02:00"`))
+		})
+		It(`Invoke Build with nested and single conditions`, func() {
+			builder := iampolicymanagementv1.Rule().
+				Or(
+					iampolicymanagementv1.DateTimeBetween(start, end.AddDate(0, 1, 0)),
+					iampolicymanagementv1.StringEquals("ip_address", "10.0.0.1"),
+				)
+			Expect(builder.Pattern()).To(Equal(iampolicymanagementv1.PolicyPatternTimeBasedOnce))
+			rule, err := builder.Build()
+			Expect(err).To(BeNil())
+			nested := rule.(*iampolicymanagementv1.V2PolicyRuleRuleWithNestedConditions)
+			Expect(*nested.Operator).To(Equal("or"))
+			group := nested.Conditions[0].(*iampolicymanagementv1.NestedConditionRuleWithConditions)
+			Expect(*group.Operator).To(Equal("and"))
+			Expect(group.Conditions).To(HaveLen(2))
+			Expect(group.Conditions[0].Value).To(Equal("2024-01-01T09:00:00+02:00"))
+			ip := nested.Conditions[1].(*iampolicymanagementv1.NestedConditionRuleAttribute)
+			Expect(*ip.Key).To(Equal("{{environment.attributes.ip_address}}"))
+
+			builder = iampolicymanagementv1.Rule().When(iampolicymanagementv1.DayOfWeekAnyOf(nil, time.Friday))
+			Expect(builder.Pattern()).To(Equal(iampolicymanagementv1.PolicyPatternTimeBasedWeeklyAllDay))
+			rule, err = builder.Build()
+			Expect(err).To(BeNil())
+			attribute := rule.(*iampolicymanagementv1.V2PolicyRuleRuleAttribute)
+			Expect(attribute.Value).To(Equal([]interface{}{"5+00:00"}))
+
+			builder = iampolicymanagementv1.Rule().When(iampolicymanagementv1.StringExists("ip_address", true))
+			Expect(builder.Pattern()).To(BeEmpty())
+			_, err = builder.Build()
+			Expect(err).To(BeNil())
+
+			_, err = iampolicymanagementv1.Rule().Build()
+			Expect(errors.Is(err, iampolicymanagementv1.ErrInvalidRule)).To(BeTrue())
+		})
+		It(`Invoke Build with invalid conditions`, func() {
+			_, err := iampolicymanagementv1.Rule().
+				And(
+					iampolicymanagementv1.RuleCondition{Key: "{{environment.attributes.current_time}}", Operator: "timeLessThan", Value: "9am"},
+					iampolicymanagementv1.RuleCondition{Key: "{{environment.attributes.current_date}}", Operator: "timeLessThan", Value: "09:00:00+00:00"},
+					iampolicymanagementv1.StringEqualsAnyOf("ip_address"),
+					iampolicymanagementv1.AnyOf(iampolicymanagementv1.AllOf(iampolicymanagementv1.StringEquals("ip_address", "a"),
+						iampolicymanagementv1.StringEquals("ip_address", "b"))),
+				).
+				Build()
+			Expect(errors.Is(err, iampolicymanagementv1.ErrInvalidRule)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("rule.conditions[0]: "))
+			Expect(err.Error()).To(ContainSubstring("rule.conditions[1]: the operator 'timeLessThan' requires the key '{{environment.attributes.current_time}}'"))
+			Expect(err.Error()).To(ContainSubstring("rule.conditions[2]: the operator 'stringEqualsAnyOf' requires a non-empty array of strings"))
+			Expect(err.Error()).To(ContainSubstring("rule.conditions[3].conditions[0]: groups of conditions may only be nested 2 levels deep"))
+		})
+		It(`Invoke ValidateV2PolicyRule`, func() {
+			Expect(iampolicymanagementv1.ValidateV2PolicyRule(nil)).To(BeNil())
+
+			valid := &iampolicymanagementv1.V2PolicyRule{
+				Key:      core.StringPtr("{{environment.attributes.current_date_time}}"),
+				Operator: core.StringPtr("dateTimeLessThan"),
+				Value:    "2024-06-30T23:59:59+00:00",
+			}
+			Expect(iampolicymanagementv1.ValidateV2PolicyRule(valid)).To(BeNil())
+
+			// Dates and times must be in the exact formats expected by IAM, even if the evaluator
+			// accepts other formats.
+			for _, condition := range []struct {
+				key      string
+				operator string
+				value    string
+				valid    bool
+			}{
+				{"current_date_time", "dateTimeLessThan", "2024-06-30T23:59:59Z", false},
+				{"current_date_time", "dateTimeLessThan", "2024-06-30T23:59:59.5+00:00", false},
+				{"current_date_time", "dateTimeLessThan", "2024-06-30T23:59:59", false},
+				{"current_time", "timeLessThan", "17:00:00-05:00", true},
+				{"current_time", "timeLessThan", "17:00+00:00", false},
+				{"current_time", "timeLessThan", "17:00:00Z", false},
+				{"current_date", "dateLessThan", "2024-06-30+02:00", true},
+				{"current_date", "dateLessThan", "2024-06-30", false},
+				{"current_date", "dateLessThan", "2024-06-30Z", false},
+				{"day_of_week", "dayOfWeekEquals", "7+00:00", true},
+				{"day_of_week", "dayOfWeekEquals", "1", false},
+				{"day_of_week", "dayOfWeekEquals", "1Z", false},
+				{"day_of_week", "dayOfWeekEquals", "1+0000", false},
+			} {
+				rule := &iampolicymanagementv1.V2PolicyRule{
+					Key:      core.StringPtr("{{environment.attributes." + condition.key + "}}"),
+					Operator: core.StringPtr(condition.operator),
+					Value:    condition.value,
+				}
+				err := iampolicymanagementv1.ValidateV2PolicyRule(rule)
+				Expect(err == nil).To(Equal(condition.valid), condition.value)
+			}
+
+			invalid := &iampolicymanagementv1.V2PolicyRule{
+				Operator:   core.StringPtr("and"),
+				Conditions: []iampolicymanagementv1.NestedConditionIntf{},
+			}
+			err := iampolicymanagementv1.ValidateV2PolicyRule(invalid)
+			Expect(errors.Is(err, iampolicymanagementv1.ErrInvalidRule)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("requires at least one condition"))
+
+			invalid = &iampolicymanagementv1.V2PolicyRule{
+				Key:      core.StringPtr("{{environment.attributes.day_of_week}}"),
+				Operator: core.StringPtr("dayOfWeekAnyOf"),
+				Value:    []interface{}{"8+00:00"},
+			}
+			Expect(iampolicymanagementv1.ValidateV2PolicyRule(invalid)).ToNot(BeNil())
+
+			invalid = &iampolicymanagementv1.V2PolicyRule{
+				Key:      core.StringPtr("{{environment.attributes.ip_address}}"),
+				Operator: core.StringPtr("ipInRange"),
+				Value:    "10.0.0.0/8",
+			}
+			err = iampolicymanagementv1.ValidateV2PolicyRule(invalid)
+			Expect(err.Error()).To(ContainSubstring("the operator 'ipInRange' is not supported"))
+		})
+		It(`Invoke Evaluate with a built rule`, func() {
+			rule, err := iampolicymanagementv1.Rule().
+				And(iampolicymanagementv1.DayOfWeekAnyOf(time.UTC, time.Monday), iampolicymanagementv1.TimeBetween(
+					time.Date(2024, time.January, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, time.January, 1, 17, 0, 0, 0, time.UTC))).
+				Build()
+			Expect(err).To(BeNil())
+			policy := iampolicymanagementv1.V2Policy{
+				ID:    core.StringPtr("weekly"),
+				Type:  core.StringPtr("access"),
+				State: core.StringPtr("active"),
+				Subject: &iampolicymanagementv1.V2PolicySubject{Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+					{Key: core.StringPtr("iam_id"), Operator: core.StringPtr("stringEquals"), Value: "IBMid-123"},
+				}},
+				Resource: &iampolicymanagementv1.V2PolicyResource{Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+					{Key: core.StringPtr("accountId"), Operator: core.StringPtr("stringEquals"), Value: "acct"},
+				}},
+				Control: &iampolicymanagementv1.ControlResponseControl{Grant: &iampolicymanagementv1.Grant{
+					Roles: []iampolicymanagementv1.Roles{{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Viewer")}},
+				}},
+				Rule: rule,
+			}
+			evaluator := iampolicymanagementv1.NewPolicyEvaluator([]iampolicymanagementv1.V2Policy{policy}, nil)
+			request := &iampolicymanagementv1.AccessRequest{
+				IamID:    "IBMid-123",
+				Resource: map[string]string{"accountId": "acct"},
+				Time:     time.Date(2024, time.January, 15, 10, 30, 0, 0, time.UTC),
+			}
+			decision, err := evaluator.Evaluate(request)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeTrue())
+
+			request.Time = time.Date(2024, time.January, 16, 10, 30, 0, 0, time.UTC)
+			decision, err = evaluator.Evaluate(request)
+			Expect(err).To(BeNil())
+			Expect(decision.Allowed).To(BeFalse())
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			iamPolicyManagementService, _ := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
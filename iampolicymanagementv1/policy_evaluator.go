@@ -0,0 +1,780 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The subject attributes recognized by the PolicyEvaluator.
+const (
+	SubjectAttributeIamID         = "iam_id"
+	SubjectAttributeAccessGroupID = "access_group_id"
+)
+
+// The environment attributes used in the keys of time-based rule conditions, e.g.
+// "{{environment.attributes.current_time}}".
+const (
+	EnvironmentAttributeCurrentTime     = "current_time"
+	EnvironmentAttributeCurrentDate     = "current_date"
+	EnvironmentAttributeCurrentDateTime = "current_date_time"
+	EnvironmentAttributeDayOfWeek       = "day_of_week"
+)
+
+// The logical operators of rules with nested conditions.
+const (
+	ruleOperatorAnd = "and"
+	ruleOperatorOr  = "or"
+)
+
+// ErrInvalidPolicy is returned (wrapped) by PolicyEvaluator.Evaluate() when a policy that
+// applies to the request contains a condition that cannot be evaluated, such as an unsupported
+// operator or a value in the wrong format. It can be detected with errors.Is().
+var ErrInvalidPolicy = errors.New("the policy cannot be evaluated")
+
+// AccessRequest : A request evaluated by a PolicyEvaluator.
+type AccessRequest struct {
+	// The IAM ID of the identity that makes the request.
+	IamID string
+
+	// The IDs of the access groups of which the identity is a member.
+	AccessGroupIDs []string
+
+	// Other attributes of the subject, matched by the subject attributes of the policies.
+	SubjectAttributes map[string]string
+
+	// The attributes of the target resource, e.g. "accountId", "serviceName", "serviceInstance",
+	// "resourceGroupId", "resourceType" and "resource". A policy matches the resource only if
+	// each of its resource attributes is satisfied, so every attribute used by the policies
+	// should be specified.
+	Resource map[string]string
+
+	// The tags of the target resource, in "key:value" form.
+	ResourceTags []string
+
+	// The role that is required (optional). Either a role CRN (e.g.
+	// "crn:v1:bluemix:public:iam::::role:Viewer") or the name of a role (e.g. "Viewer").
+	Role string
+
+	// The action that is required (optional, e.g. "iam.policy.read"). The action is granted by
+	// the roles that are associated with it by PolicyEvaluatorOptions.RoleActions, or by the
+	// enriched roles of policies retrieved with format=display.
+	Action string
+
+	// Other environment attributes used by the rule conditions (e.g. "ip_address").
+	Environment map[string]string
+
+	// The time of the request (defaults to the current time).
+	Time time.Time
+}
+
+// PolicyEvaluatorOptions : The options used to configure a PolicyEvaluator.
+type PolicyEvaluatorOptions struct {
+	// The actions of each role, keyed by role CRN, as returned by ListRoles().
+	RoleActions map[string][]string
+
+	// If true, policies that are not in the "active" state are evaluated too.
+	IncludeInactive bool
+}
+
+// PolicyEvaluator : Decides, without calling IAM, whether a set of v2 access policies grants
+// a request. Access is allowed if at least one policy matches the subject, the resource, the
+// resource tags and the rule conditions of the request, and grants the required role or action.
+type PolicyEvaluator struct {
+	policies []V2Policy
+	options  PolicyEvaluatorOptions
+}
+
+// NewPolicyEvaluator returns a PolicyEvaluator for the specified policies.
+func NewPolicyEvaluator(policies []V2Policy, options *PolicyEvaluatorOptions) *PolicyEvaluator {
+	evaluator := &PolicyEvaluator{policies: policies}
+	if options != nil {
+		evaluator.options = *options
+	}
+	return evaluator
+}
+
+// AccessDecision : The result of PolicyEvaluator.Evaluate().
+type AccessDecision struct {
+	// True if at least one policy grants the request.
+	Allowed bool
+
+	// The policies that grant the request.
+	Matches []*PolicyEvaluation
+
+	// The evaluation of each policy, in the order of the policies of the evaluator.
+	Evaluations []*PolicyEvaluation
+}
+
+// PolicyEvaluation : The evaluation of a policy against an AccessRequest.
+type PolicyEvaluation struct {
+	// The ID of the policy (or its index, e.g. "#2", if it has no ID).
+	PolicyID string
+
+	// The policy.
+	Policy *V2Policy
+
+	// True if the policy grants the request.
+	Matched bool
+
+	// The reason why the policy does not grant the request, if it does not.
+	Reason string
+
+	// The roles of the policy that grant the request.
+	Roles []string
+
+	// The results of the rule conditions of the policy, if any.
+	Conditions []*ConditionResult
+}
+
+// ConditionResult : The result of a rule condition.
+type ConditionResult struct {
+	// The key of the condition (e.g. "{{environment.attributes.current_time}}"), or "" for a
+	// group of nested conditions.
+	Key string
+
+	// The operator of the condition (e.g. "timeGreaterThanOrEquals", or "and" for a group).
+	Operator string
+
+	// The value of the condition.
+	Value interface{}
+
+	// True if the condition is satisfied.
+	Satisfied bool
+
+	// The results of the nested conditions of a group.
+	Conditions []*ConditionResult
+}
+
+// String returns a one-line description of the decision.
+func (decision *AccessDecision) String() string {
+	if !decision.Allowed {
+		return fmt.Sprintf("denied: none of the %d policies grants the request", len(decision.Evaluations))
+	}
+	descriptions := make([]string, len(decision.Matches))
+	for i, match := range decision.Matches {
+		descriptions[i] = fmt.Sprintf("policy '%s' (roles: %s)", match.PolicyID, strings.Join(match.Roles, ", "))
+	}
+	return "allowed by " + strings.Join(descriptions, "; ")
+}
+
+// String returns a description of the condition, e.g.
+// "{{environment.attributes.current_time}} timeLessThan 17:00:00+00:00: satisfied".
+func (result *ConditionResult) String() string {
+	outcome := "not satisfied"
+	if result.Satisfied {
+		outcome = "satisfied"
+	}
+	if result.Key == "" {
+		parts := make([]string, len(result.Conditions))
+		for i, condition := range result.Conditions {
+			parts[i] = condition.String()
+		}
+		return fmt.Sprintf("%s(%s): %s", result.Operator, strings.Join(parts, ", "), outcome)
+	}
+	return fmt.Sprintf("%s %s %v: %s", result.Key, result.Operator, result.Value, outcome)
+}
+
+// Evaluate decides whether the policies of the evaluator grant "request". Every policy is
+// evaluated, so that the decision explains why each policy does or does not match.
+// An error wrapping ErrInvalidPolicy is returned if a policy that matches the subject and
+// resource of the request has a condition that cannot be evaluated.
+func (evaluator *PolicyEvaluator) Evaluate(request *AccessRequest) (decision *AccessDecision, err error) {
+	if request == nil {
+		err = core.SDKErrorf(nil, "request cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if request.Time.IsZero() {
+		copied := *request
+		copied.Time = time.Now()
+		request = &copied
+	}
+
+	decision = &AccessDecision{}
+	for i := range evaluator.policies {
+		policy := &evaluator.policies[i]
+		evaluation, evalErr := evaluator.evaluatePolicy(policy, request)
+		if evaluation.PolicyID == "" {
+			evaluation.PolicyID = fmt.Sprintf("#%d", i)
+		}
+		if evalErr != nil {
+			err = core.SDKErrorf(fmt.Errorf("%w: policy '%s': %w", ErrInvalidPolicy, evaluation.PolicyID, evalErr),
+				"", "invalid-policy", common.GetComponentInfo())
+			return nil, err
+		}
+		decision.Evaluations = append(decision.Evaluations, evaluation)
+		if evaluation.Matched {
+			decision.Matches = append(decision.Matches, evaluation)
+		}
+	}
+	decision.Allowed = len(decision.Matches) > 0
+	return
+}
+
+// evaluatePolicy evaluates a single policy; the checks are performed from the cheapest to the
+// most expensive, and the first failure is reported.
+func (evaluator *PolicyEvaluator) evaluatePolicy(policy *V2Policy, request *AccessRequest) (evaluation *PolicyEvaluation, err error) {
+	evaluation = &PolicyEvaluation{PolicyID: core.StringNilMapper(policy.ID), Policy: policy}
+	if policy.Type != nil && *policy.Type != V2PolicyTypeAccessConst {
+		evaluation.Reason = fmt.Sprintf("the policy type is '%s'", *policy.Type)
+		return
+	}
+	if !evaluator.options.IncludeInactive && policy.State != nil && *policy.State != V2PolicyStateActiveConst {
+		evaluation.Reason = fmt.Sprintf("the policy state is '%s'", *policy.State)
+		return
+	}
+	if evaluation.Reason, err = matchSubject(policy.Subject, request); err != nil || evaluation.Reason != "" {
+		return
+	}
+	if evaluation.Reason, err = matchResource(policy.Resource, request); err != nil || evaluation.Reason != "" {
+		return
+	}
+	evaluation.Roles = evaluator.getGrantingRoles(policy, request)
+	if len(evaluation.Roles) == 0 {
+		evaluation.Reason = "the policy does not grant the required role or action"
+		return
+	}
+
+	condition := newRuleCondition(policy.Rule)
+	if condition != nil {
+		var result *ConditionResult
+		result, err = condition.evaluate(request)
+		if err != nil {
+			return
+		}
+		if condition.isGroup() && condition.key == "" {
+			evaluation.Conditions = result.Conditions
+		} else {
+			evaluation.Conditions = []*ConditionResult{result}
+		}
+		if !result.Satisfied {
+			evaluation.Reason = "the rule conditions are not satisfied"
+			return
+		}
+	}
+	evaluation.Matched = true
+	return
+}
+
+// matchSubject returns "" if the subject of the policy matches the request, or the reason why not.
+func matchSubject(subject *V2PolicySubject, request *AccessRequest) (string, error) {
+	if subject == nil {
+		return "", nil
+	}
+	for _, attribute := range subject.Attributes {
+		key := core.StringNilMapper(attribute.Key)
+		operator := core.StringNilMapper(attribute.Operator)
+		var values []string
+		switch key {
+		case SubjectAttributeIamID:
+			if request.IamID != "" {
+				values = []string{request.IamID}
+			}
+		case SubjectAttributeAccessGroupID:
+			values = request.AccessGroupIDs
+		default:
+			if value, found := request.SubjectAttributes[key]; found {
+				values = []string{value}
+			}
+		}
+		matched, err := matchAnyValue(operator, attribute.Value, values)
+		if err != nil {
+			return "", fmt.Errorf("subject attribute '%s': %w", key, err)
+		}
+		if !matched {
+			return fmt.Sprintf("the subject does not match %s %s %v", key, operator, attribute.Value), nil
+		}
+	}
+	return "", nil
+}
+
+// matchResource returns "" if the resource attributes and tags of the policy match the request,
+// or the reason why not.
+func matchResource(resource *V2PolicyResource, request *AccessRequest) (string, error) {
+	if resource == nil {
+		return "", nil
+	}
+	for _, attribute := range resource.Attributes {
+		key := core.StringNilMapper(attribute.Key)
+		operator := core.StringNilMapper(attribute.Operator)
+		var values []string
+		if value, found := request.Resource[key]; found {
+			values = []string{value}
+		}
+		matched, err := matchAnyValue(operator, attribute.Value, values)
+		if err != nil {
+			return "", fmt.Errorf("resource attribute '%s': %w", key, err)
+		}
+		if !matched {
+			return fmt.Sprintf("the resource does not match %s %s %v", key, operator, attribute.Value), nil
+		}
+	}
+	for _, tag := range resource.Tags {
+		key := core.StringNilMapper(tag.Key)
+		operator := core.StringNilMapper(tag.Operator)
+		var values []string
+		for _, resourceTag := range request.ResourceTags {
+			if tagKey, tagValue, found := strings.Cut(resourceTag, ":"); found && tagKey == key {
+				values = append(values, tagValue)
+			}
+		}
+		matched, err := matchAnyValue(operator, core.StringNilMapper(tag.Value), values)
+		if err != nil {
+			return "", fmt.Errorf("resource tag '%s': %w", key, err)
+		}
+		if !matched {
+			return fmt.Sprintf("the resource tags do not match %s %s %s", key, operator, core.StringNilMapper(tag.Value)), nil
+		}
+	}
+	return "", nil
+}
+
+// matchAnyValue returns true if one of the attribute values "values" satisfies the string
+// operator "operator" with the policy value "expected". An attribute without values is absent.
+func matchAnyValue(operator string, expected interface{}, values []string) (bool, error) {
+	if operator == V2PolicyResourceAttributeOperatorStringexistsConst {
+		exists, err := toBool(expected)
+		if err != nil {
+			return false, err
+		}
+		return exists == (len(values) > 0), nil
+	}
+	var patterns []string
+	switch operator {
+	case V2PolicyResourceAttributeOperatorStringequalsConst, V2PolicyResourceAttributeOperatorStringmatchConst:
+		pattern, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("the operator '%s' requires a string value, found %T", operator, expected)
+		}
+		patterns = []string{pattern}
+	case V2PolicyResourceAttributeOperatorStringequalsanyofConst, V2PolicyResourceAttributeOperatorStringmatchanyofConst:
+		var err error
+		if patterns, err = toStrings(expected); err != nil {
+			return false, fmt.Errorf("the operator '%s' requires an array of strings: %w", operator, err)
+		}
+	default:
+		return false, fmt.Errorf("the operator '%s' is not supported", operator)
+	}
+	wildcards := operator == V2PolicyResourceAttributeOperatorStringmatchConst ||
+		operator == V2PolicyResourceAttributeOperatorStringmatchanyofConst
+	for _, value := range values {
+		for _, pattern := range patterns {
+			if (wildcards && matchWildcards(pattern, value)) || (!wildcards && pattern == value) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// matchWildcards reports whether "value" matches "pattern", in which "*" matches any sequence
+// of characters and "?" matches a single character.
+func matchWildcards(pattern string, value string) bool {
+	p, v := []rune(pattern), []rune(value)
+	// On a mismatch, backtrack to the last "*" and let it match one more character.
+	star, match := -1, 0
+	i, j := 0, 0
+	for j < len(v) {
+		switch {
+		case i < len(p) && (p[i] == '?' || p[i] == v[j]):
+			i++
+			j++
+		case i < len(p) && p[i] == '*':
+			star, match = i, j
+			i++
+		case star >= 0:
+			i = star + 1
+			match++
+			j = match
+		default:
+			return false
+		}
+	}
+	for i < len(p) && p[i] == '*' {
+		i++
+	}
+	return i == len(p)
+}
+
+// getGrantingRoles returns the roles of the policy that grant the role or action of the request.
+func (evaluator *PolicyEvaluator) getGrantingRoles(policy *V2Policy, request *AccessRequest) []string {
+	type grantedRole struct {
+		id      string
+		actions []string
+	}
+	var roles []grantedRole
+	switch control := policy.Control.(type) {
+	case *ControlResponse:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				roles = append(roles, grantedRole{id: core.StringNilMapper(role.RoleID)})
+			}
+		}
+	case *ControlResponseControl:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				roles = append(roles, grantedRole{id: core.StringNilMapper(role.RoleID)})
+			}
+		}
+	case *ControlResponseControlWithEnrichedRoles:
+		if control.Grant != nil {
+			for _, role := range control.Grant.Roles {
+				granted := grantedRole{id: core.StringNilMapper(role.RoleID)}
+				for _, action := range role.Actions {
+					granted.actions = append(granted.actions, core.StringNilMapper(action.ID))
+				}
+				roles = append(roles, granted)
+			}
+		}
+	}
+
+	var granting []string
+	for _, role := range roles {
+		if request.Role != "" && !IsSameRole(role.id, request.Role) {
+			continue
+		}
+		if request.Action != "" {
+			actions := role.actions
+			if len(actions) == 0 {
+				actions = evaluator.options.RoleActions[role.id]
+			}
+			if !containsString(actions, request.Action) {
+				continue
+			}
+		}
+		granting = append(granting, role.id)
+	}
+	return granting
+}
+
+// IsSameRole returns true if "role" identifies the role CRN "roleCRN". "role" may be a role CRN
+// or the name of a role, which matches the last segment of the CRN
+// (e.g. "Viewer" matches "crn:v1:bluemix:public:iam::::role:Viewer").
+func IsSameRole(roleCRN string, role string) bool {
+	if roleCRN == role {
+		return true
+	}
+	if strings.Contains(role, ":") {
+		return false
+	}
+	index := strings.LastIndex(roleCRN, ":")
+	return index >= 0 && roleCRN[index+1:] == role
+}
+
+// ruleCondition is the common form of the rule and condition models of a policy: either a
+// single condition (key, operator and value) or a group of conditions ("and" or "or").
+type ruleCondition struct {
+	key        string
+	operator   string
+	value      interface{}
+	conditions []*ruleCondition
+}
+
+func (condition *ruleCondition) isGroup() bool {
+	return condition.operator == ruleOperatorAnd || condition.operator == ruleOperatorOr
+}
+
+// newRuleCondition converts any of the rule models into a ruleCondition, or returns nil if the
+// policy has no rule.
+func newRuleCondition(rule V2PolicyRuleIntf) *ruleCondition {
+	switch rule := rule.(type) {
+	case *V2PolicyRule:
+		if rule == nil {
+			return nil
+		}
+		condition := &ruleCondition{key: core.StringNilMapper(rule.Key), operator: core.StringNilMapper(rule.Operator), value: rule.Value}
+		for _, nested := range rule.Conditions {
+			condition.conditions = append(condition.conditions, newNestedCondition(nested))
+		}
+		return condition
+	case *V2PolicyRuleRuleAttribute:
+		if rule == nil {
+			return nil
+		}
+		return &ruleCondition{key: core.StringNilMapper(rule.Key), operator: core.StringNilMapper(rule.Operator), value: rule.Value}
+	case *V2PolicyRuleRuleWithNestedConditions:
+		if rule == nil {
+			return nil
+		}
+		condition := &ruleCondition{operator: core.StringNilMapper(rule.Operator)}
+		for _, nested := range rule.Conditions {
+			condition.conditions = append(condition.conditions, newNestedCondition(nested))
+		}
+		return condition
+	}
+	return nil
+}
+
+func newNestedCondition(nested NestedConditionIntf) *ruleCondition {
+	condition := &ruleCondition{}
+	var attributes []RuleAttribute
+	switch nested := nested.(type) {
+	case *NestedCondition:
+		condition.key, condition.operator, condition.value = core.StringNilMapper(nested.Key), core.StringNilMapper(nested.Operator), nested.Value
+		attributes = nested.Conditions
+	case *NestedConditionRuleAttribute:
+		condition.key, condition.operator, condition.value = core.StringNilMapper(nested.Key), core.StringNilMapper(nested.Operator), nested.Value
+	case *NestedConditionRuleWithConditions:
+		condition.operator = core.StringNilMapper(nested.Operator)
+		attributes = nested.Conditions
+	}
+	for _, attribute := range attributes {
+		condition.conditions = append(condition.conditions, &ruleCondition{
+			key:      core.StringNilMapper(attribute.Key),
+			operator: core.StringNilMapper(attribute.Operator),
+			value:    attribute.Value,
+		})
+	}
+	return condition
+}
+
+// evaluate evaluates the condition (and its nested conditions) for the request.
+func (condition *ruleCondition) evaluate(request *AccessRequest) (*ConditionResult, error) {
+	result := &ConditionResult{Key: condition.key, Operator: condition.operator, Value: condition.value}
+	if condition.isGroup() {
+		if len(condition.conditions) == 0 {
+			return nil, fmt.Errorf("the '%s' rule has no conditions", condition.operator)
+		}
+		result.Key, result.Value = "", nil
+		result.Satisfied = condition.operator == ruleOperatorAnd
+		for _, nested := range condition.conditions {
+			nestedResult, err := nested.evaluate(request)
+			if err != nil {
+				return nil, err
+			}
+			result.Conditions = append(result.Conditions, nestedResult)
+			if condition.operator == ruleOperatorAnd {
+				result.Satisfied = result.Satisfied && nestedResult.Satisfied
+			} else {
+				result.Satisfied = result.Satisfied || nestedResult.Satisfied
+			}
+		}
+		return result, nil
+	}
+
+	satisfied, err := evaluateCondition(condition.key, condition.operator, condition.value, request)
+	if err != nil {
+		return nil, fmt.Errorf("condition '%s %s': %w", condition.key, condition.operator, err)
+	}
+	result.Satisfied = satisfied
+	return result, nil
+}
+
+// evaluateCondition evaluates a single rule condition. Date and time operators compare the time
+// of the request; string operators compare the environment attribute named by the key.
+func evaluateCondition(key string, operator string, value interface{}, request *AccessRequest) (bool, error) {
+	switch operator {
+	case RuleAttributeOperatorTimegreaterthanConst, RuleAttributeOperatorTimegreaterthanorequalsConst,
+		RuleAttributeOperatorTimelessthanConst, RuleAttributeOperatorTimelessthanorequalsConst:
+		limit, err := parseConditionTime(value)
+		if err != nil {
+			return false, err
+		}
+		current := request.Time.In(limit.Location())
+		currentSeconds := current.Hour()*3600 + current.Minute()*60 + current.Second()
+		limitSeconds := limit.Hour()*3600 + limit.Minute()*60 + limit.Second()
+		return compareOrdered(operator, currentSeconds, limitSeconds), nil
+
+	case RuleAttributeOperatorDatetimegreaterthanConst, RuleAttributeOperatorDatetimegreaterthanorequalsConst,
+		RuleAttributeOperatorDatetimelessthanConst, RuleAttributeOperatorDatetimelessthanorequalsConst:
+		limit, err := parseConditionDateTime(value)
+		if err != nil {
+			return false, err
+		}
+		return compareOrdered(operator, request.Time.Unix(), limit.Unix()), nil
+
+	case RuleAttributeOperatorDategreaterthanConst, RuleAttributeOperatorDategreaterthanorequalsConst,
+		RuleAttributeOperatorDatelessthanConst, RuleAttributeOperatorDatelessthanorequalsConst:
+		limit, err := parseConditionDate(value)
+		if err != nil {
+			return false, err
+		}
+		current := request.Time.In(limit.Location()).Format("2006-01-02")
+		return compareOrdered(operator, current, limit.Format("2006-01-02")), nil
+
+	case RuleAttributeOperatorDayofweekequalsConst, RuleAttributeOperatorDayofweekanyofConst:
+		var days []string
+		if operator == RuleAttributeOperatorDayofweekequalsConst {
+			day, ok := value.(string)
+			if !ok {
+				return false, fmt.Errorf("the operator '%s' requires a string value, found %T", operator, value)
+			}
+			days = []string{day}
+		} else {
+			var err error
+			if days, err = toStrings(value); err != nil {
+				return false, fmt.Errorf("the operator '%s' requires an array of strings: %w", operator, err)
+			}
+		}
+		for _, day := range days {
+			weekday, location, err := parseConditionDay(day)
+			if err != nil {
+				return false, err
+			}
+			if isoWeekday(request.Time.In(location)) == weekday {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case RuleAttributeOperatorStringequalsConst, RuleAttributeOperatorStringequalsanyofConst,
+		RuleAttributeOperatorStringmatchConst, RuleAttributeOperatorStringmatchanyofConst, RuleAttributeOperatorStringexistsConst:
+		var values []string
+		if attribute, found := request.Environment[getEnvironmentAttributeName(key)]; found {
+			values = []string{attribute}
+		}
+		return matchAnyValue(operator, value, values)
+	}
+	return false, fmt.Errorf("the operator '%s' is not supported", operator)
+}
+
+// getEnvironmentAttributeName returns the name of the attribute referenced by a rule key, e.g.
+// "ip_address" for "{{environment.attributes.ip_address}}".
+func getEnvironmentAttributeName(key string) string {
+	name := strings.TrimSuffix(strings.TrimPrefix(key, "{{"), "}}")
+	return strings.TrimPrefix(name, "environment.attributes.")
+}
+
+// compareOrdered applies the comparison of a "...GreaterThan", "...GreaterThanOrEquals",
+// "...LessThan" or "...LessThanOrEquals" operator to "current" and "limit".
+func compareOrdered[T int | int64 | string](operator string, current T, limit T) bool {
+	switch {
+	case strings.HasSuffix(operator, "GreaterThan"):
+		return current > limit
+	case strings.HasSuffix(operator, "GreaterThanOrEquals"):
+		return current >= limit
+	case strings.HasSuffix(operator, "LessThan"):
+		return current < limit
+	default:
+		return current <= limit
+	}
+}
+
+// parseConditionTime parses a time of day with a UTC offset, e.g. "09:00:00+00:00".
+func parseConditionTime(value interface{}) (time.Time, error) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("a time value must be a string, found %T", value)
+	}
+	for _, layout := range []string{"15:04:05Z07:00", "15:04Z07:00"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("the time '%s' is not in the format 'hh:mm:ss+hh:mm'", s)
+}
+
+// parseConditionDateTime parses an ISO 8601 date and time, e.g. "2024-01-01T09:00:00+00:00".
+func parseConditionDateTime(value interface{}) (time.Time, error) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("a date-time value must be a string, found %T", value)
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("the date-time '%s' is not in the ISO 8601 format 'yyyy-mm-ddThh:mm:ss+hh:mm'", s)
+	}
+	return t, nil
+}
+
+// parseConditionDate parses a date with an optional UTC offset, e.g. "2024-01-01+00:00".
+func parseConditionDate(value interface{}) (time.Time, error) {
+	s, ok := value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("a date value must be a string, found %T", value)
+	}
+	for _, layout := range []string{"2006-01-02Z07:00", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("the date '%s' is not in the ISO 8601 format 'yyyy-mm-dd+hh:mm'", s)
+}
+
+// parseConditionDay parses an ISO 8601 day of the week (1 for Monday to 7 for Sunday) with an
+// optional UTC offset, e.g. "1+00:00".
+func parseConditionDay(s string) (weekday int, location *time.Location, err error) {
+	location = time.UTC
+	if len(s) == 0 || s[0] < '1' || s[0] > '7' {
+		return 0, nil, fmt.Errorf("the day of the week '%s' is not in the format 'd+hh:mm' (1 for Monday to 7 for Sunday)", s)
+	}
+	weekday = int(s[0] - '0')
+	if offset := s[1:]; offset != "" {
+		t, parseErr := time.Parse("Z07:00", offset)
+		if parseErr != nil {
+			return 0, nil, fmt.Errorf("the day of the week '%s' is not in the format 'd+hh:mm' (1 for Monday to 7 for Sunday)", s)
+		}
+		location = t.Location()
+	}
+	return
+}
+
+// isoWeekday returns the ISO 8601 day of the week of "t" (1 for Monday to 7 for Sunday).
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch value := value.(type) {
+	case bool:
+		return value, nil
+	case string:
+		switch value {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		}
+	}
+	return false, fmt.Errorf("the operator 'stringExists' requires a boolean value, found %v", value)
+}
+
+func toStrings(value interface{}) ([]string, error) {
+	switch value := value.(type) {
+	case []string:
+		return value, nil
+	case []interface{}:
+		values := make([]string, len(value))
+		for i, element := range value {
+			s, ok := element.(string)
+			if !ok {
+				return nil, fmt.Errorf("element %d is a %T", i, element)
+			}
+			values[i] = s
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("found %T", value)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,488 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package iampolicymanagementv1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The patterns of policies with time-based conditions, as returned by RuleBuilder.Pattern().
+const (
+	PolicyPatternTimeBasedOnce              = "time-based-conditions:once"
+	PolicyPatternTimeBasedWeeklyAllDay      = "time-based-conditions:weekly:all-day"
+	PolicyPatternTimeBasedWeeklyCustomHours = "time-based-conditions:weekly:custom-hours"
+)
+
+// MaxRuleNestingDepth is the maximum depth of the groups of conditions of a policy rule: the
+// rule itself may be a group whose conditions are groups, but those may only contain conditions.
+const MaxRuleNestingDepth = 2
+
+// ErrInvalidRule is returned (wrapped) by ValidateV2PolicyRule() and RuleBuilder.Build() when a
+// policy rule would be rejected by IAM. It can be detected with errors.Is().
+var ErrInvalidRule = errors.New("invalid policy rule")
+
+// The formats of the values of time-based conditions.
+const (
+	ruleTimeLayout     = "15:04:05-07:00"
+	ruleDateTimeLayout = "2006-01-02T15:04:05-07:00"
+	ruleDateLayout     = "2006-01-02-07:00"
+	ruleOffsetLayout   = "-07:00"
+)
+
+// RuleCondition : A condition of a v2 policy rule, or a group of conditions, as created by the
+// condition functions (e.g. TimeBetween() or DayOfWeekAnyOf()) and combined by a RuleBuilder.
+type RuleCondition struct {
+	// The key of the condition (e.g. "{{environment.attributes.current_time}}"), or "" for a group.
+	Key string
+
+	// The operator of the condition (e.g. "timeLessThanOrEquals"), or "and" or "or" for a group.
+	Operator string
+
+	// The value of the condition.
+	Value interface{}
+
+	// The conditions of a group.
+	Conditions []RuleCondition
+}
+
+// RuleBuilder : Builds the rule of a v2 policy from typed conditions, e.g.
+//
+//	rule, err := iampolicymanagementv1.Rule().
+//		And(
+//			iampolicymanagementv1.DayOfWeekAnyOf(time.UTC, time.Monday, time.Tuesday),
+//			iampolicymanagementv1.TimeBetween(start, end),
+//		).
+//		Build()
+type RuleBuilder struct {
+	root *RuleCondition
+}
+
+// Rule returns a new RuleBuilder.
+func Rule() *RuleBuilder {
+	return &RuleBuilder{}
+}
+
+// And makes the rule require all of the conditions.
+func (builder *RuleBuilder) And(conditions ...RuleCondition) *RuleBuilder {
+	group := AllOf(conditions...)
+	builder.root = &group
+	return builder
+}
+
+// Or makes the rule require any of the conditions.
+func (builder *RuleBuilder) Or(conditions ...RuleCondition) *RuleBuilder {
+	group := AnyOf(conditions...)
+	builder.root = &group
+	return builder
+}
+
+// When makes the rule consist of a single condition (or group of conditions).
+func (builder *RuleBuilder) When(condition RuleCondition) *RuleBuilder {
+	builder.root = &condition
+	return builder
+}
+
+// Build returns the rule, which can be set as the Rule of CreateV2PolicyOptions or
+// ReplaceV2PolicyOptions. An error wrapping ErrInvalidRule is returned if the rule is not valid.
+func (builder *RuleBuilder) Build() (rule V2PolicyRuleIntf, err error) {
+	if builder.root == nil {
+		err = fmt.Errorf("%w: the rule has no conditions", ErrInvalidRule)
+		err = core.SDKErrorf(err, "", "invalid-rule", common.GetComponentInfo())
+		return
+	}
+	root := builder.root
+	if !root.isGroup() {
+		rule = &V2PolicyRuleRuleAttribute{Key: core.StringPtr(root.Key), Operator: core.StringPtr(root.Operator), Value: root.Value}
+	} else {
+		nested := make([]NestedConditionIntf, len(root.Conditions))
+		for i, condition := range root.Conditions {
+			if !condition.isGroup() {
+				nested[i] = &NestedConditionRuleAttribute{
+					Key: core.StringPtr(condition.Key), Operator: core.StringPtr(condition.Operator), Value: condition.Value,
+				}
+				continue
+			}
+			attributes := make([]RuleAttribute, len(condition.Conditions))
+			for j, attribute := range condition.Conditions {
+				attributes[j] = RuleAttribute{
+					Key: core.StringPtr(attribute.Key), Operator: core.StringPtr(attribute.Operator), Value: attribute.Value,
+				}
+			}
+			nested[i] = &NestedConditionRuleWithConditions{Operator: core.StringPtr(condition.Operator), Conditions: attributes}
+		}
+		rule = &V2PolicyRuleRuleWithNestedConditions{Operator: core.StringPtr(root.Operator), Conditions: nested}
+	}
+	if err = ValidateV2PolicyRule(rule); err != nil {
+		rule = nil
+	}
+	return
+}
+
+// Pattern returns the pattern of a policy with the rule (one of the PolicyPattern constants), or
+// "" if the rule has no time-based conditions:
+//   - A rule with a date-time condition grants access once, for a period of time.
+//   - A rule with a day of week condition grants access weekly, on all day unless the rule
+//     also has a time condition.
+func (builder *RuleBuilder) Pattern() string {
+	if builder.root == nil {
+		return ""
+	}
+	keys := make(map[string]bool)
+	builder.root.collectKeys(keys)
+	switch {
+	case keys[EnvironmentAttributeCurrentDateTime]:
+		return PolicyPatternTimeBasedOnce
+	case keys[EnvironmentAttributeDayOfWeek] && keys[EnvironmentAttributeCurrentTime]:
+		return PolicyPatternTimeBasedWeeklyCustomHours
+	case keys[EnvironmentAttributeDayOfWeek]:
+		return PolicyPatternTimeBasedWeeklyAllDay
+	}
+	return ""
+}
+
+func (condition *RuleCondition) isGroup() bool {
+	return condition.Operator == ruleOperatorAnd || condition.Operator == ruleOperatorOr
+}
+
+func (condition *RuleCondition) collectKeys(keys map[string]bool) {
+	if condition.Key != "" {
+		keys[getEnvironmentAttributeName(condition.Key)] = true
+	}
+	for i := range condition.Conditions {
+		condition.Conditions[i].collectKeys(keys)
+	}
+}
+
+// AllOf returns a group of conditions that is satisfied if all of the conditions are satisfied.
+// Conditions that are themselves "and" groups, such as those returned by TimeBetween(), are
+// merged into the group.
+func AllOf(conditions ...RuleCondition) RuleCondition {
+	return newConditionGroup(ruleOperatorAnd, conditions)
+}
+
+// AnyOf returns a group of conditions that is satisfied if any of the conditions is satisfied.
+// Conditions that are themselves "or" groups are merged into the group.
+func AnyOf(conditions ...RuleCondition) RuleCondition {
+	return newConditionGroup(ruleOperatorOr, conditions)
+}
+
+func newConditionGroup(operator string, conditions []RuleCondition) RuleCondition {
+	group := RuleCondition{Operator: operator}
+	for _, condition := range conditions {
+		if condition.Operator == operator && condition.Key == "" {
+			group.Conditions = append(group.Conditions, condition.Conditions...)
+		} else {
+			group.Conditions = append(group.Conditions, condition)
+		}
+	}
+	return group
+}
+
+// EnvironmentAttributeKey returns the rule key of an environment attribute, e.g.
+// "{{environment.attributes.current_time}}" for EnvironmentAttributeCurrentTime.
+func EnvironmentAttributeKey(name string) string {
+	return "{{environment.attributes." + name + "}}"
+}
+
+// TimeAfter returns a condition that is satisfied at and after the time of day of "t", in the
+// time zone of "t".
+func TimeAfter(t time.Time) RuleCondition {
+	return RuleCondition{
+		Key:      EnvironmentAttributeKey(EnvironmentAttributeCurrentTime),
+		Operator: RuleAttributeOperatorTimegreaterthanorequalsConst,
+		Value:    t.Format(ruleTimeLayout),
+	}
+}
+
+// TimeBefore returns a condition that is satisfied until (and at) the time of day of "t", in the
+// time zone of "t".
+func TimeBefore(t time.Time) RuleCondition {
+	return RuleCondition{
+		Key:      EnvironmentAttributeKey(EnvironmentAttributeCurrentTime),
+		Operator: RuleAttributeOperatorTimelessthanorequalsConst,
+		Value:    t.Format(ruleTimeLayout),
+	}
+}
+
+// TimeBetween returns a group of conditions that is satisfied every day between the times of
+// day of "start" and "end" (inclusive). Only the time of day and time zone of the arguments are used.
+func TimeBetween(start time.Time, end time.Time) RuleCondition {
+	return AllOf(TimeAfter(start), TimeBefore(end))
+}
+
+// DateTimeAfter returns a condition that is satisfied at and after "t".
+func DateTimeAfter(t time.Time) RuleCondition {
+	return RuleCondition{
+		Key:      EnvironmentAttributeKey(EnvironmentAttributeCurrentDateTime),
+		Operator: RuleAttributeOperatorDatetimegreaterthanorequalsConst,
+		Value:    t.Format(ruleDateTimeLayout),
+	}
+}
+
+// DateTimeBefore returns a condition that is satisfied until (and at) "t".
+func DateTimeBefore(t time.Time) RuleCondition {
+	return RuleCondition{
+		Key:      EnvironmentAttributeKey(EnvironmentAttributeCurrentDateTime),
+		Operator: RuleAttributeOperatorDatetimelessthanorequalsConst,
+		Value:    t.Format(ruleDateTimeLayout),
+	}
+}
+
+// DateTimeBetween returns a group of conditions that is satisfied from "start" until "end" (inclusive).
+func DateTimeBetween(start time.Time, end time.Time) RuleCondition {
+	return AllOf(DateTimeAfter(start), DateTimeBefore(end))
+}
+
+// DayOfWeekAnyOf returns a condition that is satisfied on the specified days of the week in the
+// time zone "zone" (time.UTC if nil). The UTC offset of the zone at the current time is used.
+func DayOfWeekAnyOf(zone *time.Location, days ...time.Weekday) RuleCondition {
+	if zone == nil {
+		zone = time.UTC
+	}
+	offset := time.Now().In(zone).Format(ruleOffsetLayout)
+	values := make([]interface{}, len(days))
+	for i, day := range days {
+		weekday := int(day)
+		if day == time.Sunday {
+			weekday = 7
+		}
+		values[i] = fmt.Sprintf("%d%s", weekday, offset)
+	}
+	return RuleCondition{
+		Key:      EnvironmentAttributeKey(EnvironmentAttributeDayOfWeek),
+		Operator: RuleAttributeOperatorDayofweekanyofConst,
+		Value:    values,
+	}
+}
+
+// StringEquals returns a condition that is satisfied if the environment attribute "attribute"
+// (e.g. "ip_address") is equal to "value".
+func StringEquals(attribute string, value string) RuleCondition {
+	return newStringCondition(attribute, RuleAttributeOperatorStringequalsConst, value)
+}
+
+// StringEqualsAnyOf returns a condition that is satisfied if the environment attribute
+// "attribute" is equal to any of the values.
+func StringEqualsAnyOf(attribute string, values ...string) RuleCondition {
+	return newStringCondition(attribute, RuleAttributeOperatorStringequalsanyofConst, toInterfaces(values))
+}
+
+// StringMatch returns a condition that is satisfied if the environment attribute "attribute"
+// matches "pattern", in which "*" matches any sequence of characters and "?" a single character.
+func StringMatch(attribute string, pattern string) RuleCondition {
+	return newStringCondition(attribute, RuleAttributeOperatorStringmatchConst, pattern)
+}
+
+// StringMatchAnyOf returns a condition that is satisfied if the environment attribute
+// "attribute" matches any of the patterns.
+func StringMatchAnyOf(attribute string, patterns ...string) RuleCondition {
+	return newStringCondition(attribute, RuleAttributeOperatorStringmatchanyofConst, toInterfaces(patterns))
+}
+
+// StringExists returns a condition that is satisfied if the environment attribute "attribute"
+// is present (if "exists" is true) or absent (if "exists" is false).
+func StringExists(attribute string, exists bool) RuleCondition {
+	return newStringCondition(attribute, RuleAttributeOperatorStringexistsConst, exists)
+}
+
+func newStringCondition(attribute string, operator string, value interface{}) RuleCondition {
+	key := attribute
+	if !strings.HasPrefix(key, "{{") {
+		key = EnvironmentAttributeKey(attribute)
+	}
+	return RuleCondition{Key: key, Operator: operator, Value: value}
+}
+
+func toInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
+
+// ValidateV2PolicyRule checks, without calling IAM, that a policy rule is well formed:
+//   - Groups use the "and" or "or" operator, have at least one condition and are nested at most
+//     MaxRuleNestingDepth levels deep.
+//   - Conditions have a key, a supported operator and a value of the type required by the operator.
+//   - Date and time conditions use the keys of the corresponding environment attributes and
+//     values in the exact ISO 8601 formats expected by IAM, with seconds and a numeric UTC
+//     offset: "09:00:00+00:00" for times, "2024-01-01T09:00:00+00:00" for dates and times,
+//     "2024-01-01+00:00" for dates and "1+00:00" (Monday) for days of the week.
+//
+// An error wrapping ErrInvalidRule that lists every problem is returned if the rule is not valid.
+// A nil rule is valid.
+func ValidateV2PolicyRule(rule V2PolicyRuleIntf) error {
+	condition := newRuleCondition(rule)
+	if condition == nil {
+		return nil
+	}
+	var problems []string
+	validateRuleCondition(condition, "rule", 1, &problems)
+	if len(problems) == 0 {
+		return nil
+	}
+	err := fmt.Errorf("%w: %s", ErrInvalidRule, strings.Join(problems, "; "))
+	return core.SDKErrorf(err, "", "invalid-rule", common.GetComponentInfo())
+}
+
+// validateRuleCondition appends the problems of "condition", found at "path" and at the
+// specified nesting depth, to "problems".
+func validateRuleCondition(condition *ruleCondition, path string, depth int, problems *[]string) {
+	report := func(format string, args ...interface{}) {
+		*problems = append(*problems, path+": "+fmt.Sprintf(format, args...))
+	}
+	if condition.isGroup() {
+		if depth > MaxRuleNestingDepth {
+			report("groups of conditions may only be nested %d levels deep", MaxRuleNestingDepth)
+			return
+		}
+		if condition.key != "" || condition.value != nil {
+			report("a group of conditions ('%s') cannot have a key or value", condition.operator)
+		}
+		if len(condition.conditions) == 0 {
+			report("a group of conditions ('%s') requires at least one condition", condition.operator)
+		}
+		for i, nested := range condition.conditions {
+			validateRuleCondition(nested, fmt.Sprintf("%s.conditions[%d]", path, i), depth+1, problems)
+		}
+		return
+	}
+
+	if len(condition.conditions) > 0 {
+		report("nested conditions require the operator 'and' or 'or', found '%s'", condition.operator)
+		return
+	}
+	if condition.operator == "" {
+		report("the operator is required")
+		return
+	}
+	if condition.key == "" {
+		report("the key is required")
+	}
+	if condition.value == nil {
+		report("the value is required")
+		return
+	}
+	if requiredKey := getRequiredKey(condition.operator); requiredKey != "" && condition.key != "" &&
+		getEnvironmentAttributeName(condition.key) != requiredKey {
+		report("the operator '%s' requires the key '%s', found '%s'", condition.operator,
+			EnvironmentAttributeKey(requiredKey), condition.key)
+	}
+	if err := validateConditionValue(condition.operator, condition.value); err != nil {
+		report("%s", err.Error())
+	}
+}
+
+// getRequiredKey returns the environment attribute compared by a date or time operator, or ""
+// for the other operators.
+func getRequiredKey(operator string) string {
+	switch {
+	case strings.HasPrefix(operator, "dateTime"):
+		return EnvironmentAttributeCurrentDateTime
+	case strings.HasPrefix(operator, "date"):
+		return EnvironmentAttributeCurrentDate
+	case strings.HasPrefix(operator, "time"):
+		return EnvironmentAttributeCurrentTime
+	case strings.HasPrefix(operator, "dayOfWeek"):
+		return EnvironmentAttributeDayOfWeek
+	}
+	return ""
+}
+
+// validateConditionValue returns an error if "value" is not valid for "operator". Dates and
+// times must be in the exact formats expected by IAM: unlike the PolicyEvaluator, which also
+// accepts "Z", times without seconds and values without a UTC offset, the validator rejects
+// what IAM would reject.
+func validateConditionValue(operator string, value interface{}) error {
+	switch operator {
+	case RuleAttributeOperatorTimegreaterthanConst, RuleAttributeOperatorTimegreaterthanorequalsConst,
+		RuleAttributeOperatorTimelessthanConst, RuleAttributeOperatorTimelessthanorequalsConst:
+		return validateConditionLayout(value, ruleTimeLayout, "time", "the format 'hh:mm:ss+hh:mm'")
+	case RuleAttributeOperatorDatetimegreaterthanConst, RuleAttributeOperatorDatetimegreaterthanorequalsConst,
+		RuleAttributeOperatorDatetimelessthanConst, RuleAttributeOperatorDatetimelessthanorequalsConst:
+		return validateConditionLayout(value, ruleDateTimeLayout, "date-time", "the ISO 8601 format 'yyyy-mm-ddThh:mm:ss+hh:mm'")
+	case RuleAttributeOperatorDategreaterthanConst, RuleAttributeOperatorDategreaterthanorequalsConst,
+		RuleAttributeOperatorDatelessthanConst, RuleAttributeOperatorDatelessthanorequalsConst:
+		return validateConditionLayout(value, ruleDateLayout, "date", "the ISO 8601 format 'yyyy-mm-dd+hh:mm'")
+	case RuleAttributeOperatorDayofweekequalsConst:
+		day, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("the operator '%s' requires a string value, found %T", operator, value)
+		}
+		return validateConditionDay(day)
+	case RuleAttributeOperatorDayofweekanyofConst:
+		days, err := toStrings(value)
+		if err != nil || len(days) == 0 {
+			return fmt.Errorf("the operator '%s' requires a non-empty array of strings", operator)
+		}
+		for _, day := range days {
+			if err = validateConditionDay(day); err != nil {
+				return err
+			}
+		}
+		return nil
+	case RuleAttributeOperatorStringequalsConst, RuleAttributeOperatorStringmatchConst:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("the operator '%s' requires a string value, found %T", operator, value)
+		}
+		return nil
+	case RuleAttributeOperatorStringequalsanyofConst, RuleAttributeOperatorStringmatchanyofConst:
+		if values, err := toStrings(value); err != nil || len(values) == 0 {
+			return fmt.Errorf("the operator '%s' requires a non-empty array of strings", operator)
+		}
+		return nil
+	case RuleAttributeOperatorStringexistsConst:
+		_, err := toBool(value)
+		return err
+	}
+	return fmt.Errorf("the operator '%s' is not supported", operator)
+}
+
+// validateConditionLayout returns an error if "value" is not a string in the format "layout".
+// "kind" and "format" describe the value and the format in the error.
+func validateConditionLayout(value interface{}, layout string, kind string, format string) error {
+	s, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("a %s value must be a string, found %T", kind, value)
+	}
+	// Formatting the parsed value rejects what time.Parse() tolerates, such as fractional seconds.
+	if t, err := time.Parse(layout, s); err != nil || t.Format(layout) != s {
+		return fmt.Errorf("the %s '%s' is not in %s", kind, s, format)
+	}
+	return nil
+}
+
+// validateConditionDay returns an error if "day" is not an ISO 8601 day of the week (1 for
+// Monday to 7 for Sunday) followed by a UTC offset, e.g. "1+00:00".
+func validateConditionDay(day string) error {
+	valid := len(day) > 0 && day[0] >= '1' && day[0] <= '7'
+	if valid {
+		offset, err := time.Parse(ruleOffsetLayout, day[1:])
+		valid = err == nil && offset.Format(ruleOffsetLayout) == day[1:]
+	}
+	if !valid {
+		return fmt.Errorf("the day of the week '%s' is not in the format 'd+hh:mm' (1 for Monday to 7 for Sunday)", day)
+	}
+	return nil
+}
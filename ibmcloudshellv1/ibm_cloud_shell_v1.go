@@ -207,7 +207,7 @@ func (ibmCloudShell *IBMCloudShellV1) GetAccountSettingsWithContext(ctx context.
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = ibmCloudShell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(ibmCloudShell.Service, "ibm_cloud_shell", "V1", "GetAccountSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -295,7 +295,7 @@ func (ibmCloudShell *IBMCloudShellV1) UpdateAccountSettingsWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = ibmCloudShell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(ibmCloudShell.Service, "ibm_cloud_shell", "V1", "UpdateAccountSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
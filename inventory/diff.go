@@ -0,0 +1,206 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The types of the changes reported by Compare().
+const (
+	ChangeTypeAdded    = "added"
+	ChangeTypeRemoved  = "removed"
+	ChangeTypeModified = "modified"
+)
+
+// Diff : The changes between two snapshots, as returned by Compare().
+type Diff struct {
+	// The creation times of the compared snapshots.
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+
+	// The changes, sorted by CRN.
+	Changes []*Change `json:"changes"`
+}
+
+// Change : A resource that was added, removed or modified between two snapshots.
+type Change struct {
+	// The type of the change (one of the ChangeType constants).
+	Type string `json:"type"`
+
+	// The CRN of the resource.
+	CRN string `json:"crn"`
+
+	// The type of the resource (one of the ResourceType constants).
+	ResourceType string `json:"resource_type"`
+
+	// The name of the resource (in the newer snapshot, unless the resource was removed).
+	Name string `json:"name,omitempty"`
+
+	// The resource in the older snapshot, or nil if it was added.
+	Before *Resource `json:"before,omitempty"`
+
+	// The resource in the newer snapshot, or nil if it was removed.
+	After *Resource `json:"after,omitempty"`
+
+	// The fields that were modified, sorted by path.
+	Fields []*FieldChange `json:"fields,omitempty"`
+}
+
+// FieldChange : A field of a resource that was modified between two snapshots.
+type FieldChange struct {
+	// The path of the field: "tags", or the dot-separated path of a property of the resource
+	// (e.g. "last_operation.state").
+	Path string `json:"path"`
+
+	// The value of the field in each snapshot, or nil if the field was not present.
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// Compare returns the resources that were added, removed or modified between the "before" and
+// "after" snapshots. Resources are matched by CRN.
+func Compare(before *Snapshot, after *Snapshot) (*Diff, error) {
+	if before == nil || after == nil {
+		return nil, fmt.Errorf("two snapshots are required")
+	}
+	if before.AccountID != "" && after.AccountID != "" && before.AccountID != after.AccountID {
+		return nil, fmt.Errorf("the snapshots belong to different accounts ('%s' and '%s')", before.AccountID, after.AccountID)
+	}
+
+	diff := &Diff{From: before.CreatedAt, To: after.CreatedAt, Changes: []*Change{}}
+	beforeResources := indexResources(before)
+	afterResources := indexResources(after)
+	for crn, old := range beforeResources {
+		current, found := afterResources[crn]
+		if !found {
+			diff.Changes = append(diff.Changes, &Change{
+				Type: ChangeTypeRemoved, CRN: crn, ResourceType: old.Type, Name: old.Name, Before: old,
+			})
+			continue
+		}
+		if fields := compareResources(old, current); len(fields) > 0 {
+			diff.Changes = append(diff.Changes, &Change{
+				Type: ChangeTypeModified, CRN: crn, ResourceType: current.Type, Name: current.Name,
+				Before: old, After: current, Fields: fields,
+			})
+		}
+	}
+	for crn, current := range afterResources {
+		if _, found := beforeResources[crn]; !found {
+			diff.Changes = append(diff.Changes, &Change{
+				Type: ChangeTypeAdded, CRN: crn, ResourceType: current.Type, Name: current.Name, After: current,
+			})
+		}
+	}
+	sort.Slice(diff.Changes, func(i, j int) bool {
+		return diff.Changes[i].CRN < diff.Changes[j].CRN
+	})
+	return diff, nil
+}
+
+// GetChanges returns the changes of the specified type (one of the ChangeType constants).
+func (diff *Diff) GetChanges(changeType string) []*Change {
+	var changes []*Change
+	for _, change := range diff.Changes {
+		if change.Type == changeType {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// IsEmpty returns true if the snapshots contain the same resources.
+func (diff *Diff) IsEmpty() bool {
+	return len(diff.Changes) == 0
+}
+
+// String returns a human-readable description of the changes, with one line per resource.
+func (diff *Diff) String() string {
+	var b strings.Builder
+	symbols := map[string]string{ChangeTypeAdded: "+", ChangeTypeRemoved: "-", ChangeTypeModified: "~"}
+	for _, change := range diff.Changes {
+		fmt.Fprintf(&b, "%s %s '%s' (%s)", symbols[change.Type], change.ResourceType, change.Name, change.CRN)
+		if len(change.Fields) > 0 {
+			paths := make([]string, len(change.Fields))
+			for i, field := range change.Fields {
+				paths[i] = field.Path
+			}
+			fmt.Fprintf(&b, ": %s", strings.Join(paths, ", "))
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%d added, %d removed, %d modified.", len(diff.GetChanges(ChangeTypeAdded)),
+		len(diff.GetChanges(ChangeTypeRemoved)), len(diff.GetChanges(ChangeTypeModified)))
+	return b.String()
+}
+
+func indexResources(snapshot *Snapshot) map[string]*Resource {
+	resources := make(map[string]*Resource, len(snapshot.Resources))
+	for _, resource := range snapshot.Resources {
+		resources[resource.CRN] = resource
+	}
+	return resources
+}
+
+// compareResources returns the fields that differ between two versions of a resource.
+func compareResources(before *Resource, after *Resource) []*FieldChange {
+	var fields []*FieldChange
+	if !reflect.DeepEqual(normalizeTags(before.Tags), normalizeTags(after.Tags)) {
+		fields = append(fields, &FieldChange{Path: "tags", Before: before.Tags, After: after.Tags})
+	}
+	compareProperties("", before.Properties, after.Properties, &fields)
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+	return fields
+}
+
+// compareProperties appends the differences between two JSON objects to "fields". Nested
+// objects are compared property by property; other values (including arrays) are compared whole.
+func compareProperties(prefix string, before map[string]interface{}, after map[string]interface{}, fields *[]*FieldChange) {
+	names := make(map[string]bool)
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+	for name := range names {
+		oldValue, newValue := before[name], after[name]
+		oldObject, oldIsObject := oldValue.(map[string]interface{})
+		newObject, newIsObject := newValue.(map[string]interface{})
+		if oldIsObject && newIsObject {
+			compareProperties(prefix+name+".", oldObject, newObject, fields)
+			continue
+		}
+		if !reflect.DeepEqual(oldValue, newValue) {
+			*fields = append(*fields, &FieldChange{Path: prefix + name, Before: oldValue, After: newValue})
+		}
+	}
+}
+
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	return tags
+}
@@ -0,0 +1,135 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory_test
+
+import (
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/inventory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	f := newFixture(t)
+	kept := f.createInstance(t, "kept")
+	renamed := f.createInstance(t, "renamed")
+	removed := f.createInstance(t, "removed")
+	before := f.export(t)
+
+	updateOptions := f.resourceController.NewUpdateResourceInstanceOptions(*renamed.GUID).SetName("new-name")
+	_, _, err := f.resourceController.UpdateResourceInstance(updateOptions)
+	require.Nil(t, err)
+	_, err = f.resourceController.DeleteResourceInstance(f.resourceController.NewDeleteResourceInstanceOptions(*removed.GUID))
+	require.Nil(t, err)
+	added := f.createInstance(t, "added")
+	f.attachTag(t, *kept.CRN, "env:prod")
+	after := f.export(t)
+
+	diff, err := inventory.Compare(before, after)
+	require.Nil(t, err)
+	assert.False(t, diff.IsEmpty())
+	require.Len(t, diff.GetChanges(inventory.ChangeTypeAdded), 1)
+	require.Len(t, diff.GetChanges(inventory.ChangeTypeRemoved), 1)
+	require.Len(t, diff.GetChanges(inventory.ChangeTypeModified), 2)
+
+	change := diff.GetChanges(inventory.ChangeTypeAdded)[0]
+	assert.Equal(t, *added.CRN, change.CRN)
+	assert.Equal(t, "added", change.Name)
+	assert.Nil(t, change.Before)
+	assert.NotNil(t, change.After)
+
+	change = diff.GetChanges(inventory.ChangeTypeRemoved)[0]
+	assert.Equal(t, *removed.CRN, change.CRN)
+	assert.Equal(t, inventory.ResourceTypeResourceInstance, change.ResourceType)
+	assert.Nil(t, change.After)
+
+	paths := func(change *inventory.Change) []string {
+		var paths []string
+		for _, field := range change.Fields {
+			paths = append(paths, field.Path)
+		}
+		return paths
+	}
+	for _, change := range diff.GetChanges(inventory.ChangeTypeModified) {
+		switch change.CRN {
+		case *renamed.CRN:
+			assert.Equal(t, "new-name", change.Name)
+			assert.Contains(t, paths(change), "name")
+			for _, field := range change.Fields {
+				if field.Path == "name" {
+					assert.Equal(t, "renamed", field.Before)
+					assert.Equal(t, "new-name", field.After)
+				}
+			}
+		case *kept.CRN:
+			assert.Equal(t, []string{"tags"}, paths(change))
+			assert.Equal(t, []string{"env:prod"}, change.Fields[0].After)
+		default:
+			t.Errorf("unexpected change of '%s'", change.CRN)
+		}
+	}
+
+	output := diff.String()
+	assert.Contains(t, output, "+ resource_instance 'added' ("+*added.CRN+")\n")
+	assert.Contains(t, output, "- resource_instance 'removed' ("+*removed.CRN+")\n")
+	assert.Contains(t, output, "~ resource_instance 'kept' ("+*kept.CRN+"): tags\n")
+	assert.Contains(t, output, "1 added, 1 removed, 2 modified.")
+
+	diff, err = inventory.Compare(after, after)
+	require.Nil(t, err)
+	assert.True(t, diff.IsEmpty())
+	assert.Equal(t, "0 added, 0 removed, 0 modified.", diff.String())
+}
+
+func TestCompareNestedProperties(t *testing.T) {
+	crn := "crn:v1:bluemix:public:cloud-object-storage:global:a/account:instance::"
+	before := &inventory.Snapshot{Version: 1, Resources: []*inventory.Resource{{
+		CRN:  crn,
+		Type: inventory.ResourceTypeResourceInstance,
+		Properties: map[string]interface{}{
+			"last_operation": map[string]interface{}{"state": "in progress", "type": "create"},
+			"extensions":     []interface{}{"a"},
+		},
+	}}}
+	after := &inventory.Snapshot{Version: 1, Resources: []*inventory.Resource{{
+		CRN:  crn,
+		Type: inventory.ResourceTypeResourceInstance,
+		Properties: map[string]interface{}{
+			"last_operation": map[string]interface{}{"state": "succeeded", "type": "create"},
+			"extensions":     []interface{}{"a", "b"},
+			"locked":         true,
+		},
+	}}}
+
+	diff, err := inventory.Compare(before, after)
+	require.Nil(t, err)
+	require.Len(t, diff.Changes, 1)
+	fields := diff.Changes[0].Fields
+	require.Len(t, fields, 3)
+	assert.Equal(t, "extensions", fields[0].Path)
+	assert.Equal(t, "last_operation.state", fields[1].Path)
+	assert.Equal(t, "in progress", fields[1].Before)
+	assert.Equal(t, "succeeded", fields[1].After)
+	assert.Equal(t, "locked", fields[2].Path)
+	assert.Nil(t, fields[2].Before)
+
+	_, err = inventory.Compare(before, nil)
+	assert.NotNil(t, err)
+	_, err = inventory.Compare(&inventory.Snapshot{AccountID: "a"}, &inventory.Snapshot{AccountID: "b"})
+	assert.NotNil(t, err)
+}
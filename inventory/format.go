@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// WriteJSON writes the snapshot to "w" as a single, indented JSON document.
+func (snapshot *Snapshot) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(snapshot); err != nil {
+		return fmt.Errorf("error writing the snapshot: %w", err)
+	}
+	return nil
+}
+
+// WriteNDJSON writes the snapshot to "w" as newline-delimited JSON: the first line contains
+// the version, account ID and creation time of the snapshot, and each following line
+// contains a resource. Large snapshots can be processed one line at a time.
+func (snapshot *Snapshot) WriteNDJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	header := *snapshot
+	header.Resources = nil
+	if err := encoder.Encode(&header); err != nil {
+		return fmt.Errorf("error writing the snapshot: %w", err)
+	}
+	for _, resource := range snapshot.Resources {
+		if err := encoder.Encode(resource); err != nil {
+			return fmt.Errorf("error writing the snapshot: %w", err)
+		}
+	}
+	return nil
+}
+
+// ReadSnapshot reads a snapshot written by Snapshot.WriteJSON() or Snapshot.WriteNDJSON().
+// An error is returned if the snapshot was written in a newer version of the format.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	decoder := json.NewDecoder(r)
+	snapshot := &Snapshot{}
+	if err := decoder.Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("the snapshot is not valid: %w", err)
+	}
+	if snapshot.Version < 1 || snapshot.Version > SnapshotVersion {
+		return nil, fmt.Errorf("the snapshot version %d is not supported (supported versions: 1 to %d)", snapshot.Version, SnapshotVersion)
+	}
+	for {
+		resource := &Resource{}
+		err := decoder.Decode(resource)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("the snapshot is not valid: %w", err)
+		}
+		snapshot.Resources = append(snapshot.Resources, resource)
+	}
+	for i, resource := range snapshot.Resources {
+		if resource.CRN == "" {
+			return nil, fmt.Errorf("the snapshot is not valid: resource %d has no CRN", i)
+		}
+	}
+	sort.SliceStable(snapshot.Resources, func(i, j int) bool {
+		return snapshot.Resources[i].CRN < snapshot.Resources[j].CRN
+	})
+	return snapshot, nil
+}
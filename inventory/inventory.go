@@ -0,0 +1,391 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package inventory exports the resources of an account into a versioned snapshot, and
+// reports the resources that were added, removed or modified between two snapshots.
+//
+// A snapshot contains the resource instances, resource keys, resource aliases and resource
+// bindings known to the Resource Controller, the resource groups known to the Resource Manager
+// and the user tags attached to each of them:
+//
+//	exporter, err := inventory.NewExporter(&inventory.ExporterOptions{
+//		ResourceController: resourceController,
+//		ResourceManager:    resourceManager,
+//		GlobalTagging:      globalTagging,
+//		AccountID:          accountID,
+//	})
+//	...
+//	snapshot, err := exporter.Export(ctx)
+//	...
+//	err = snapshot.WriteNDJSON(file)
+//
+// Snapshots written by WriteJSON() or WriteNDJSON() are read back with ReadSnapshot(), and two
+// snapshots are compared with Compare():
+//
+//	diff, err := inventory.Compare(yesterday, today)
+//	...
+//	fmt.Println(diff.String())
+//
+// The credentials of resource keys and bindings are stored with their secret values redacted.
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+)
+
+// SnapshotVersion is the version of the snapshot format written by this package.
+const SnapshotVersion = 1
+
+// DefaultConcurrency is the default value of ExporterOptions.Concurrency.
+const DefaultConcurrency = 4
+
+// The types of the resources in a snapshot.
+const (
+	ResourceTypeResourceInstance = "resource_instance"
+	ResourceTypeResourceKey      = "resource_key"
+	ResourceTypeResourceAlias    = "resource_alias"
+	ResourceTypeResourceBinding  = "resource_binding"
+	ResourceTypeResourceGroup    = "resource_group"
+)
+
+// Snapshot : The resources of an account at a point in time.
+type Snapshot struct {
+	// The version of the snapshot format (SnapshotVersion).
+	Version int `json:"version"`
+
+	// The account that the resources belong to, if known.
+	AccountID string `json:"account_id,omitempty"`
+
+	// The time at which the snapshot was taken.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The resources, sorted by CRN.
+	Resources []*Resource `json:"resources,omitempty"`
+}
+
+// Resource : A resource in a snapshot.
+type Resource struct {
+	// The CRN of the resource, which identifies it across snapshots.
+	CRN string `json:"crn"`
+
+	// The type of the resource (one of the ResourceType constants).
+	Type string `json:"type"`
+
+	// The GUID or ID of the resource.
+	ID string `json:"id,omitempty"`
+
+	// The name of the resource.
+	Name string `json:"name,omitempty"`
+
+	// The state of the resource (e.g. "active").
+	State string `json:"state,omitempty"`
+
+	// The ID of the resource group that contains the resource.
+	ResourceGroupID string `json:"resource_group_id,omitempty"`
+
+	// The CRN or ID of the resource instance that a key, alias or binding belongs to.
+	Parent string `json:"parent,omitempty"`
+
+	// The user tags attached to the resource, sorted by name.
+	Tags []string `json:"tags,omitempty"`
+
+	// The JSON representation of the resource, as returned by the service.
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+// GetResource returns the resource with the specified CRN, or nil if the snapshot doesn't contain it.
+func (snapshot *Snapshot) GetResource(crn string) *Resource {
+	index := sort.Search(len(snapshot.Resources), func(i int) bool {
+		return snapshot.Resources[i].CRN >= crn
+	})
+	if index < len(snapshot.Resources) && snapshot.Resources[index].CRN == crn {
+		return snapshot.Resources[index]
+	}
+	return nil
+}
+
+// ExporterOptions : The options used to create an Exporter with NewExporter().
+// Each resource type is exported only if the client of the service that manages it is set.
+type ExporterOptions struct {
+	// The client used to export resource instances, keys, aliases and bindings.
+	ResourceController *resourcecontrollerv2.ResourceControllerV2
+
+	// The client used to export resource groups.
+	ResourceManager *resourcemanagerv2.ResourceManagerV2
+
+	// The client used to retrieve the user tags attached to each resource.
+	GlobalTagging *globaltaggingv1.GlobalTaggingV1
+
+	// The account whose resource groups are exported, and that is recorded in the snapshot.
+	AccountID string
+
+	// The maximum number of tag lookups performed concurrently (defaults to DefaultConcurrency).
+	Concurrency int
+
+	// The function used to obtain the creation time of a snapshot (defaults to time.Now).
+	Now func() time.Time
+}
+
+// Exporter : Exports the resources of an account into a Snapshot.
+type Exporter struct {
+	options ExporterOptions
+}
+
+// NewExporter returns a new Exporter. At least one of ResourceController and ResourceManager
+// must be set in "options".
+func NewExporter(options *ExporterOptions) (*Exporter, error) {
+	if options == nil || (options.ResourceController == nil && options.ResourceManager == nil) {
+		return nil, fmt.Errorf("a ResourceController or ResourceManager client is required")
+	}
+	exporter := &Exporter{options: *options}
+	if exporter.options.Concurrency <= 0 {
+		exporter.options.Concurrency = DefaultConcurrency
+	}
+	if exporter.options.Now == nil {
+		exporter.options.Now = time.Now
+	}
+	return exporter, nil
+}
+
+// Export retrieves the resources of the account and returns them as a snapshot.
+func (exporter *Exporter) Export(ctx context.Context) (*Snapshot, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	snapshot := &Snapshot{
+		Version:   SnapshotVersion,
+		AccountID: exporter.options.AccountID,
+		CreatedAt: exporter.options.Now().UTC(),
+		Resources: []*Resource{},
+	}
+
+	add := func(resourceType string, parentProperty string, model interface{}) error {
+		resource, err := newResource(resourceType, parentProperty, model)
+		if err != nil {
+			return err
+		}
+		if resource.CRN != "" {
+			snapshot.Resources = append(snapshot.Resources, resource)
+		}
+		return nil
+	}
+	if exporter.options.ResourceController != nil {
+		if err := exporter.exportResourceController(ctx, add); err != nil {
+			return nil, err
+		}
+	}
+	if exporter.options.ResourceManager != nil {
+		if err := exporter.exportResourceGroups(ctx, add); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(snapshot.Resources, func(i, j int) bool {
+		return snapshot.Resources[i].CRN < snapshot.Resources[j].CRN
+	})
+	if exporter.options.GlobalTagging != nil {
+		if err := exporter.exportTags(ctx, snapshot.Resources); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+type addFunc func(resourceType string, parentProperty string, model interface{}) error
+
+func (exporter *Exporter) exportResourceController(ctx context.Context, add addFunc) error {
+	resourceController := exporter.options.ResourceController
+
+	// Instances are listed in every state other than removed, so that the keys, aliases and
+	// bindings of inactive, failed or pending reclamation instances have a parent.
+	instances, err := resourceController.ListNonRemovedResourceInstances(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error listing resource instances: %w", err)
+	}
+	for i := range instances {
+		if err = add(ResourceTypeResourceInstance, "", &instances[i]); err != nil {
+			return err
+		}
+	}
+
+	keysPager, err := resourceController.NewResourceKeysPager(&resourcecontrollerv2.ListResourceKeysOptions{})
+	if err != nil {
+		return err
+	}
+	keys, err := keysPager.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing resource keys: %w", err)
+	}
+	for i := range keys {
+		if err = add(ResourceTypeResourceKey, "source_crn", &keys[i]); err != nil {
+			return err
+		}
+	}
+
+	aliasesPager, err := resourceController.NewResourceAliasesPager(&resourcecontrollerv2.ListResourceAliasesOptions{})
+	if err != nil {
+		return err
+	}
+	aliases, err := aliasesPager.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing resource aliases: %w", err)
+	}
+	for i := range aliases {
+		if err = add(ResourceTypeResourceAlias, "resource_instance_id", &aliases[i]); err != nil {
+			return err
+		}
+	}
+
+	bindingsPager, err := resourceController.NewResourceBindingsPager(&resourcecontrollerv2.ListResourceBindingsOptions{})
+	if err != nil {
+		return err
+	}
+	bindings, err := bindingsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing resource bindings: %w", err)
+	}
+	for i := range bindings {
+		if err = add(ResourceTypeResourceBinding, "source_crn", &bindings[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (exporter *Exporter) exportResourceGroups(ctx context.Context, add addFunc) error {
+	listOptions := &resourcemanagerv2.ListResourceGroupsOptions{}
+	if exporter.options.AccountID != "" {
+		listOptions.AccountID = core.StringPtr(exporter.options.AccountID)
+	}
+	groups, _, err := exporter.options.ResourceManager.ListResourceGroupsWithContext(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("error listing resource groups: %w", err)
+	}
+	for i := range groups.Resources {
+		if err = add(ResourceTypeResourceGroup, "", &groups.Resources[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// exportTags retrieves the user tags attached to each resource, using up to
+// ExporterOptions.Concurrency concurrent requests.
+func (exporter *Exporter) exportTags(ctx context.Context, resources []*Resource) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	work := make(chan *Resource)
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var firstErr error
+	for n := 0; n < exporter.options.Concurrency; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for resource := range work {
+				tags, err := exporter.getTags(ctx, resource.CRN)
+				mutex.Lock()
+				if err != nil && firstErr == nil {
+					firstErr = fmt.Errorf("error listing the tags of '%s': %w", resource.CRN, err)
+					cancel()
+				}
+				resource.Tags = tags
+				mutex.Unlock()
+			}
+		}()
+	}
+	for _, resource := range resources {
+		if ctx.Err() != nil {
+			break
+		}
+		work <- resource
+	}
+	close(work)
+	wg.Wait()
+	return firstErr
+}
+
+func (exporter *Exporter) getTags(ctx context.Context, crn string) ([]string, error) {
+	pager, err := exporter.options.GlobalTagging.NewTagsPager(&globaltaggingv1.ListTagsOptions{
+		AttachedTo: core.StringPtr(crn),
+		TagType:    core.StringPtr(globaltaggingv1.ListTagsOptionsTagTypeUserConst),
+	})
+	if err != nil {
+		return nil, err
+	}
+	tags, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, tag := range tags {
+		names = append(names, *tag.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// newResource returns the snapshot entry for "model". "parentProperty" is the name of the
+// property of the model that identifies its parent, if any.
+func newResource(resourceType string, parentProperty string, model interface{}) (*Resource, error) {
+	buffer, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing a %s: %w", resourceType, err)
+	}
+	resource := &Resource{Type: resourceType}
+	if err = json.Unmarshal(buffer, &resource.Properties); err != nil {
+		return nil, fmt.Errorf("error serializing a %s: %w", resourceType, err)
+	}
+	// The secret values of credentials are not stored.
+	var credentials *resourcecontrollerv2.Credentials
+	switch m := model.(type) {
+	case *resourcecontrollerv2.ResourceKey:
+		credentials = m.Credentials
+	case *resourcecontrollerv2.ResourceBinding:
+		credentials = m.Credentials
+	}
+	if credentials != nil {
+		resource.Properties["credentials"] = credentials.Redact()
+	}
+
+	getString := func(name string) string {
+		value, _ := resource.Properties[name].(string)
+		return value
+	}
+	resource.CRN = getString("crn")
+	resource.ID = getString("guid")
+	if resource.ID == "" {
+		resource.ID = getString("id")
+	}
+	resource.Name = getString("name")
+	resource.State = getString("state")
+	resource.ResourceGroupID = getString("resource_group_id")
+	if parentProperty != "" {
+		resource.Parent = getString(parentProperty)
+	}
+	return resource, nil
+}
@@ -0,0 +1,243 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/platform-services-go-sdk/inventory"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var snapshotTime = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+type fixture struct {
+	server             *platformfake.Server
+	resourceController *resourcecontrollerv2.ResourceControllerV2
+	resourceManager    *resourcemanagerv2.ResourceManagerV2
+	globalTagging      *globaltaggingv1.GlobalTaggingV1
+}
+
+func newFixture(t *testing.T) *fixture {
+	return newFixtureWithOptions(t, nil)
+}
+
+func newFixtureWithOptions(t *testing.T, options *platformfake.ServerOptions) *fixture {
+	server := platformfake.NewServer(options)
+	t.Cleanup(server.Close)
+
+	f := &fixture{server: server}
+	var err error
+	f.resourceController, err = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	f.resourceManager, err = resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	f.globalTagging, err = globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return f
+}
+
+func (f *fixture) export(t *testing.T) *inventory.Snapshot {
+	exporter, err := inventory.NewExporter(&inventory.ExporterOptions{
+		ResourceController: f.resourceController,
+		ResourceManager:    f.resourceManager,
+		GlobalTagging:      f.globalTagging,
+		AccountID:          f.server.AccountID(),
+		Now:                func() time.Time { return snapshotTime },
+	})
+	require.Nil(t, err)
+	snapshot, err := exporter.Export(context.Background())
+	require.Nil(t, err)
+	return snapshot
+}
+
+func (f *fixture) createInstance(t *testing.T, name string) *resourcecontrollerv2.ResourceInstance {
+	createOptions := f.resourceController.NewCreateResourceInstanceOptions(name, "us-south", f.server.DefaultResourceGroupID(), "lite-plan")
+	instance, _, err := f.resourceController.CreateResourceInstance(createOptions)
+	require.Nil(t, err)
+	return instance
+}
+
+func (f *fixture) attachTag(t *testing.T, crn string, tag string) {
+	attachOptions := f.globalTagging.NewAttachTagOptions([]globaltaggingv1.Resource{{ResourceID: core.StringPtr(crn)}})
+	attachOptions.SetTagNames([]string{tag})
+	_, _, err := f.globalTagging.AttachTag(attachOptions)
+	require.Nil(t, err)
+}
+
+func TestExport(t *testing.T) {
+	f := newFixture(t)
+	instance := f.createInstance(t, "db")
+	key, _, err := f.resourceController.CreateResourceKey(f.resourceController.NewCreateResourceKeyOptions("db-key", *instance.GUID))
+	require.Nil(t, err)
+	alias, _, err := f.resourceController.CreateResourceAlias(f.resourceController.NewCreateResourceAliasOptions("db-alias", *instance.GUID,
+		"crn:v1:bluemix:public:cf:eu-gb:o/org::cf-space:space"))
+	require.Nil(t, err)
+	f.attachTag(t, *instance.CRN, "env:prod")
+	f.attachTag(t, *instance.CRN, "app:shop")
+
+	snapshot := f.export(t)
+	assert.Equal(t, inventory.SnapshotVersion, snapshot.Version)
+	assert.Equal(t, f.server.AccountID(), snapshot.AccountID)
+	assert.Equal(t, snapshotTime, snapshot.CreatedAt)
+	require.Len(t, snapshot.Resources, 4)
+	for i := 1; i < len(snapshot.Resources); i++ {
+		assert.Less(t, snapshot.Resources[i-1].CRN, snapshot.Resources[i].CRN)
+	}
+
+	resource := snapshot.GetResource(*instance.CRN)
+	require.NotNil(t, resource)
+	assert.Equal(t, inventory.ResourceTypeResourceInstance, resource.Type)
+	assert.Equal(t, *instance.GUID, resource.ID)
+	assert.Equal(t, "db", resource.Name)
+	assert.Equal(t, "active", resource.State)
+	assert.Equal(t, f.server.DefaultResourceGroupID(), resource.ResourceGroupID)
+	assert.Equal(t, []string{"app:shop", "env:prod"}, resource.Tags)
+	assert.Equal(t, "lite-plan", resource.Properties["resource_plan_id"])
+
+	resource = snapshot.GetResource(*key.CRN)
+	require.NotNil(t, resource)
+	assert.Equal(t, inventory.ResourceTypeResourceKey, resource.Type)
+	assert.Equal(t, *instance.CRN, resource.Parent)
+	assert.Empty(t, resource.Tags)
+	credentials := resource.Properties["credentials"].(map[string]interface{})
+	assert.Equal(t, resourcecontrollerv2.RedactedCredentialValue, credentials["apikey"])
+
+	resource = snapshot.GetResource(*alias.CRN)
+	require.NotNil(t, resource)
+	assert.Equal(t, inventory.ResourceTypeResourceAlias, resource.Type)
+	assert.Equal(t, *instance.GUID, resource.Parent)
+
+	var groups int
+	for _, resource := range snapshot.Resources {
+		if resource.Type == inventory.ResourceTypeResourceGroup {
+			groups++
+			assert.Equal(t, platformfake.DefaultResourceGroupName, resource.Name)
+			assert.Equal(t, f.server.DefaultResourceGroupID(), resource.ID)
+		}
+	}
+	assert.Equal(t, 1, groups)
+	assert.Nil(t, snapshot.GetResource("crn:v1:unknown"))
+}
+
+func TestExportNonActiveInstances(t *testing.T) {
+	f := newFixtureWithOptions(t, &platformfake.ServerOptions{ReclamationEnabled: true, ProvisioningPolls: 1})
+	failed := f.createInstance(t, "failed")
+	require.True(t, f.server.FailResourceInstanceOperation(*failed.GUID, "The broker failed"))
+	deleted := f.createInstance(t, "deleted")
+	_, _, err := f.resourceController.GetResourceInstance(f.resourceController.NewGetResourceInstanceOptions(*deleted.GUID))
+	require.Nil(t, err)
+	_, err = f.resourceController.DeleteResourceInstance(f.resourceController.NewDeleteResourceInstanceOptions(*deleted.GUID))
+	require.Nil(t, err)
+
+	snapshot := f.export(t)
+	resource := snapshot.GetResource(*failed.CRN)
+	require.NotNil(t, resource)
+	assert.Equal(t, "failed", resource.State)
+	resource = snapshot.GetResource(*deleted.CRN)
+	require.NotNil(t, resource)
+	assert.Equal(t, "pending_reclamation", resource.State)
+}
+
+func TestExportErrors(t *testing.T) {
+	_, err := inventory.NewExporter(nil)
+	assert.NotNil(t, err)
+	_, err = inventory.NewExporter(&inventory.ExporterOptions{})
+	assert.NotNil(t, err)
+
+	f := newFixture(t)
+	instance := f.createInstance(t, "db")
+	f.server.Fail(http.MethodGet, "/v3/tags", http.StatusForbidden, 1)
+	exporter, err := inventory.NewExporter(&inventory.ExporterOptions{
+		ResourceController: f.resourceController,
+		GlobalTagging:      f.globalTagging,
+		Concurrency:        1,
+	})
+	require.Nil(t, err)
+	_, err = exporter.Export(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), *instance.CRN)
+	assert.Equal(t, http.StatusForbidden, common.GetErrorStatusCode(err))
+
+	f.server.Fail(http.MethodGet, "/v2/resource_groups", http.StatusInternalServerError, 1)
+	exporter, err = inventory.NewExporter(&inventory.ExporterOptions{ResourceManager: f.resourceManager})
+	require.Nil(t, err)
+	_, err = exporter.Export(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing resource groups")
+}
+
+func TestSnapshotFormats(t *testing.T) {
+	f := newFixture(t)
+	instance := f.createInstance(t, "db")
+	f.attachTag(t, *instance.CRN, "env:prod")
+	snapshot := f.export(t)
+
+	var buffer bytes.Buffer
+	require.Nil(t, snapshot.WriteJSON(&buffer))
+	assert.True(t, strings.HasPrefix(buffer.String(), "{\n  \"version\": 1,"))
+	read, err := inventory.ReadSnapshot(&buffer)
+	require.Nil(t, err)
+	assert.Equal(t, snapshot, read)
+
+	buffer.Reset()
+	require.Nil(t, snapshot.WriteNDJSON(&buffer))
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Len(t, lines, 1+len(snapshot.Resources))
+	assert.Equal(t, `{"version":1,"account_id":"fake-account-id","created_at":"2024-05-01T12:00:00Z"}`, lines[0])
+	read, err = inventory.ReadSnapshot(&buffer)
+	require.Nil(t, err)
+	assert.Equal(t, snapshot, read)
+
+	// An empty snapshot can be read back in either format.
+	empty := &inventory.Snapshot{Version: inventory.SnapshotVersion, CreatedAt: snapshotTime}
+	buffer.Reset()
+	require.Nil(t, empty.WriteNDJSON(&buffer))
+	read, err = inventory.ReadSnapshot(&buffer)
+	require.Nil(t, err)
+	assert.Empty(t, read.Resources)
+
+	_, err = inventory.ReadSnapshot(strings.NewReader(`{"version": 2, "created_at": "2024-05-01T12:00:00Z"}`))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "version 2 is not supported")
+	_, err = inventory.ReadSnapshot(strings.NewReader(`{"version": 1}` + "\n" + `{"type": "resource_group"}`))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "resource 0 has no CRN")
+	_, err = inventory.ReadSnapshot(strings.NewReader(`not json`))
+	assert.NotNil(t, err)
+}
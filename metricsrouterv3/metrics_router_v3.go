@@ -247,7 +247,7 @@ func (metricsRouter *MetricsRouterV3) CreateTargetWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "CreateTarget", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -299,7 +299,7 @@ func (metricsRouter *MetricsRouterV3) ListTargetsWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "ListTargets", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -359,7 +359,7 @@ func (metricsRouter *MetricsRouterV3) GetTargetWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "GetTarget", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -432,7 +432,7 @@ func (metricsRouter *MetricsRouterV3) UpdateTargetWithContext(ctx context.Contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "UpdateTarget", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -490,7 +490,7 @@ func (metricsRouter *MetricsRouterV3) DeleteTargetWithContext(ctx context.Contex
 		return
 	}
 
-	response, err = metricsRouter.Service.Request(request, nil)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "DeleteTarget", request, nil)
 
 	return
 }
@@ -549,7 +549,7 @@ func (metricsRouter *MetricsRouterV3) CreateRouteWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "CreateRoute", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -601,7 +601,7 @@ func (metricsRouter *MetricsRouterV3) ListRoutesWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "ListRoutes", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -661,7 +661,7 @@ func (metricsRouter *MetricsRouterV3) GetRouteWithContext(ctx context.Context, g
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "GetRoute", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -734,7 +734,7 @@ func (metricsRouter *MetricsRouterV3) UpdateRouteWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "UpdateRoute", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -792,7 +792,7 @@ func (metricsRouter *MetricsRouterV3) DeleteRouteWithContext(ctx context.Context
 		return
 	}
 
-	response, err = metricsRouter.Service.Request(request, nil)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "DeleteRoute", request, nil)
 
 	return
 }
@@ -834,7 +834,7 @@ func (metricsRouter *MetricsRouterV3) GetSettingsWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "GetSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -913,7 +913,7 @@ func (metricsRouter *MetricsRouterV3) UpdateSettingsWithContext(ctx context.Cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = metricsRouter.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(metricsRouter.Service, "metrics_router", "V3", "UpdateSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
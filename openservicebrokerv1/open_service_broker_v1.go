@@ -210,7 +210,7 @@ func (openServiceBroker *OpenServiceBrokerV1) GetServiceInstanceStateWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "GetServiceInstanceState", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -292,7 +292,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceStateWithCon
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "ReplaceServiceInstanceState", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -389,7 +389,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceInstanceWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "ReplaceServiceInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -478,7 +478,7 @@ func (openServiceBroker *OpenServiceBrokerV1) UpdateServiceInstanceWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "UpdateServiceInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -544,7 +544,7 @@ func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceInstanceWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "DeleteServiceInstance", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -601,7 +601,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ListCatalogWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "ListCatalog", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -677,7 +677,7 @@ func (openServiceBroker *OpenServiceBrokerV1) GetLastOperationWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "GetLastOperation", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -763,7 +763,7 @@ func (openServiceBroker *OpenServiceBrokerV1) ReplaceServiceBindingWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = openServiceBroker.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "ReplaceServiceBinding", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -830,7 +830,7 @@ func (openServiceBroker *OpenServiceBrokerV1) DeleteServiceBindingWithContext(ct
 		return
 	}
 
-	response, err = openServiceBroker.Service.Request(request, nil)
+	response, err = common.InvokeRequest(openServiceBroker.Service, "open_service_broker", "V1", "DeleteServiceBinding", request, nil)
 
 	return
 }
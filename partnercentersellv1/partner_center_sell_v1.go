@@ -240,7 +240,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateRegistrationWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -309,7 +309,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetRegistrationWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -385,7 +385,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateRegistrationWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -452,7 +452,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteRegistrationWithContext(ctx
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteRegistration", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -539,7 +539,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateOnboardingProductWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateOnboardingProduct", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_onboarding_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -608,7 +608,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetOnboardingProductWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetOnboardingProduct", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_onboarding_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -684,7 +684,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateOnboardingProductWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateOnboardingProduct", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_onboarding_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -751,7 +751,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteOnboardingProductWithContext
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteOnboardingProduct", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_onboarding_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -858,7 +858,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogProductWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateCatalogProduct", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -932,7 +932,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogProductWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetCatalogProduct", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1013,7 +1013,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogProductWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateCatalogProduct", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1085,7 +1085,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogProductWithContext(ct
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteCatalogProduct", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_product", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1189,7 +1189,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogPlanWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateCatalogPlan", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1264,7 +1264,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogPlanWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetCatalogPlan", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1346,7 +1346,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogPlanWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateCatalogPlan", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1419,7 +1419,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogPlanWithContext(ctx c
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteCatalogPlan", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_plan", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1525,7 +1525,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateCatalogDeploymentWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateCatalogDeployment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_catalog_deployment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1601,7 +1601,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetCatalogDeploymentWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetCatalogDeployment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_catalog_deployment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1684,7 +1684,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateCatalogDeploymentWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateCatalogDeployment", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_catalog_deployment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1758,7 +1758,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteCatalogDeploymentWithContext
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteCatalogDeployment", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_catalog_deployment", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1870,7 +1870,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateIamRegistrationWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateIamRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_iam_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1951,7 +1951,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateIamRegistrationWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateIamRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_iam_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2023,7 +2023,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteIamRegistrationWithContext(c
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteIamRegistration", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_iam_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2089,7 +2089,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetIamRegistrationWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetIamRegistration", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_iam_registration", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2199,7 +2199,7 @@ func (partnerCenterSell *PartnerCenterSellV1) CreateResourceBrokerWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "CreateResourceBroker", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_broker", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2279,7 +2279,7 @@ func (partnerCenterSell *PartnerCenterSellV1) UpdateResourceBrokerWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "UpdateResourceBroker", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_broker", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2352,7 +2352,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetResourceBrokerWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetResourceBroker", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_broker", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2426,7 +2426,7 @@ func (partnerCenterSell *PartnerCenterSellV1) DeleteResourceBrokerWithContext(ct
 		return
 	}
 
-	response, err = partnerCenterSell.Service.Request(request, nil)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "DeleteResourceBroker", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_broker", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2485,7 +2485,7 @@ func (partnerCenterSell *PartnerCenterSellV1) ListProductBadgesWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "ListProductBadges", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_product_badges", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2554,7 +2554,7 @@ func (partnerCenterSell *PartnerCenterSellV1) GetProductBadgeWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerCenterSell.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerCenterSell.Service, "partner_center_sell", "V1", "GetProductBadge", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_product_badge", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -244,7 +244,7 @@ func (partnerManagement *PartnerManagementV1) GetResourceUsageReportWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerManagement.Service, "partner_management", "V1", "GetResourceUsageReport", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_usage_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -323,7 +323,7 @@ func (partnerManagement *PartnerManagementV1) GetBillingOptionsWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerManagement.Service, "partner_management", "V1", "GetBillingOptions", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_billing_options", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -402,7 +402,7 @@ func (partnerManagement *PartnerManagementV1) GetCreditPoolsReportWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = partnerManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(partnerManagement.Service, "partner_management", "V1", "GetCreditPoolsReport", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_credit_pools_report", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1717,3 +1717,30 @@ func (pager *GetResourceUsageReportPager) GetAll() (allItems []PartnerUsageRepor
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetResourceUsageReportPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetResourceUsageReportPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetResourceUsageReportPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetResourceUsageReportPager returns a new GetResourceUsageReportPager instance positioned at the checkpoint
+// returned by GetResourceUsageReportPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (partnerManagement *PartnerManagementV1) ResumeGetResourceUsageReportPager(options *GetResourceUsageReportOptions, checkpoint string) (pager *GetResourceUsageReportPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetResourceUsageReportPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = partnerManagement.NewGetResourceUsageReportPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
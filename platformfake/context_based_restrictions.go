@@ -0,0 +1,426 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+)
+
+// The maximum number of addresses included in the preview of a zone summary.
+const zoneAddressesPreviewSize = 5
+
+// The rule context attribute that references a network zone.
+const networkZoneIDAttribute = "networkZoneId"
+
+type zoneRecord struct {
+	zone    contextbasedrestrictionsv1.Zone
+	version int
+}
+
+func (record *zoneRecord) etag() string {
+	return etag(*record.zone.ID, record.version)
+}
+
+// summary returns the ZoneSummary of the zone, as returned by the ListZones operation.
+func (record *zoneRecord) summary() contextbasedrestrictionsv1.ZoneSummary {
+	zone := &record.zone
+	preview := zone.Addresses
+	if len(preview) > zoneAddressesPreviewSize {
+		preview = preview[:zoneAddressesPreviewSize]
+	}
+	return contextbasedrestrictionsv1.ZoneSummary{
+		ID:               zone.ID,
+		CRN:              zone.CRN,
+		Name:             zone.Name,
+		Description:      zone.Description,
+		AddressesPreview: preview,
+		AddressCount:     zone.AddressCount,
+		ExcludedCount:    zone.ExcludedCount,
+		Href:             zone.Href,
+		CreatedAt:        zone.CreatedAt,
+		CreatedByID:      zone.CreatedByID,
+		LastModifiedAt:   zone.LastModifiedAt,
+		LastModifiedByID: zone.LastModifiedByID,
+	}
+}
+
+type ruleRecord struct {
+	rule    contextbasedrestrictionsv1.Rule
+	version int
+}
+
+func (record *ruleRecord) etag() string {
+	return etag(*record.rule.ID, record.version)
+}
+
+// resourceAttribute returns the value of the named attribute of the first resource of the rule, or "".
+func (record *ruleRecord) resourceAttribute(name string) string {
+	for _, resource := range record.rule.Resources {
+		for _, attribute := range resource.Attributes {
+			if *attribute.Name == name {
+				return *attribute.Value
+			}
+		}
+	}
+	return ""
+}
+
+// zoneIDs returns the IDs of the network zones referenced by the contexts of the rule.
+func zoneIDs(rule *contextbasedrestrictionsv1.Rule) (ids []string) {
+	for _, context := range rule.Contexts {
+		for _, attribute := range context.Attributes {
+			if *attribute.Name == networkZoneIDAttribute {
+				ids = append(ids, strings.Split(*attribute.Value, ",")...)
+			}
+		}
+	}
+	return
+}
+
+func (server *Server) addContextBasedRestrictionsRoutes() {
+	style := errorStyleContextBasedRestrictions
+	r := server.router
+	r.handle(http.MethodPost, "/v1/zones", style, server.createZone)
+	r.handle(http.MethodGet, "/v1/zones", style, server.listZones)
+	r.handle(http.MethodGet, "/v1/zones/{zone_id}", style, server.getZone)
+	r.handle(http.MethodPut, "/v1/zones/{zone_id}", style, server.replaceZone)
+	r.handle(http.MethodDelete, "/v1/zones/{zone_id}", style, server.deleteZone)
+	r.handle(http.MethodPost, "/v1/rules", style, server.createRule)
+	r.handle(http.MethodGet, "/v1/rules", style, server.listRules)
+	r.handle(http.MethodGet, "/v1/rules/{rule_id}", style, server.getRule)
+	r.handle(http.MethodPut, "/v1/rules/{rule_id}", style, server.replaceRule)
+	r.handle(http.MethodDelete, "/v1/rules/{rule_id}", style, server.deleteRule)
+}
+
+//
+// Zones
+//
+
+func (server *Server) findZone(id string) *zoneRecord {
+	for _, record := range server.zones {
+		if *record.zone.ID == id {
+			return record
+		}
+	}
+	return nil
+}
+
+func (c *call) lookupZone() *zoneRecord {
+	id := c.pathParam("zone_id")
+	record := c.server.findZone(id)
+	if record == nil {
+		c.notFound("zone", id)
+	}
+	return record
+}
+
+// decodeZone unmarshals and validates the zone in the request body.
+// "existing" is the zone being replaced, or nil when a zone is created.
+func (c *call) decodeZone(existing *zoneRecord) (zone *contextbasedrestrictionsv1.Zone, ok bool) {
+	if !c.decodeModel(&zone, contextbasedrestrictionsv1.UnmarshalZone) {
+		return
+	}
+	if zone.Name == nil || *zone.Name == "" {
+		c.badRequest("The 'name' property is required.")
+		return
+	}
+	if zone.AccountID == nil || *zone.AccountID == "" {
+		c.badRequest("The 'account_id' property is required.")
+		return
+	}
+	for _, record := range c.server.zones {
+		if record != existing && *record.zone.AccountID == *zone.AccountID && *record.zone.Name == *zone.Name {
+			c.conflict(fmt.Sprintf("A zone named '%s' already exists.", *zone.Name))
+			return
+		}
+	}
+	if zone.Description == nil {
+		zone.Description = core.StringPtr("")
+	}
+	if zone.Addresses == nil {
+		zone.Addresses = []contextbasedrestrictionsv1.AddressIntf{}
+	}
+	if zone.Excluded == nil {
+		zone.Excluded = []contextbasedrestrictionsv1.AddressIntf{}
+	}
+	zone.AddressCount = core.Int64Ptr(int64(len(zone.Addresses)))
+	zone.ExcludedCount = core.Int64Ptr(int64(len(zone.Excluded)))
+	zone.LastModifiedAt = c.server.timestamp()
+	zone.LastModifiedByID = core.StringPtr(fakeUserID)
+	ok = true
+	return
+}
+
+func (server *Server) createZone(c *call) {
+	zone, ok := c.decodeZone(nil)
+	if !ok {
+		return
+	}
+
+	id := strings.ReplaceAll(server.nextID(), "-", "")
+	zone.ID = core.StringPtr(id)
+	zone.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:context-based-restrictions::a/%s::zone:%s", *zone.AccountID, id))
+	zone.Href = core.StringPtr(c.href("/v1/zones/" + id))
+	zone.CreatedAt = zone.LastModifiedAt
+	zone.CreatedByID = core.StringPtr(fakeUserID)
+
+	record := &zoneRecord{
+		zone:    *zone,
+		version: 1,
+	}
+	server.zones = append(server.zones, record)
+	c.writeJSONWithETag(http.StatusCreated, record.etag(), record.zone)
+}
+
+func (server *Server) listZones(c *call) {
+	accountID := c.query("account_id")
+	if accountID == "" {
+		c.badRequest("The 'account_id' query parameter is required.")
+		return
+	}
+	name := c.query("name")
+
+	result := &contextbasedrestrictionsv1.ZoneList{
+		Zones: []contextbasedrestrictionsv1.ZoneSummary{},
+	}
+	for _, record := range server.zones {
+		if *record.zone.AccountID == accountID && (name == "" || *record.zone.Name == name) {
+			result.Zones = append(result.Zones, record.summary())
+		}
+	}
+	result.Count = core.Int64Ptr(int64(len(result.Zones)))
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) getZone(c *call) {
+	record := c.lookupZone()
+	if record == nil {
+		return
+	}
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.zone)
+}
+
+func (server *Server) replaceZone(c *call) {
+	record := c.lookupZone()
+	if record == nil {
+		return
+	}
+	zone, ok := c.decodeZone(record)
+	if !ok || !c.checkIfMatch(record.etag()) {
+		return
+	}
+
+	existing := record.zone
+	zone.ID = existing.ID
+	zone.CRN = existing.CRN
+	zone.Href = existing.Href
+	zone.CreatedAt = existing.CreatedAt
+	zone.CreatedByID = existing.CreatedByID
+
+	record.zone = *zone
+	record.version++
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.zone)
+}
+
+func (server *Server) deleteZone(c *call) {
+	record := c.lookupZone()
+	if record == nil {
+		return
+	}
+	for _, rule := range server.rules {
+		for _, id := range zoneIDs(&rule.rule) {
+			if id == *record.zone.ID {
+				c.conflict(fmt.Sprintf("The zone '%s' is referenced by the rule '%s'.", id, *rule.rule.ID))
+				return
+			}
+		}
+	}
+	for i, existing := range server.zones {
+		if existing == record {
+			server.zones = append(server.zones[:i], server.zones[i+1:]...)
+			break
+		}
+	}
+	c.writeNoContent()
+}
+
+//
+// Rules
+//
+
+func (c *call) lookupRule() *ruleRecord {
+	id := c.pathParam("rule_id")
+	for _, record := range c.server.rules {
+		if *record.rule.ID == id {
+			return record
+		}
+	}
+	c.notFound("rule", id)
+	return nil
+}
+
+// decodeRule unmarshals and validates the rule in the request body.
+func (c *call) decodeRule() (rule *contextbasedrestrictionsv1.Rule, ok bool) {
+	if !c.decodeModel(&rule, contextbasedrestrictionsv1.UnmarshalRule) {
+		return
+	}
+	if len(rule.Resources) == 0 {
+		c.badRequest("The 'resources' property must contain at least one resource.")
+		return
+	}
+	if (&ruleRecord{rule: *rule}).resourceAttribute("accountId") == "" {
+		c.badRequest("The rule resource must have an 'accountId' attribute.")
+		return
+	}
+	for _, id := range zoneIDs(rule) {
+		if c.server.findZone(id) == nil {
+			c.badRequest(fmt.Sprintf("The zone '%s' referenced by the rule was not found.", id))
+			return
+		}
+	}
+	if rule.Description == nil {
+		rule.Description = core.StringPtr("")
+	}
+	if rule.Contexts == nil {
+		rule.Contexts = []contextbasedrestrictionsv1.RuleContext{}
+	}
+	if rule.EnforcementMode == nil {
+		rule.EnforcementMode = core.StringPtr(contextbasedrestrictionsv1.CreateRuleOptionsEnforcementModeEnabledConst)
+	}
+	rule.LastModifiedAt = c.server.timestamp()
+	rule.LastModifiedByID = core.StringPtr(fakeUserID)
+	ok = true
+	return
+}
+
+func (server *Server) createRule(c *call) {
+	rule, ok := c.decodeRule()
+	if !ok {
+		return
+	}
+
+	id := strings.ReplaceAll(server.nextID(), "-", "")
+	accountID := (&ruleRecord{rule: *rule}).resourceAttribute("accountId")
+	rule.ID = core.StringPtr(id)
+	rule.CRN = core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:context-based-restrictions::a/%s::rule:%s", accountID, id))
+	rule.Href = core.StringPtr(c.href("/v1/rules/" + id))
+	rule.CreatedAt = rule.LastModifiedAt
+	rule.CreatedByID = core.StringPtr(fakeUserID)
+
+	record := &ruleRecord{
+		rule:    *rule,
+		version: 1,
+	}
+	server.rules = append(server.rules, record)
+	c.writeJSONWithETag(http.StatusCreated, record.etag(), record.rule)
+}
+
+func (server *Server) listRules(c *call) {
+	accountID := c.query("account_id")
+	if accountID == "" {
+		c.badRequest("The 'account_id' query parameter is required.")
+		return
+	}
+	zoneID := c.query("zone_id")
+	enforcementMode := c.query("enforcement_mode")
+	resourceFilters := map[string]string{
+		"serviceName":     c.query("service_name"),
+		"serviceInstance": c.query("service_instance"),
+		"serviceType":     c.query("service_type"),
+		"region":          c.query("region"),
+		"resourceType":    c.query("resource_type"),
+	}
+
+	result := &contextbasedrestrictionsv1.RuleList{
+		Rules: []contextbasedrestrictionsv1.Rule{},
+	}
+	for _, record := range server.rules {
+		if record.resourceAttribute("accountId") != accountID {
+			continue
+		}
+		if enforcementMode != "" && *record.rule.EnforcementMode != enforcementMode {
+			continue
+		}
+		matched := true
+		for name, want := range resourceFilters {
+			if want != "" && record.resourceAttribute(name) != want {
+				matched = false
+			}
+		}
+		if zoneID != "" {
+			referenced := false
+			for _, id := range zoneIDs(&record.rule) {
+				referenced = referenced || id == zoneID
+			}
+			matched = matched && referenced
+		}
+		if matched {
+			result.Rules = append(result.Rules, record.rule)
+		}
+	}
+	result.Count = core.Int64Ptr(int64(len(result.Rules)))
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) getRule(c *call) {
+	record := c.lookupRule()
+	if record == nil {
+		return
+	}
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.rule)
+}
+
+func (server *Server) replaceRule(c *call) {
+	record := c.lookupRule()
+	if record == nil {
+		return
+	}
+	rule, ok := c.decodeRule()
+	if !ok || !c.checkIfMatch(record.etag()) {
+		return
+	}
+
+	existing := record.rule
+	rule.ID = existing.ID
+	rule.CRN = existing.CRN
+	rule.Href = existing.Href
+	rule.CreatedAt = existing.CreatedAt
+	rule.CreatedByID = existing.CreatedByID
+
+	record.rule = *rule
+	record.version++
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.rule)
+}
+
+func (server *Server) deleteRule(c *call) {
+	record := c.lookupRule()
+	if record == nil {
+		return
+	}
+	for i, existing := range server.rules {
+		if existing == record {
+			server.rules = append(server.rules[:i], server.rules[i+1:]...)
+			break
+		}
+	}
+	c.writeNoContent()
+}
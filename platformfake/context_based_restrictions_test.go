@@ -0,0 +1,100 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZonesAndRules(t *testing.T) {
+	server := newServer(t, nil)
+	cbr, err := contextbasedrestrictionsv1.NewContextBasedRestrictionsV1(&contextbasedrestrictionsv1.ContextBasedRestrictionsV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	accountID := server.AccountID()
+
+	addresses := []contextbasedrestrictionsv1.AddressIntf{
+		&contextbasedrestrictionsv1.AddressIPAddress{Type: core.StringPtr("ipAddress"), Value: core.StringPtr("169.23.56.234")},
+		&contextbasedrestrictionsv1.AddressSubnet{Type: core.StringPtr("subnet"), Value: core.StringPtr("10.0.0.0/24")},
+	}
+	zone, response, err := cbr.CreateZone(cbr.NewCreateZoneOptions().SetName("office").SetAccountID(accountID).SetAddresses(addresses))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, int64(2), *zone.AddressCount)
+	etag := response.Headers.Get("ETag")
+
+	zones, _, err := cbr.ListZones(cbr.NewListZonesOptions(accountID))
+	require.Nil(t, err)
+	require.Equal(t, int64(1), *zones.Count)
+	assert.Len(t, zones.Zones[0].AddressesPreview, 2)
+
+	replaceOptions := cbr.NewReplaceZoneOptions(*zone.ID, etag).SetName("office").SetAccountID(accountID).SetAddresses(addresses[:1])
+	replaced, _, err := cbr.ReplaceZone(replaceOptions)
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *replaced.AddressCount)
+	_, response, err = cbr.ReplaceZone(replaceOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+	ruleOptions := cbr.NewCreateRuleOptions()
+	ruleOptions.SetContexts([]contextbasedrestrictionsv1.RuleContext{
+		{Attributes: []contextbasedrestrictionsv1.RuleContextAttribute{
+			{Name: core.StringPtr("networkZoneId"), Value: zone.ID},
+		}},
+	})
+	ruleOptions.SetResources([]contextbasedrestrictionsv1.Resource{
+		{Attributes: []contextbasedrestrictionsv1.ResourceAttribute{
+			{Name: core.StringPtr("accountId"), Value: core.StringPtr(accountID)},
+			{Name: core.StringPtr("serviceName"), Value: core.StringPtr("kms")},
+		}},
+	})
+	rule, response, err := cbr.CreateRule(ruleOptions)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, "enabled", *rule.EnforcementMode)
+
+	rules, _, err := cbr.ListRules(cbr.NewListRulesOptions(accountID).SetZoneID(*zone.ID).SetServiceName("kms"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *rules.Count)
+	rules, _, err = cbr.ListRules(cbr.NewListRulesOptions(accountID).SetServiceName("iam-groups"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(0), *rules.Count)
+
+	// A zone that is referenced by a rule cannot be deleted.
+	response, err = cbr.DeleteZone(cbr.NewDeleteZoneOptions(*zone.ID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	response, err = cbr.DeleteRule(cbr.NewDeleteRuleOptions(*rule.ID))
+	require.Nil(t, err)
+	response, err = cbr.DeleteZone(cbr.NewDeleteZoneOptions(*zone.ID))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	// Rules cannot reference zones that do not exist.
+	_, response, err = cbr.CreateRule(ruleOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
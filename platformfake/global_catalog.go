@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+)
+
+// Catalog entry kinds.
+const (
+	catalogKindService = "service"
+	catalogKindPlan    = "plan"
+	catalogKindAll     = "*"
+)
+
+// The default page size of the catalog list operations.
+const catalogDefaultLimit = 200
+
+func (server *Server) addGlobalCatalogRoutes() {
+	style := errorStyleIAM
+	r := server.router
+	r.handle(http.MethodGet, "/{id}", style, server.getCatalogEntry)
+	r.handle(http.MethodGet, "/{id}/{kind}", style, server.getChildObjects)
+}
+
+// catalogService returns the service with the catalog ID "resourceID", which is either registered
+// with RegisterService() or derived from the registered plans.
+func (server *Server) catalogService(resourceID string) (service Service, found bool) {
+	if service, found = server.services[resourceID]; found {
+		if service.Name == "" {
+			service.Name = resourceID
+		}
+		return
+	}
+	for _, plan := range server.plans {
+		if plan.ResourceID == resourceID {
+			return Service{Name: plan.ServiceName}, true
+		}
+	}
+	return
+}
+
+// newServiceEntry returns the catalog entry of a service.
+func newServiceEntry(resourceID string, service Service) globalcatalogv1.CatalogEntry {
+	return globalcatalogv1.CatalogEntry{
+		ID:       core.StringPtr(resourceID),
+		Name:     core.StringPtr(service.Name),
+		Kind:     core.StringPtr(catalogKindService),
+		Active:   core.BoolPtr(true),
+		Disabled: core.BoolPtr(false),
+		Tags:     []string{},
+		OverviewUI: map[string]globalcatalogv1.Overview{
+			"en": {
+				DisplayName:     core.StringPtr(service.Name),
+				Description:     core.StringPtr(service.Name),
+				LongDescription: core.StringPtr(service.Name),
+			},
+		},
+		Metadata: &globalcatalogv1.CatalogEntryMetadata{
+			RcCompatible: core.BoolPtr(true),
+			Service: &globalcatalogv1.CfMetaData{
+				PlanUpdateable: core.BoolPtr(!service.FixedPlan),
+			},
+		},
+	}
+}
+
+// newPlanEntry returns the catalog entry of a plan.
+func newPlanEntry(planID string, plan Plan) globalcatalogv1.CatalogEntry {
+	name := plan.Name
+	if name == "" {
+		name = planID
+	}
+	geoTags := plan.Locations
+	if geoTags == nil {
+		geoTags = []string{}
+	}
+	return globalcatalogv1.CatalogEntry{
+		ID:       core.StringPtr(planID),
+		Name:     core.StringPtr(name),
+		Kind:     core.StringPtr(catalogKindPlan),
+		ParentID: core.StringPtr(plan.ResourceID),
+		Active:   core.BoolPtr(true),
+		Disabled: core.BoolPtr(plan.Disabled),
+		Tags:     []string{},
+		GeoTags:  geoTags,
+		OverviewUI: map[string]globalcatalogv1.Overview{
+			"en": {
+				DisplayName:     core.StringPtr(name),
+				Description:     core.StringPtr(name),
+				LongDescription: core.StringPtr(name),
+			},
+		},
+		Metadata: &globalcatalogv1.CatalogEntryMetadata{
+			RcCompatible: core.BoolPtr(true),
+			Plan: &globalcatalogv1.PlanMetaData{
+				Bindable: core.BoolPtr(true),
+			},
+		},
+	}
+}
+
+func (server *Server) getCatalogEntry(c *call) {
+	id := c.pathParam("id")
+	if plan, found := server.plans[id]; found {
+		c.writeJSON(http.StatusOK, newPlanEntry(id, plan))
+		return
+	}
+	if service, found := server.catalogService(id); found {
+		c.writeJSON(http.StatusOK, newServiceEntry(id, service))
+		return
+	}
+	c.notFound("catalog entry", id)
+}
+
+func (server *Server) getChildObjects(c *call) {
+	id := c.pathParam("id")
+	kind := c.pathParam("kind")
+	if _, found := server.catalogService(id); !found {
+		c.notFound("catalog entry", id)
+		return
+	}
+	limit, ok := c.queryInt64("_limit", catalogDefaultLimit)
+	if !ok {
+		return
+	}
+	offset, ok := c.queryInt64("_offset", 0)
+	if !ok {
+		return
+	}
+
+	var children []globalcatalogv1.CatalogEntry
+	if kind == catalogKindPlan || kind == catalogKindAll {
+		for planID, plan := range server.plans {
+			if plan.ResourceID == id {
+				children = append(children, newPlanEntry(planID, plan))
+			}
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return *children[i].Name < *children[j].Name
+	})
+
+	start, end := paginate(len(children), offset, limit)
+	c.writeJSON(http.StatusOK, &globalcatalogv1.EntrySearchResult{
+		Offset:        core.Int64Ptr(offset),
+		Limit:         core.Int64Ptr(limit),
+		Count:         core.Int64Ptr(int64(len(children))),
+		ResourceCount: core.Int64Ptr(int64(end - start)),
+		Resources:     append([]globalcatalogv1.CatalogEntry{}, children[start:end]...),
+	})
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalCatalog(t *testing.T) {
+	server := newServer(t, nil)
+	server.RegisterPlan("standard-plan", platformfake.Plan{ServiceName: "databases", ResourceID: "db-id", Name: "standard"})
+	server.RegisterPlan("lite-plan", platformfake.Plan{ServiceName: "databases", ResourceID: "db-id", Name: "lite", Locations: []string{"us-south"}})
+	server.RegisterPlan("old-plan", platformfake.Plan{ServiceName: "databases", ResourceID: "db-id", Name: "old", Disabled: true})
+	server.RegisterService("fixed-id", platformfake.Service{Name: "fixed", FixedPlan: true})
+
+	globalCatalog, err := globalcatalogv1.NewGlobalCatalogV1(&globalcatalogv1.GlobalCatalogV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	// Services of registered plans are known to the catalog and allow plan changes.
+	service, _, err := globalCatalog.GetCatalogEntry(globalCatalog.NewGetCatalogEntryOptions("db-id"))
+	require.Nil(t, err)
+	assert.Equal(t, "databases", *service.Name)
+	assert.Equal(t, "service", *service.Kind)
+	assert.True(t, *service.Metadata.Service.PlanUpdateable)
+
+	service, _, err = globalCatalog.GetCatalogEntry(globalCatalog.NewGetCatalogEntryOptions("fixed-id"))
+	require.Nil(t, err)
+	assert.False(t, *service.Metadata.Service.PlanUpdateable)
+
+	plan, _, err := globalCatalog.GetCatalogEntry(globalCatalog.NewGetCatalogEntryOptions("lite-plan"))
+	require.Nil(t, err)
+	assert.Equal(t, "plan", *plan.Kind)
+	assert.Equal(t, "db-id", *plan.ParentID)
+	assert.Equal(t, []string{"us-south"}, plan.GeoTags)
+
+	// The plans of a service are its children, sorted by name.
+	children, _, err := globalCatalog.GetChildObjects(globalCatalog.NewGetChildObjectsOptions("db-id", "plan"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), *children.Count)
+	require.Len(t, children.Resources, 3)
+	assert.Equal(t, "lite", *children.Resources[0].Name)
+	assert.True(t, *children.Resources[1].Disabled)
+	assert.Equal(t, "standard-plan", *children.Resources[2].ID)
+
+	page, _, err := globalCatalog.GetChildObjects(globalCatalog.NewGetChildObjectsOptions("db-id", "*").SetOffset(2).SetLimit(2))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *page.ResourceCount)
+
+	children, _, err = globalCatalog.GetChildObjects(globalCatalog.NewGetChildObjectsOptions("fixed-id", "plan"))
+	require.Nil(t, err)
+	assert.Empty(t, children.Resources)
+
+	_, response, err := globalCatalog.GetCatalogEntry(globalCatalog.NewGetCatalogEntryOptions("unknown"))
+	require.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+}
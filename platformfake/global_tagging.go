@@ -0,0 +1,355 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+)
+
+// Tag types.
+const (
+	tagTypeUser    = "user"
+	tagTypeService = "service"
+	tagTypeAccess  = "access"
+)
+
+// The default and maximum page sizes of the tag list operation.
+const (
+	tagsDefaultLimit = 100
+	tagsMaxLimit     = 1000
+)
+
+// tagRecord holds a tag and the IDs (CRNs) of the resources to which it is attached.
+type tagRecord struct {
+	name      string
+	resources []string
+}
+
+func (record *tagRecord) attachedTo(resourceID string) bool {
+	for _, attached := range record.resources {
+		if attached == resourceID {
+			return true
+		}
+	}
+	return false
+}
+
+func (server *Server) addGlobalTaggingRoutes() {
+	style := errorStyleIAM
+	r := server.router
+	r.handle(http.MethodGet, "/v3/tags", style, server.listTags)
+	r.handle(http.MethodPost, "/v3/tags", style, server.createTag)
+	r.handle(http.MethodDelete, "/v3/tags", style, server.deleteTagAll)
+	r.handle(http.MethodDelete, "/v3/tags/{tag_name}", style, server.deleteTag)
+	r.handle(http.MethodPost, "/v3/tags/attach", style, server.attachTags)
+	r.handle(http.MethodPost, "/v3/tags/detach", style, server.detachTags)
+}
+
+// findTag returns the tag of the specified type and name, or nil.
+func (server *Server) findTag(tagType string, name string) *tagRecord {
+	for _, record := range server.tags[tagType] {
+		if record.name == name {
+			return record
+		}
+	}
+	return nil
+}
+
+// attachTag attaches the named tag to the resource, creating the tag if necessary.
+func (server *Server) attachTag(tagType string, resourceID string, name string) {
+	record := server.findTag(tagType, name)
+	if record == nil {
+		record = &tagRecord{name: name}
+		server.tags[tagType] = append(server.tags[tagType], record)
+	}
+	if !record.attachedTo(resourceID) {
+		record.resources = append(record.resources, resourceID)
+	}
+}
+
+// detachTag detaches the named tag from the resource. The tag itself is retained.
+func (server *Server) detachTag(tagType string, resourceID string, name string) {
+	record := server.findTag(tagType, name)
+	if record == nil {
+		return
+	}
+	for i, attached := range record.resources {
+		if attached == resourceID {
+			record.resources = append(record.resources[:i], record.resources[i+1:]...)
+			return
+		}
+	}
+}
+
+// tagType returns the value of the "tag_type" query parameter, or "defaultType" if it is not present.
+// It writes an error response and returns false if the value is not a valid tag type.
+func (c *call) tagType(defaultType string) (string, bool) {
+	tagType := c.query("tag_type")
+	if tagType == "" {
+		return defaultType, true
+	}
+	if tagType != tagTypeUser && tagType != tagTypeService && tagType != tagTypeAccess {
+		c.badRequest(fmt.Sprintf("The tag type '%s' is not valid.", tagType))
+		return "", false
+	}
+	return tagType, true
+}
+
+func (server *Server) listTags(c *call) {
+	tagType, ok := c.tagType(tagTypeUser)
+	if !ok {
+		return
+	}
+	limit, ok := c.queryInt64("limit", tagsDefaultLimit)
+	if !ok {
+		return
+	}
+	if limit == 0 || limit > tagsMaxLimit {
+		c.badRequest(fmt.Sprintf("The value of the 'limit' query parameter must be between 1 and %d.", tagsMaxLimit))
+		return
+	}
+	offset, ok := c.queryInt64("offset", 0)
+	if !ok {
+		return
+	}
+	attachedTo := c.query("attached_to")
+	attachedOnly := c.query("attached_only") == "true"
+
+	var names []string
+	for _, record := range server.tags[tagType] {
+		if attachedTo != "" && !record.attachedTo(attachedTo) {
+			continue
+		}
+		if attachedOnly && len(record.resources) == 0 {
+			continue
+		}
+		names = append(names, record.name)
+	}
+	sort.Strings(names)
+	if c.query("order_by_name") == "desc" {
+		sort.Sort(sort.Reverse(sort.StringSlice(names)))
+	}
+
+	start, end := paginate(len(names), offset, limit)
+	result := &globaltaggingv1.TagList{
+		TotalCount: core.Int64Ptr(int64(len(names))),
+		Offset:     core.Int64Ptr(offset),
+		Limit:      core.Int64Ptr(limit),
+		Items:      []globaltaggingv1.Tag{},
+	}
+	for _, name := range names[start:end] {
+		result.Items = append(result.Items, globaltaggingv1.Tag{Name: core.StringPtr(name)})
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) createTag(c *call) {
+	tagType, ok := c.tagType(tagTypeAccess)
+	if !ok {
+		return
+	}
+	if tagType != tagTypeAccess {
+		c.badRequest("Only access tags can be created.")
+		return
+	}
+	var body struct {
+		TagNames []string `json:"tag_names"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if len(body.TagNames) == 0 {
+		c.badRequest("The 'tag_names' property must contain at least one tag name.")
+		return
+	}
+
+	result := &globaltaggingv1.CreateTagResults{}
+	for _, name := range body.TagNames {
+		if server.findTag(tagType, name) == nil {
+			server.tags[tagType] = append(server.tags[tagType], &tagRecord{name: name})
+		}
+		result.Results = append(result.Results, globaltaggingv1.CreateTagResultsResultsItem{
+			TagName: core.StringPtr(name),
+			IsError: core.BoolPtr(false),
+		})
+	}
+	c.writeJSON(http.StatusCreated, result)
+}
+
+func (server *Server) deleteTag(c *call) {
+	tagType, ok := c.tagType(tagTypeUser)
+	if !ok {
+		return
+	}
+	name := c.pathParam("tag_name")
+	record := server.findTag(tagType, name)
+	if record == nil {
+		c.notFound("tag", name)
+		return
+	}
+	if len(record.resources) > 0 {
+		c.badRequest(fmt.Sprintf("The tag '%s' is attached to %d resources and cannot be deleted.", name, len(record.resources)))
+		return
+	}
+	server.removeTag(tagType, record)
+	c.writeJSON(http.StatusOK, &globaltaggingv1.DeleteTagResults{
+		Results: []globaltaggingv1.DeleteTagResultsItem{
+			{
+				Provider: core.StringPtr("ghost"),
+				IsError:  core.BoolPtr(false),
+			},
+		},
+	})
+}
+
+func (server *Server) deleteTagAll(c *call) {
+	tagType, ok := c.tagType(tagTypeUser)
+	if !ok {
+		return
+	}
+
+	result := &globaltaggingv1.DeleteTagsResult{
+		Errors: core.BoolPtr(false),
+		Items:  []globaltaggingv1.DeleteTagsResultItem{},
+	}
+	for _, record := range append([]*tagRecord{}, server.tags[tagType]...) {
+		if len(record.resources) == 0 {
+			server.removeTag(tagType, record)
+			result.Items = append(result.Items, globaltaggingv1.DeleteTagsResultItem{
+				TagName: core.StringPtr(record.name),
+				IsError: core.BoolPtr(false),
+			})
+		}
+	}
+	result.TotalCount = core.Int64Ptr(int64(len(result.Items)))
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) removeTag(tagType string, record *tagRecord) {
+	records := server.tags[tagType]
+	for i, existing := range records {
+		if existing == record {
+			server.tags[tagType] = append(records[:i], records[i+1:]...)
+			return
+		}
+	}
+}
+
+// decodeTagRequest decodes the body of an attach or detach request and returns the tag names
+// and the IDs of the resources.
+func (c *call) decodeTagRequest() (tagNames []string, resourceIDs []string, ok bool) {
+	var body struct {
+		Resources []globaltaggingv1.Resource `json:"resources"`
+		TagName   *string                    `json:"tag_name"`
+		TagNames  []string                   `json:"tag_names"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	tagNames = body.TagNames
+	if body.TagName != nil {
+		tagNames = append(tagNames, *body.TagName)
+	}
+	if len(body.Resources) == 0 {
+		c.badRequest("The 'resources' property must contain at least one resource.")
+		return
+	}
+	for _, resource := range body.Resources {
+		if resource.ResourceID == nil || *resource.ResourceID == "" {
+			c.badRequest("Each resource must have a 'resource_id'.")
+			return
+		}
+		resourceIDs = append(resourceIDs, *resource.ResourceID)
+	}
+	ok = true
+	return
+}
+
+func (server *Server) attachTags(c *call) {
+	tagType, ok := c.tagType(tagTypeUser)
+	if !ok {
+		return
+	}
+	tagNames, resourceIDs, ok := c.decodeTagRequest()
+	if !ok {
+		return
+	}
+	if len(tagNames) == 0 && c.query("replace") != "true" {
+		c.badRequest("Either 'tag_name' or 'tag_names' must be specified.")
+		return
+	}
+
+	result := &globaltaggingv1.TagResults{}
+	for _, resourceID := range resourceIDs {
+		isError := false
+		if tagType == tagTypeAccess {
+			// Access tags must be created before they are attached.
+			for _, name := range tagNames {
+				if server.findTag(tagType, name) == nil {
+					isError = true
+				}
+			}
+		}
+		if !isError {
+			if c.query("replace") == "true" {
+				for _, record := range server.tags[tagType] {
+					server.detachTag(tagType, resourceID, record.name)
+				}
+			}
+			for _, name := range tagNames {
+				server.attachTag(tagType, resourceID, name)
+			}
+		}
+		result.Results = append(result.Results, globaltaggingv1.TagResultsItem{
+			ResourceID: core.StringPtr(resourceID),
+			IsError:    core.BoolPtr(isError),
+		})
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) detachTags(c *call) {
+	tagType, ok := c.tagType(tagTypeUser)
+	if !ok {
+		return
+	}
+	tagNames, resourceIDs, ok := c.decodeTagRequest()
+	if !ok {
+		return
+	}
+	if len(tagNames) == 0 {
+		c.badRequest("Either 'tag_name' or 'tag_names' must be specified.")
+		return
+	}
+
+	result := &globaltaggingv1.TagResults{}
+	for _, resourceID := range resourceIDs {
+		for _, name := range tagNames {
+			server.detachTag(tagType, resourceID, name)
+		}
+		result.Results = append(result.Results, globaltaggingv1.TagResultsItem{
+			ResourceID: core.StringPtr(resourceID),
+			IsError:    core.BoolPtr(false),
+		})
+	}
+	c.writeJSON(http.StatusOK, result)
+}
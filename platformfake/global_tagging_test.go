@@ -0,0 +1,86 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTags(t *testing.T) {
+	server := newServer(t, nil)
+	resourceController := newResourceController(t, server)
+	globalTagging, err := globaltaggingv1.NewGlobalTaggingV1(&globaltaggingv1.GlobalTaggingV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	// Tags specified when an instance is created are attached to the instance.
+	createOptions := resourceController.NewCreateResourceInstanceOptions("tagged", "us-south", server.DefaultResourceGroupID(), "lite-plan")
+	instance, _, err := resourceController.CreateResourceInstance(createOptions.SetTags([]string{"env:dev"}))
+	require.Nil(t, err)
+	resources := []globaltaggingv1.Resource{{ResourceID: instance.CRN}}
+
+	attachOptions := globalTagging.NewAttachTagOptions(resources).SetTagNames([]string{"team:a", "cost:1"})
+	results, _, err := globalTagging.AttachTag(attachOptions)
+	require.Nil(t, err)
+	assert.False(t, *results.Results[0].IsError)
+
+	tags, _, err := globalTagging.ListTags(globalTagging.NewListTagsOptions().SetAttachedTo(*instance.CRN))
+	require.Nil(t, err)
+	assert.Equal(t, int64(3), *tags.TotalCount)
+	assert.Equal(t, "cost:1", *tags.Items[0].Name)
+
+	pager, err := globalTagging.NewTagsPager(globalTagging.NewListTagsOptions().SetLimit(2))
+	require.Nil(t, err)
+	allTags, err := pager.GetAll()
+	require.Nil(t, err)
+	assert.Len(t, allTags, 3)
+
+	// An attached tag cannot be deleted.
+	_, response, err := globalTagging.DeleteTag(globalTagging.NewDeleteTagOptions("team:a"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	_, _, err = globalTagging.DetachTag(globalTagging.NewDetachTagOptions(resources).SetTagNames([]string{"team:a"}))
+	require.Nil(t, err)
+	tags, _, err = globalTagging.ListTags(globalTagging.NewListTagsOptions().SetAttachedOnly(true))
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), *tags.TotalCount)
+
+	deleted, _, err := globalTagging.DeleteTag(globalTagging.NewDeleteTagOptions("team:a"))
+	require.Nil(t, err)
+	assert.False(t, *deleted.Results[0].IsError)
+
+	// Access tags must be created before they are attached.
+	accessAttach := globalTagging.NewAttachTagOptions(resources).SetTagNames([]string{"project:x"}).SetTagType("access")
+	results, _, err = globalTagging.AttachTag(accessAttach)
+	require.Nil(t, err)
+	assert.True(t, *results.Results[0].IsError)
+	_, response, err = globalTagging.CreateTag(globalTagging.NewCreateTagOptions([]string{"project:x"}))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	results, _, err = globalTagging.AttachTag(accessAttach)
+	require.Nil(t, err)
+	assert.False(t, *results.Results[0].IsError)
+}
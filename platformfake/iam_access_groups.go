@@ -0,0 +1,486 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+)
+
+// The default and maximum page sizes of the access group list operations.
+const (
+	accessGroupsDefaultLimit = 100
+	accessGroupsMaxLimit     = 100
+)
+
+// The member types accepted by the access groups service.
+var accessGroupMemberTypes = map[string]bool{
+	"user":    true,
+	"service": true,
+	"profile": true,
+}
+
+type accessGroupRecord struct {
+	group   iamaccessgroupsv2.Group
+	version int
+	members []iamaccessgroupsv2.ListGroupMembersResponseMember
+}
+
+func (record *accessGroupRecord) etag() string {
+	return etag(*record.group.ID, record.version)
+}
+
+// memberIndex returns the index of the member with the specified IAM ID, or -1.
+func (record *accessGroupRecord) memberIndex(iamID string) int {
+	for i, member := range record.members {
+		if *member.IamID == iamID {
+			return i
+		}
+	}
+	return -1
+}
+
+func (server *Server) addAccessGroupsRoutes() {
+	style := errorStyleIAM
+	r := server.router
+	r.handle(http.MethodPost, "/v2/groups", style, server.createAccessGroup)
+	r.handle(http.MethodGet, "/v2/groups", style, server.listAccessGroups)
+	r.handle(http.MethodGet, "/v2/groups/{access_group_id}", style, server.getAccessGroup)
+	r.handle(http.MethodPatch, "/v2/groups/{access_group_id}", style, server.updateAccessGroup)
+	r.handle(http.MethodDelete, "/v2/groups/{access_group_id}", style, server.deleteAccessGroup)
+	r.handle(http.MethodHead, "/v2/groups/{access_group_id}/members/{iam_id}", style, server.isMemberOfAccessGroup)
+	r.handle(http.MethodPut, "/v2/groups/{access_group_id}/members", style, server.addMembersToAccessGroup)
+	r.handle(http.MethodGet, "/v2/groups/{access_group_id}/members", style, server.listAccessGroupMembers)
+	r.handle(http.MethodDelete, "/v2/groups/{access_group_id}/members/{iam_id}", style, server.removeMemberFromAccessGroup)
+	r.handle(http.MethodPost, "/v2/groups/{access_group_id}/members/delete", style, server.removeMembersFromAccessGroup)
+}
+
+// findAccessGroup returns the access group with the specified ID, or nil.
+func (server *Server) findAccessGroup(id string) *accessGroupRecord {
+	for _, record := range server.accessGroups {
+		if *record.group.ID == id {
+			return record
+		}
+	}
+	return nil
+}
+
+// AddDynamicAccessGroupMember makes the identity "iamID" of type "memberType" (e.g. "user" or
+// "profile") a member of the access group "accessGroupID", as if it matched a dynamic rule of
+// the group. Like the real service, the fake only lists dynamic members when the
+// membership_type query parameter is "dynamic" or "all".
+// It returns false if the access group does not exist or already has the member.
+func (server *Server) AddDynamicAccessGroupMember(accessGroupID string, iamID string, memberType string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	record := server.findAccessGroup(accessGroupID)
+	if record == nil || record.memberIndex(iamID) >= 0 {
+		return false
+	}
+	record.members = append(record.members, iamaccessgroupsv2.ListGroupMembersResponseMember{
+		IamID:          core.StringPtr(iamID),
+		Type:           core.StringPtr(memberType),
+		MembershipType: core.StringPtr("dynamic"),
+		CreatedAt:      server.timestamp(),
+		CreatedByID:    core.StringPtr(fakeUserID),
+	})
+	return true
+}
+
+// membershipTypeFilter returns a function that selects the members matching the membership_type
+// query parameter, which selects the static members by default. It returns false if the
+// parameter is not valid.
+func (c *call) membershipTypeFilter() (func(member *iamaccessgroupsv2.ListGroupMembersResponseMember) bool, bool) {
+	membershipType := c.query("membership_type")
+	switch membershipType {
+	case "":
+		membershipType = "static"
+	case "static", "dynamic", "all":
+	default:
+		c.badRequest("The 'membership_type' query parameter must be 'static', 'dynamic' or 'all'.")
+		return nil, false
+	}
+	return func(member *iamaccessgroupsv2.ListGroupMembersResponseMember) bool {
+		return membershipType == "all" || *member.MembershipType == membershipType
+	}, true
+}
+
+func (c *call) lookupAccessGroup() *accessGroupRecord {
+	id := c.pathParam("access_group_id")
+	record := c.server.findAccessGroup(id)
+	if record == nil {
+		c.notFound("access group", id)
+	}
+	return record
+}
+
+// offsetPageLinks returns the first, previous, next and last links of a page of "total"
+// items selected by "offset" and "limit", in the format used by the IAM services.
+func offsetPageLinks(c *call, total int, offset int64, limit int64) (first, previous, next, last *iamaccessgroupsv2.HrefStruct) {
+	link := func(offset int64) *iamaccessgroupsv2.HrefStruct {
+		query := c.req.URL.Query()
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		query.Set("offset", strconv.FormatInt(offset, 10))
+		return &iamaccessgroupsv2.HrefStruct{
+			Href: core.StringPtr(c.href(c.req.URL.Path + "?" + query.Encode())),
+		}
+	}
+
+	first = link(0)
+	if offset > 0 {
+		previousOffset := offset - limit
+		if previousOffset < 0 {
+			previousOffset = 0
+		}
+		previous = link(previousOffset)
+	}
+	if offset+limit < int64(total) {
+		next = link(offset + limit)
+	}
+	lastOffset := int64(0)
+	if total > 0 {
+		lastOffset = (int64(total) - 1) / limit * limit
+	}
+	last = link(lastOffset)
+	return
+}
+
+// pageLimits returns the "limit" and "offset" query parameters of a list request.
+func (c *call) pageLimits(defaultLimit int64, maxLimit int64) (limit int64, offset int64, ok bool) {
+	limit, ok = c.queryInt64("limit", defaultLimit)
+	if !ok {
+		return
+	}
+	if limit == 0 || limit > maxLimit {
+		c.badRequest(fmt.Sprintf("The value of the 'limit' query parameter must be between 1 and %d.", maxLimit))
+		return 0, 0, false
+	}
+	offset, ok = c.queryInt64("offset", 0)
+	return
+}
+
+func (server *Server) createAccessGroup(c *call) {
+	accountID := c.query("account_id")
+	if accountID == "" {
+		c.badRequest("The 'account_id' query parameter is required.")
+		return
+	}
+	var body struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		c.badRequest("The 'name' property is required.")
+		return
+	}
+	for _, existing := range server.accessGroups {
+		if *existing.group.AccountID == accountID && strings.EqualFold(*existing.group.Name, *body.Name) {
+			c.conflict(fmt.Sprintf("An access group named '%s' already exists.", *body.Name))
+			return
+		}
+	}
+
+	id := "AccessGroupId-" + server.nextID()
+	now := server.timestamp()
+	record := &accessGroupRecord{
+		group: iamaccessgroupsv2.Group{
+			ID:               core.StringPtr(id),
+			Name:             body.Name,
+			Description:      body.Description,
+			AccountID:        core.StringPtr(accountID),
+			CreatedAt:        now,
+			CreatedByID:      core.StringPtr(fakeUserID),
+			LastModifiedAt:   now,
+			LastModifiedByID: core.StringPtr(fakeUserID),
+			Href:             core.StringPtr(c.href("/v2/groups/" + id)),
+			IsFederated:      core.BoolPtr(false),
+		},
+		version: 1,
+	}
+	server.accessGroups = append(server.accessGroups, record)
+	c.writeJSONWithETag(http.StatusCreated, record.etag(), record.group)
+}
+
+func (server *Server) listAccessGroups(c *call) {
+	accountID := c.query("account_id")
+	if accountID == "" {
+		c.badRequest("The 'account_id' query parameter is required.")
+		return
+	}
+	limit, offset, ok := c.pageLimits(accessGroupsDefaultLimit, accessGroupsMaxLimit)
+	if !ok {
+		return
+	}
+	iamID := c.query("iam_id")
+	search := strings.ToLower(c.query("search"))
+	matchesMembershipType, ok := c.membershipTypeFilter()
+	if !ok {
+		return
+	}
+
+	var matches []iamaccessgroupsv2.Group
+	for _, record := range server.accessGroups {
+		group := record.group
+		if *group.AccountID != accountID {
+			continue
+		}
+		if iamID != "" {
+			index := record.memberIndex(iamID)
+			if index < 0 || !matchesMembershipType(&record.members[index]) {
+				continue
+			}
+		}
+		if search != "" && !strings.Contains(strings.ToLower(*group.Name), search) &&
+			(group.Description == nil || !strings.Contains(strings.ToLower(*group.Description), search)) {
+			continue
+		}
+		matches = append(matches, group)
+	}
+	if sortBy := c.query("sort"); strings.TrimPrefix(sortBy, "-") == "name" {
+		sort.SliceStable(matches, func(i, j int) bool {
+			if strings.HasPrefix(sortBy, "-") {
+				return *matches[i].Name > *matches[j].Name
+			}
+			return *matches[i].Name < *matches[j].Name
+		})
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	result := &iamaccessgroupsv2.GroupsList{
+		Limit:      core.Int64Ptr(limit),
+		Offset:     core.Int64Ptr(offset),
+		TotalCount: core.Int64Ptr(int64(len(matches))),
+		Groups:     append([]iamaccessgroupsv2.Group{}, matches[start:end]...),
+	}
+	result.First, result.Previous, result.Next, result.Last = offsetPageLinks(c, len(matches), offset, limit)
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) getAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.group)
+}
+
+func (server *Server) updateAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Name        *string `json:"name"`
+		Description *string `json:"description"`
+	}
+	if !c.decodeBody(&body) || !c.checkIfMatch(record.etag()) {
+		return
+	}
+	if body.Name != nil {
+		record.group.Name = body.Name
+	}
+	if body.Description != nil {
+		record.group.Description = body.Description
+	}
+	record.group.LastModifiedAt = server.timestamp()
+	record.group.LastModifiedByID = core.StringPtr(fakeUserID)
+	record.version++
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.group)
+}
+
+func (server *Server) deleteAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	if len(record.members) > 0 && c.query("force") != "true" {
+		c.conflict(fmt.Sprintf("The access group '%s' has members; use the 'force' option to delete it.", *record.group.ID))
+		return
+	}
+	for i, existing := range server.accessGroups {
+		if existing == record {
+			server.accessGroups = append(server.accessGroups[:i], server.accessGroups[i+1:]...)
+			break
+		}
+	}
+	c.writeNoContent()
+}
+
+func (server *Server) isMemberOfAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	iamID := c.pathParam("iam_id")
+	if record.memberIndex(iamID) < 0 {
+		c.notFound("access group member", iamID)
+		return
+	}
+	c.writeNoContent()
+}
+
+func (server *Server) addMembersToAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Members []iamaccessgroupsv2.AddGroupMembersRequestMembersItem `json:"members"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if len(body.Members) == 0 {
+		c.badRequest("The 'members' property must contain at least one member.")
+		return
+	}
+
+	result := &iamaccessgroupsv2.AddGroupMembersResponse{}
+	for _, member := range body.Members {
+		item := iamaccessgroupsv2.AddGroupMembersResponseMembersItem{
+			IamID: member.IamID,
+			Type:  member.Type,
+			Trace: core.StringPtr(server.transactionID(c.req)),
+		}
+		if member.IamID == nil || member.Type == nil || !accessGroupMemberTypes[*member.Type] {
+			item.StatusCode = core.Int64Ptr(http.StatusBadRequest)
+			item.Errors = []iamaccessgroupsv2.Error{
+				{
+					Code:    core.StringPtr("invalid_member"),
+					Message: core.StringPtr("Each member must have an 'iam_id' and a 'type' of 'user', 'service' or 'profile'."),
+				},
+			}
+			result.Members = append(result.Members, item)
+			continue
+		}
+
+		now := server.timestamp()
+		if record.memberIndex(*member.IamID) < 0 {
+			record.members = append(record.members, iamaccessgroupsv2.ListGroupMembersResponseMember{
+				IamID:          member.IamID,
+				Type:           member.Type,
+				MembershipType: core.StringPtr("static"),
+				Href:           core.StringPtr(c.href(fmt.Sprintf("/v2/groups/%s/members/%s", *record.group.ID, *member.IamID))),
+				CreatedAt:      now,
+				CreatedByID:    core.StringPtr(fakeUserID),
+			})
+		}
+		item.CreatedAt = now
+		item.CreatedByID = core.StringPtr(fakeUserID)
+		item.StatusCode = core.Int64Ptr(http.StatusOK)
+		result.Members = append(result.Members, item)
+	}
+	c.writeJSON(http.StatusMultiStatus, result)
+}
+
+func (server *Server) listAccessGroupMembers(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	limit, offset, ok := c.pageLimits(accessGroupsDefaultLimit, accessGroupsMaxLimit)
+	if !ok {
+		return
+	}
+	memberType := c.query("type")
+	matchesMembershipType, ok := c.membershipTypeFilter()
+	if !ok {
+		return
+	}
+
+	var matches []iamaccessgroupsv2.ListGroupMembersResponseMember
+	for _, member := range record.members {
+		if (memberType == "" || *member.Type == memberType) && matchesMembershipType(&member) {
+			matches = append(matches, member)
+		}
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	result := &iamaccessgroupsv2.GroupMembersList{
+		Limit:      core.Int64Ptr(limit),
+		Offset:     core.Int64Ptr(offset),
+		TotalCount: core.Int64Ptr(int64(len(matches))),
+		Members:    append([]iamaccessgroupsv2.ListGroupMembersResponseMember{}, matches[start:end]...),
+	}
+	result.First, result.Previous, result.Next, result.Last = offsetPageLinks(c, len(matches), offset, limit)
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) removeMemberFromAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	iamID := c.pathParam("iam_id")
+	index := record.memberIndex(iamID)
+	if index < 0 {
+		c.notFound("access group member", iamID)
+		return
+	}
+	record.members = append(record.members[:index], record.members[index+1:]...)
+	c.writeNoContent()
+}
+
+func (server *Server) removeMembersFromAccessGroup(c *call) {
+	record := c.lookupAccessGroup()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Members []string `json:"members"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if len(body.Members) == 0 {
+		c.badRequest("The 'members' property must contain at least one IAM ID.")
+		return
+	}
+
+	result := &iamaccessgroupsv2.DeleteGroupBulkMembersResponse{
+		AccessGroupID: record.group.ID,
+	}
+	for _, iamID := range body.Members {
+		item := iamaccessgroupsv2.DeleteGroupBulkMembersResponseMembersItem{
+			IamID: core.StringPtr(iamID),
+			Trace: core.StringPtr(server.transactionID(c.req)),
+		}
+		if index := record.memberIndex(iamID); index >= 0 {
+			record.members = append(record.members[:index], record.members[index+1:]...)
+			item.StatusCode = core.Int64Ptr(http.StatusNoContent)
+		} else {
+			item.StatusCode = core.Int64Ptr(http.StatusNotFound)
+			item.Errors = []iamaccessgroupsv2.Error{
+				{
+					Code:    core.StringPtr("not_found"),
+					Message: core.StringPtr(fmt.Sprintf("The access group member '%s' was not found.", iamID)),
+				},
+			}
+		}
+		result.Members = append(result.Members, item)
+	}
+	c.writeJSON(http.StatusMultiStatus, result)
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessGroups(t *testing.T) {
+	server := newServer(t, nil)
+	accessGroups, err := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	accountID := server.AccountID()
+
+	for i := 0; i < 3; i++ {
+		_, response, err := accessGroups.CreateAccessGroup(accessGroups.NewCreateAccessGroupOptions(accountID, fmt.Sprintf("group-%d", i)))
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, response.StatusCode)
+	}
+	_, response, err := accessGroups.CreateAccessGroup(accessGroups.NewCreateAccessGroupOptions(accountID, "group-0"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	pager, err := accessGroups.NewAccessGroupsPager(accessGroups.NewListAccessGroupsOptions(accountID).SetLimit(2))
+	require.Nil(t, err)
+	groups, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, groups, 3)
+	groupID := *groups[0].ID
+
+	// Updates require the current ETag.
+	group, response, err := accessGroups.GetAccessGroup(accessGroups.NewGetAccessGroupOptions(groupID))
+	require.Nil(t, err)
+	etag := response.Headers.Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	updateOptions := accessGroups.NewUpdateAccessGroupOptions(groupID, etag).SetDescription("updated")
+	updated, response, err := accessGroups.UpdateAccessGroup(updateOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "updated", *updated.Description)
+	assert.Equal(t, *group.Name, *updated.Name)
+	assert.NotEqual(t, etag, response.Headers.Get("ETag"))
+
+	_, response, err = accessGroups.UpdateAccessGroup(updateOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+	// Members.
+	addOptions := accessGroups.NewAddMembersToAccessGroupOptions(groupID)
+	addOptions.SetMembers([]iamaccessgroupsv2.AddGroupMembersRequestMembersItem{
+		{IamID: core.StringPtr("IBMid-user1"), Type: core.StringPtr("user")},
+		{IamID: core.StringPtr("iam-ServiceId-1"), Type: core.StringPtr("service")},
+		{IamID: core.StringPtr("bad"), Type: core.StringPtr("group")},
+	})
+	added, response, err := accessGroups.AddMembersToAccessGroup(addOptions)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusMultiStatus, response.StatusCode)
+	require.Len(t, added.Members, 3)
+	assert.Equal(t, int64(200), *added.Members[0].StatusCode)
+	assert.Equal(t, int64(400), *added.Members[2].StatusCode)
+	assert.NotEmpty(t, added.Members[2].Errors)
+
+	members, _, err := accessGroups.ListAccessGroupMembers(accessGroups.NewListAccessGroupMembersOptions(groupID).SetType("user"))
+	require.Nil(t, err)
+	require.Len(t, members.Members, 1)
+	assert.Equal(t, "IBMid-user1", *members.Members[0].IamID)
+
+	response, err = accessGroups.IsMemberOfAccessGroup(accessGroups.NewIsMemberOfAccessGroupOptions(groupID, "IBMid-user1"))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	memberGroups, _, err := accessGroups.ListAccessGroups(accessGroups.NewListAccessGroupsOptions(accountID).SetIamID("IBMid-user1"))
+	require.Nil(t, err)
+	assert.Equal(t, int64(1), *memberGroups.TotalCount)
+
+	// A group with members can only be deleted with the force option.
+	response, err = accessGroups.DeleteAccessGroup(accessGroups.NewDeleteAccessGroupOptions(groupID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	removeOptions := accessGroups.NewRemoveMembersFromAccessGroupOptions(groupID).SetMembers([]string{"IBMid-user1", "unknown"})
+	removed, _, err := accessGroups.RemoveMembersFromAccessGroup(removeOptions)
+	require.Nil(t, err)
+	assert.Equal(t, int64(204), *removed.Members[0].StatusCode)
+	assert.Equal(t, int64(404), *removed.Members[1].StatusCode)
+
+	response, err = accessGroups.RemoveMemberFromAccessGroup(accessGroups.NewRemoveMemberFromAccessGroupOptions(groupID, "iam-ServiceId-1"))
+	require.Nil(t, err)
+	response, err = accessGroups.IsMemberOfAccessGroup(accessGroups.NewIsMemberOfAccessGroupOptions(groupID, "iam-ServiceId-1"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	response, err = accessGroups.DeleteAccessGroup(accessGroups.NewDeleteAccessGroupOptions(groupID))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+}
+
+func TestDynamicAccessGroupMembers(t *testing.T) {
+	server := newServer(t, nil)
+	accessGroups, err := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	accountID := server.AccountID()
+	group, _, err := accessGroups.CreateAccessGroup(accessGroups.NewCreateAccessGroupOptions(accountID, "federated"))
+	require.Nil(t, err)
+	addOptions := accessGroups.NewAddMembersToAccessGroupOptions(*group.ID)
+	addOptions.Members = []iamaccessgroupsv2.AddGroupMembersRequestMembersItem{
+		{IamID: core.StringPtr("IBMid-static"), Type: core.StringPtr("user")},
+	}
+	_, _, err = accessGroups.AddMembersToAccessGroup(addOptions)
+	require.Nil(t, err)
+	assert.True(t, server.AddDynamicAccessGroupMember(*group.ID, "IBMid-dynamic", "user"))
+	assert.False(t, server.AddDynamicAccessGroupMember(*group.ID, "IBMid-dynamic", "user"))
+	assert.False(t, server.AddDynamicAccessGroupMember("unknown", "IBMid-dynamic", "user"))
+
+	// Only static members are listed by default.
+	for membershipType, want := range map[string][]string{
+		"":        {"IBMid-static"},
+		"static":  {"IBMid-static"},
+		"dynamic": {"IBMid-dynamic"},
+		"all":     {"IBMid-static", "IBMid-dynamic"},
+	} {
+		listOptions := accessGroups.NewListAccessGroupMembersOptions(*group.ID)
+		if membershipType != "" {
+			listOptions.SetMembershipType(membershipType)
+		}
+		members, _, err := accessGroups.ListAccessGroupMembers(listOptions)
+		require.Nil(t, err)
+		var iamIDs []string
+		for _, member := range members.Members {
+			iamIDs = append(iamIDs, *member.IamID)
+		}
+		assert.Equal(t, want, iamIDs, membershipType)
+	}
+
+	listOptions := accessGroups.NewListAccessGroupsOptions(accountID).SetIamID("IBMid-dynamic")
+	groups, _, err := accessGroups.ListAccessGroups(listOptions)
+	require.Nil(t, err)
+	assert.Empty(t, groups.Groups)
+	groups, _, err = accessGroups.ListAccessGroups(listOptions.SetMembershipType("all"))
+	require.Nil(t, err)
+	require.Len(t, groups.Groups, 1)
+	assert.Equal(t, *group.ID, *groups.Groups[0].ID)
+
+	_, response, err := accessGroups.ListAccessGroups(listOptions.SetMembershipType("unknown"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
@@ -0,0 +1,149 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// activityTimeLayout is the format of the authentication times in activity reports.
+const activityTimeLayout = "2006-01-02T15:04-0700"
+
+// The default duration of an activity report, in hours.
+const activityReportDefaultDuration = 720
+
+// Identity : An identity known to the fake, registered with Server.RegisterIdentity().
+type Identity struct {
+	// The IAM ID of the identity. Service IDs and trusted profiles are identified by the
+	// "iam-ServiceId-" and "iam-Profile-" prefixes; other IAM IDs are users.
+	IamID string
+
+	// The name of the identity (defaults to the IAM ID).
+	Name string
+
+	// The time of the last authentication of the identity, or the zero time if it never authenticated.
+	LastAuthn time.Time
+}
+
+type activityReportRecord struct {
+	report iamidentityv1.Report
+	ready  bool
+}
+
+func (server *Server) addIdentityRoutes() {
+	style := errorStyleIAM
+	r := server.router
+	r.handle(http.MethodPost, "/v1/activity/accounts/{account_id}/report", style, server.createReport)
+	r.handle(http.MethodGet, "/v1/activity/accounts/{account_id}/report/{reference}", style, server.getReport)
+}
+
+// createReport creates an inactivity report, which lists the identities that have not
+// authenticated within the duration of the report. The report is reported as incomplete (204)
+// the first time it is retrieved.
+func (server *Server) createReport(c *call) {
+	if c.pathParam("account_id") != server.accountID {
+		c.notFound("account", c.pathParam("account_id"))
+		return
+	}
+	if reportType := c.query("type"); reportType != "" && reportType != "inactive" {
+		c.badRequest("The only supported report type is 'inactive'.")
+		return
+	}
+	duration, ok := c.queryInt64("duration", activityReportDefaultDuration)
+	if !ok {
+		return
+	}
+
+	end := server.now()
+	start := end.Add(-time.Duration(duration) * time.Hour)
+	reference := server.nextID()
+	report := iamidentityv1.Report{
+		CreatedBy:       core.StringPtr(fakeUserID),
+		Reference:       core.StringPtr(reference),
+		ReportDuration:  core.StringPtr(strconv.FormatInt(duration, 10)),
+		ReportStartTime: core.StringPtr(start.Format(activityTimeLayout)),
+		ReportEndTime:   core.StringPtr(end.Format(activityTimeLayout)),
+		Users:           []iamidentityv1.UserActivity{},
+		Apikeys:         []iamidentityv1.ApikeyActivity{},
+		Serviceids:      []iamidentityv1.EntityActivity{},
+		Profiles:        []iamidentityv1.EntityActivity{},
+	}
+	for _, identity := range server.identities {
+		if identity.LastAuthn.After(start) {
+			continue
+		}
+		var lastAuthn *string
+		if !identity.LastAuthn.IsZero() {
+			lastAuthn = core.StringPtr(identity.LastAuthn.Format(activityTimeLayout))
+		}
+		name := identity.Name
+		if name == "" {
+			name = identity.IamID
+		}
+		switch {
+		case strings.HasPrefix(identity.IamID, "iam-ServiceId-"):
+			report.Serviceids = append(report.Serviceids, iamidentityv1.EntityActivity{
+				ID: core.StringPtr(strings.TrimPrefix(identity.IamID, "iam-")), Name: core.StringPtr(name), LastAuthn: lastAuthn,
+			})
+		case strings.HasPrefix(identity.IamID, "iam-Profile-"):
+			report.Profiles = append(report.Profiles, iamidentityv1.EntityActivity{
+				ID: core.StringPtr(strings.TrimPrefix(identity.IamID, "iam-")), Name: core.StringPtr(name), LastAuthn: lastAuthn,
+			})
+		default:
+			report.Users = append(report.Users, iamidentityv1.UserActivity{
+				IamID: core.StringPtr(identity.IamID), Name: core.StringPtr(name), Username: core.StringPtr(name), LastAuthn: lastAuthn,
+			})
+		}
+	}
+	sort.Slice(report.Users, func(i, j int) bool { return *report.Users[i].IamID < *report.Users[j].IamID })
+	sort.Slice(report.Serviceids, func(i, j int) bool { return *report.Serviceids[i].ID < *report.Serviceids[j].ID })
+	sort.Slice(report.Profiles, func(i, j int) bool { return *report.Profiles[i].ID < *report.Profiles[j].ID })
+
+	server.activityReports = append(server.activityReports, &activityReportRecord{report: report})
+	c.writeJSON(http.StatusAccepted, &iamidentityv1.ReportReference{Reference: core.StringPtr(reference)})
+}
+
+func (server *Server) getReport(c *call) {
+	if c.pathParam("account_id") != server.accountID {
+		c.notFound("account", c.pathParam("account_id"))
+		return
+	}
+	reference := c.pathParam("reference")
+	var record *activityReportRecord
+	for _, existing := range server.activityReports {
+		if *existing.report.Reference == reference || reference == "latest" {
+			record = existing
+		}
+	}
+	if record == nil {
+		c.notFound("report", reference)
+		return
+	}
+	if !record.ready {
+		record.ready = true
+		c.writeNoContent()
+		return
+	}
+	c.writeJSON(http.StatusOK, record.report)
+}
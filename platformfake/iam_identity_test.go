@@ -0,0 +1,73 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInactivityReports(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	server := newServer(t, &platformfake.ServerOptions{Now: func() time.Time { return now }})
+	server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-active", LastAuthn: now.Add(-time.Hour)})
+	server.RegisterIdentity(platformfake.Identity{IamID: "IBMid-inactive", Name: "Jane", LastAuthn: now.AddDate(0, -3, 0)})
+	server.RegisterIdentity(platformfake.Identity{IamID: "iam-ServiceId-123"})
+	server.RegisterIdentity(platformfake.Identity{IamID: "iam-Profile-456", LastAuthn: now.AddDate(0, 0, -10)})
+
+	iamIdentity, err := iamidentityv1.NewIamIdentityV1(&iamidentityv1.IamIdentityV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	accountID := server.AccountID()
+
+	reference, response, err := iamIdentity.CreateReport(iamIdentity.NewCreateReportOptions(accountID).SetType("inactive").SetDuration("168"))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+
+	// The report is incomplete the first time it is retrieved.
+	report, response, err := iamIdentity.GetReport(iamIdentity.NewGetReportOptions(accountID, *reference.Reference))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	assert.Nil(t, report)
+
+	report, response, err = iamIdentity.GetReport(iamIdentity.NewGetReportOptions(accountID, *reference.Reference))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "168", *report.ReportDuration)
+	require.Len(t, report.Users, 1)
+	assert.Equal(t, "IBMid-inactive", *report.Users[0].IamID)
+	assert.Equal(t, "Jane", *report.Users[0].Name)
+	assert.Equal(t, "2024-02-01T12:00+0000", *report.Users[0].LastAuthn)
+	require.Len(t, report.Serviceids, 1)
+	assert.Equal(t, "ServiceId-123", *report.Serviceids[0].ID)
+	assert.Nil(t, report.Serviceids[0].LastAuthn)
+	require.Len(t, report.Profiles, 1)
+	assert.Equal(t, "Profile-456", *report.Profiles[0].ID)
+
+	_, response, err = iamIdentity.GetReport(iamIdentity.NewGetReportOptions(accountID, "unknown"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
@@ -0,0 +1,612 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// The default and maximum page sizes of the v2 policy list operation.
+const (
+	policiesDefaultLimit = 50
+	policiesMaxLimit     = 1000
+)
+
+type policyRecord struct {
+	policy  iampolicymanagementv1.V2Policy
+	version int
+}
+
+func (record *policyRecord) etag() string {
+	return etag(*record.policy.ID, record.version)
+}
+
+// v2PolicyCollection is the response body of the ListV2Policies operation.
+type v2PolicyCollection struct {
+	Limit    *int64                           `json:"limit"`
+	First    *iampolicymanagementv1.First     `json:"first,omitempty"`
+	Next     *iampolicymanagementv1.Next      `json:"next,omitempty"`
+	Previous *iampolicymanagementv1.Previous  `json:"previous,omitempty"`
+	Policies []iampolicymanagementv1.V2Policy `json:"policies"`
+}
+
+func (server *Server) addPolicyManagementRoutes() {
+	style := errorStyleIAM
+	r := server.router
+	r.handle(http.MethodGet, "/v2/policies", style, server.listV2Policies)
+	r.handle(http.MethodPost, "/v2/policies", style, server.createV2Policy)
+	r.handle(http.MethodGet, "/v2/policies/{id}", style, server.getV2Policy)
+	r.handle(http.MethodPut, "/v2/policies/{id}", style, server.replaceV2Policy)
+	r.handle(http.MethodDelete, "/v2/policies/{id}", style, server.deleteV2Policy)
+	r.handle(http.MethodGet, "/v2/roles", style, server.listRoles)
+	r.handle(http.MethodPost, "/v2/roles", style, server.createRole)
+	r.handle(http.MethodGet, "/v2/roles/{role_id}", style, server.getRole)
+	r.handle(http.MethodPut, "/v2/roles/{role_id}", style, server.replaceRole)
+	r.handle(http.MethodDelete, "/v2/roles/{role_id}", style, server.deleteRole)
+	r.handle(http.MethodGet, "/v1/policy_templates", style, server.listPolicyTemplates)
+	r.handle(http.MethodPost, "/v1/policy_templates", style, server.createPolicyTemplate)
+	r.handle(http.MethodGet, "/v1/policy_templates/{policy_template_id}", style, server.getPolicyTemplate)
+	r.handle(http.MethodPost, "/v1/policy_templates/{policy_template_id}/versions", style, server.createPolicyTemplateVersion)
+}
+
+// systemRoles are the platform roles returned by the ListRoles operation for every service.
+var systemRoles = []iampolicymanagementv1.Role{
+	newSystemRole("Viewer", "iam.policy.read", "resource-controller.instance.retrieve"),
+	newSystemRole("Operator", "iam.policy.read", "resource-controller.instance.retrieve"),
+	newSystemRole("Editor", "iam.policy.read", "resource-controller.instance.retrieve",
+		"resource-controller.instance.create", "resource-controller.instance.update", "resource-controller.instance.delete"),
+	newSystemRole("Administrator", "iam.policy.read", "iam.policy.create", "iam.policy.update", "iam.policy.delete",
+		"resource-controller.instance.retrieve", "resource-controller.instance.create",
+		"resource-controller.instance.update", "resource-controller.instance.delete"),
+}
+
+func newSystemRole(name string, actions ...string) iampolicymanagementv1.Role {
+	return iampolicymanagementv1.Role{
+		DisplayName: core.StringPtr(name),
+		Description: core.StringPtr(name),
+		Actions:     actions,
+		CRN:         core.StringPtr("crn:v1:bluemix:public:iam::::role:" + name),
+	}
+}
+
+// subjectAttribute returns the value of the named subject attribute of "policy", or "".
+func subjectAttribute(policy *iampolicymanagementv1.V2Policy, key string) string {
+	if policy.Subject != nil {
+		for _, attribute := range policy.Subject.Attributes {
+			if *attribute.Key == key {
+				return fmt.Sprint(attribute.Value)
+			}
+		}
+	}
+	return ""
+}
+
+// resourceAttribute returns the value of the named resource attribute of "policy", or "".
+func resourceAttribute(policy *iampolicymanagementv1.V2Policy, key string) string {
+	if policy.Resource != nil {
+		for _, attribute := range policy.Resource.Attributes {
+			if *attribute.Key == key {
+				return fmt.Sprint(attribute.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func (c *call) lookupPolicy() *policyRecord {
+	id := c.pathParam("id")
+	for _, record := range c.server.policies {
+		if *record.policy.ID == id {
+			return record
+		}
+	}
+	c.notFound("policy", id)
+	return nil
+}
+
+// decodePolicy unmarshals and validates the V2Policy in the request body.
+func (c *call) decodePolicy() (policy *iampolicymanagementv1.V2Policy, ok bool) {
+	if !c.decodeModel(&policy, iampolicymanagementv1.UnmarshalV2Policy) {
+		return
+	}
+	control, _ := policy.Control.(*iampolicymanagementv1.ControlResponse)
+	switch {
+	case policy.Type == nil || (*policy.Type != "access" && *policy.Type != "authorization"):
+		c.badRequest("The 'type' property must be 'access' or 'authorization'.")
+	case control == nil || control.Grant == nil || len(control.Grant.Roles) == 0:
+		c.badRequest("The 'control.grant.roles' property must contain at least one role.")
+	case resourceAttribute(policy, "accountId") == "":
+		c.badRequest("The policy resource must have an 'accountId' attribute.")
+	default:
+		ok = true
+	}
+	return
+}
+
+func (server *Server) listV2Policies(c *call) {
+	accountID := c.query("account_id")
+	if accountID == "" {
+		c.badRequest("The 'account_id' query parameter is required.")
+		return
+	}
+	limit, ok := c.queryInt64("limit", policiesDefaultLimit)
+	if !ok {
+		return
+	}
+	if limit == 0 || limit > policiesMaxLimit {
+		c.badRequest(fmt.Sprintf("The value of the 'limit' query parameter must be between 1 and %d.", policiesMaxLimit))
+		return
+	}
+	offset, ok := c.queryInt64("start", 0)
+	if !ok {
+		return
+	}
+
+	filters := map[string]func(*iampolicymanagementv1.V2Policy) string{
+		"iam_id":           func(p *iampolicymanagementv1.V2Policy) string { return subjectAttribute(p, "iam_id") },
+		"access_group_id":  func(p *iampolicymanagementv1.V2Policy) string { return subjectAttribute(p, "access_group_id") },
+		"type":             func(p *iampolicymanagementv1.V2Policy) string { return *p.Type },
+		"service_type":     func(p *iampolicymanagementv1.V2Policy) string { return resourceAttribute(p, "serviceType") },
+		"service_name":     func(p *iampolicymanagementv1.V2Policy) string { return resourceAttribute(p, "serviceName") },
+		"service_group_id": func(p *iampolicymanagementv1.V2Policy) string { return resourceAttribute(p, "service_group_id") },
+	}
+	state := c.query("state")
+	if state == "" {
+		state = "active"
+	}
+
+	matches := []iampolicymanagementv1.V2Policy{}
+	for _, record := range server.policies {
+		policy := record.policy
+		if resourceAttribute(&policy, "accountId") != accountID || *policy.State != state {
+			continue
+		}
+		matched := true
+		for queryParam, field := range filters {
+			if want := c.query(queryParam); want != "" && field(&policy) != want {
+				matched = false
+			}
+		}
+		if matched {
+			matches = append(matches, policy)
+		}
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	link := func(start int) string {
+		query := c.req.URL.Query()
+		query.Set("limit", strconv.FormatInt(limit, 10))
+		if start > 0 {
+			query.Set("start", strconv.Itoa(start))
+		} else {
+			query.Del("start")
+		}
+		return c.href(c.req.URL.Path + "?" + query.Encode())
+	}
+	result := &v2PolicyCollection{
+		Limit:    core.Int64Ptr(limit),
+		First:    &iampolicymanagementv1.First{Href: core.StringPtr(link(0))},
+		Policies: matches[start:end],
+	}
+	if end < len(matches) {
+		result.Next = &iampolicymanagementv1.Next{
+			Href:  core.StringPtr(link(end)),
+			Start: core.StringPtr(strconv.Itoa(end)),
+		}
+	}
+	if start > 0 {
+		previous := start - int(limit)
+		if previous < 0 {
+			previous = 0
+		}
+		result.Previous = &iampolicymanagementv1.Previous{
+			Href:  core.StringPtr(link(previous)),
+			Start: core.StringPtr(strconv.Itoa(previous)),
+		}
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) createV2Policy(c *call) {
+	policy, ok := c.decodePolicy()
+	if !ok {
+		return
+	}
+
+	id := server.nextID()
+	now := server.timestamp()
+	policy.ID = core.StringPtr(id)
+	policy.Href = core.StringPtr(c.href("/v2/policies/" + id))
+	policy.State = core.StringPtr("active")
+	policy.CreatedAt = now
+	policy.CreatedByID = core.StringPtr(fakeUserID)
+	policy.LastModifiedAt = now
+	policy.LastModifiedByID = core.StringPtr(fakeUserID)
+
+	record := &policyRecord{
+		policy:  *policy,
+		version: 1,
+	}
+	server.policies = append(server.policies, record)
+	c.writeJSONWithETag(http.StatusCreated, record.etag(), record.policy)
+}
+
+func (server *Server) getV2Policy(c *call) {
+	record := c.lookupPolicy()
+	if record == nil {
+		return
+	}
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.policy)
+}
+
+func (server *Server) replaceV2Policy(c *call) {
+	record := c.lookupPolicy()
+	if record == nil {
+		return
+	}
+	policy, ok := c.decodePolicy()
+	if !ok || !c.checkIfMatch(record.etag()) {
+		return
+	}
+	if *policy.Type != *record.policy.Type {
+		c.badRequest("The type of a policy cannot be changed.")
+		return
+	}
+
+	existing := record.policy
+	policy.ID = existing.ID
+	policy.Href = existing.Href
+	policy.State = existing.State
+	policy.CreatedAt = existing.CreatedAt
+	policy.CreatedByID = existing.CreatedByID
+	policy.LastModifiedAt = server.timestamp()
+	policy.LastModifiedByID = core.StringPtr(fakeUserID)
+
+	record.policy = *policy
+	record.version++
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.policy)
+}
+
+func (server *Server) deleteV2Policy(c *call) {
+	record := c.lookupPolicy()
+	if record == nil {
+		return
+	}
+	for i, existing := range server.policies {
+		if existing == record {
+			server.policies = append(server.policies[:i], server.policies[i+1:]...)
+			break
+		}
+	}
+	c.writeNoContent()
+}
+
+func (server *Server) listRoles(c *call) {
+	result := &iampolicymanagementv1.RoleCollection{
+		CustomRoles:  []iampolicymanagementv1.CustomRole{},
+		ServiceRoles: []iampolicymanagementv1.Role{},
+		SystemRoles:  systemRoles,
+	}
+	serviceName := c.query("service_name")
+	if serviceName != "" {
+		result.ServiceRoles = append(result.ServiceRoles, server.serviceRoles[serviceName]...)
+	}
+	if accountID := c.query("account_id"); accountID != "" {
+		for _, record := range server.customRoles {
+			role := record.role
+			if *role.AccountID == accountID && (serviceName == "" || *role.ServiceName == serviceName) {
+				result.CustomRoles = append(result.CustomRoles, role)
+			}
+		}
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+type customRoleRecord struct {
+	role    iampolicymanagementv1.CustomRole
+	version int
+}
+
+func (record *customRoleRecord) etag() string {
+	return etag(*record.role.ID, record.version)
+}
+
+// customRoleCRN returns the CRN of the custom role "name" of account "accountID".
+func customRoleCRN(accountID string, name string) string {
+	return "crn:v1:bluemix:public:iam-access-management::a/" + accountID + "::customRole:" + name
+}
+
+func (c *call) lookupCustomRole() *customRoleRecord {
+	id := c.pathParam("role_id")
+	for _, record := range c.server.customRoles {
+		if *record.role.ID == id {
+			return record
+		}
+	}
+	c.notFound("role", id)
+	return nil
+}
+
+// decodeCustomRole unmarshals and validates the CustomRole in the request body. The name,
+// account and service of the role are only required when the role is created.
+func (c *call) decodeCustomRole(create bool) (role *iampolicymanagementv1.CustomRole, ok bool) {
+	if !c.decodeModel(&role, iampolicymanagementv1.UnmarshalCustomRole) {
+		return
+	}
+	switch {
+	case role.DisplayName == nil || *role.DisplayName == "":
+		c.badRequest("The 'display_name' property is required.")
+	case len(role.Actions) == 0:
+		c.badRequest("The 'actions' property must contain at least one action.")
+	case create && (role.Name == nil || *role.Name == ""):
+		c.badRequest("The 'name' property is required.")
+	case create && (role.AccountID == nil || *role.AccountID == ""):
+		c.badRequest("The 'account_id' property is required.")
+	case create && (role.ServiceName == nil || *role.ServiceName == ""):
+		c.badRequest("The 'service_name' property is required.")
+	default:
+		ok = true
+	}
+	return
+}
+
+// addCustomRole assigns the server-managed properties of "role" and stores it.
+// The caller must hold the mutex of the server.
+func (server *Server) addCustomRole(role iampolicymanagementv1.CustomRole, href func(string) string) *customRoleRecord {
+	if role.ID == nil {
+		role.ID = core.StringPtr(server.nextID())
+	}
+	if role.CRN == nil {
+		role.CRN = core.StringPtr(customRoleCRN(*role.AccountID, *role.Name))
+	}
+	if href != nil {
+		role.Href = core.StringPtr(href("/v2/roles/" + *role.ID))
+	}
+	now := server.timestamp()
+	role.CreatedAt = now
+	role.CreatedByID = core.StringPtr(fakeUserID)
+	role.LastModifiedAt = now
+	role.LastModifiedByID = core.StringPtr(fakeUserID)
+
+	record := &customRoleRecord{
+		role:    role,
+		version: 1,
+	}
+	server.customRoles = append(server.customRoles, record)
+	return record
+}
+
+func (server *Server) createRole(c *call) {
+	role, ok := c.decodeCustomRole(true)
+	if !ok {
+		return
+	}
+	for _, existing := range server.customRoles {
+		if *existing.role.AccountID == *role.AccountID && *existing.role.Name == *role.Name {
+			c.conflict(fmt.Sprintf("A custom role named '%s' already exists in the account.", *role.Name))
+			return
+		}
+	}
+	role.ID = nil
+	role.CRN = nil
+	record := server.addCustomRole(*role, c.href)
+	c.writeJSONWithETag(http.StatusCreated, record.etag(), record.role)
+}
+
+func (server *Server) getRole(c *call) {
+	record := c.lookupCustomRole()
+	if record == nil {
+		return
+	}
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.role)
+}
+
+func (server *Server) replaceRole(c *call) {
+	record := c.lookupCustomRole()
+	if record == nil {
+		return
+	}
+	role, ok := c.decodeCustomRole(false)
+	if !ok || !c.checkIfMatch(record.etag()) {
+		return
+	}
+
+	record.role.DisplayName = role.DisplayName
+	if role.Description != nil {
+		// Like the real API, an omitted description is left unchanged.
+		record.role.Description = role.Description
+	}
+	record.role.Actions = role.Actions
+	record.role.LastModifiedAt = server.timestamp()
+	record.role.LastModifiedByID = core.StringPtr(fakeUserID)
+	record.version++
+	c.writeJSONWithETag(http.StatusOK, record.etag(), record.role)
+}
+
+func (server *Server) deleteRole(c *call) {
+	record := c.lookupCustomRole()
+	if record == nil {
+		return
+	}
+	for i, existing := range server.customRoles {
+		if existing == record {
+			server.customRoles = append(server.customRoles[:i], server.customRoles[i+1:]...)
+			break
+		}
+	}
+	c.writeNoContent()
+}
+
+// policyTemplateRecord holds the versions of a policy template, oldest first.
+type policyTemplateRecord struct {
+	versions []iampolicymanagementv1.PolicyTemplate
+}
+
+func (record *policyTemplateRecord) latest() iampolicymanagementv1.PolicyTemplate {
+	return record.versions[len(record.versions)-1]
+}
+
+func (c *call) lookupPolicyTemplate() *policyTemplateRecord {
+	id := c.pathParam("policy_template_id")
+	for _, record := range c.server.policyTemplates {
+		if *record.versions[0].ID == id {
+			return record
+		}
+	}
+	c.notFound("policy template", id)
+	return nil
+}
+
+// decodePolicyTemplate unmarshals and validates the policy template in the request body.
+// The name and account of the template are only required when the template is created.
+func (c *call) decodePolicyTemplate(create bool) (template *iampolicymanagementv1.PolicyTemplate, ok bool) {
+	if !c.decodeModel(&template, iampolicymanagementv1.UnmarshalPolicyTemplate) {
+		return
+	}
+	policy := template.Policy
+	switch {
+	case create && (template.Name == nil || *template.Name == ""):
+		c.badRequest("The 'name' property is required.")
+	case create && (template.AccountID == nil || *template.AccountID == ""):
+		c.badRequest("The 'account_id' property is required.")
+	case policy == nil:
+		c.badRequest("The 'policy' property is required.")
+	case policy.Type == nil || (*policy.Type != "access" && *policy.Type != "authorization"):
+		c.badRequest("The 'policy.type' property must be 'access' or 'authorization'.")
+	case policy.Control == nil || policy.Control.Grant == nil || len(policy.Control.Grant.Roles) == 0:
+		c.badRequest("The 'policy.control.grant.roles' property must contain at least one role.")
+	default:
+		ok = true
+	}
+	return
+}
+
+func (server *Server) listPolicyTemplates(c *call) {
+	accountID := c.query("account_id")
+	if accountID == "" {
+		c.badRequest("The 'account_id' query parameter is required.")
+		return
+	}
+	limit, ok := c.queryInt64("limit", policiesDefaultLimit)
+	if !ok {
+		return
+	}
+	if limit == 0 || limit > policiesMaxLimit {
+		c.badRequest(fmt.Sprintf("The value of the 'limit' query parameter must be between 1 and %d.", policiesMaxLimit))
+		return
+	}
+	offset, ok := c.queryInt64("start", 0)
+	if !ok {
+		return
+	}
+
+	name := c.query("name")
+	matches := []iampolicymanagementv1.PolicyTemplate{}
+	for _, record := range server.policyTemplates {
+		template := record.latest()
+		if *template.AccountID == accountID && (name == "" || *template.Name == name) {
+			matches = append(matches, template)
+		}
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	result := &iampolicymanagementv1.PolicyTemplateCollection{
+		Limit:           core.Int64Ptr(limit),
+		PolicyTemplates: matches[start:end],
+	}
+	if end < len(matches) {
+		query := c.req.URL.Query()
+		query.Set("start", strconv.Itoa(end))
+		result.Next = &iampolicymanagementv1.Next{
+			Href:  core.StringPtr(c.href(c.req.URL.Path + "?" + query.Encode())),
+			Start: core.StringPtr(strconv.Itoa(end)),
+		}
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) createPolicyTemplate(c *call) {
+	template, ok := c.decodePolicyTemplate(true)
+	if !ok {
+		return
+	}
+	for _, record := range server.policyTemplates {
+		existing := record.latest()
+		if *existing.AccountID == *template.AccountID && *existing.Name == *template.Name {
+			c.conflict(fmt.Sprintf("A policy template named '%s' already exists in the account.", *template.Name))
+			return
+		}
+	}
+
+	id := "policyTemplate-" + server.nextID()
+	now := server.timestamp()
+	template.ID = core.StringPtr(id)
+	template.Href = core.StringPtr(c.href("/v1/policy_templates/" + id))
+	template.Version = core.StringPtr("1")
+	template.State = core.StringPtr(iampolicymanagementv1.PolicyTemplateStateActiveConst)
+	template.CreatedAt = now
+	template.CreatedByID = core.StringPtr(fakeUserID)
+	template.LastModifiedAt = now
+	template.LastModifiedByID = core.StringPtr(fakeUserID)
+
+	server.policyTemplates = append(server.policyTemplates, &policyTemplateRecord{
+		versions: []iampolicymanagementv1.PolicyTemplate{*template},
+	})
+	c.writeJSON(http.StatusCreated, template)
+}
+
+func (server *Server) getPolicyTemplate(c *call) {
+	record := c.lookupPolicyTemplate()
+	if record == nil {
+		return
+	}
+	c.writeJSON(http.StatusOK, record.latest())
+}
+
+func (server *Server) createPolicyTemplateVersion(c *call) {
+	record := c.lookupPolicyTemplate()
+	if record == nil {
+		return
+	}
+	template, ok := c.decodePolicyTemplate(false)
+	if !ok {
+		return
+	}
+
+	latest := record.latest()
+	template.ID = latest.ID
+	template.Href = latest.Href
+	template.AccountID = latest.AccountID
+	template.State = latest.State
+	template.Version = core.StringPtr(strconv.Itoa(len(record.versions) + 1))
+	if template.Name == nil {
+		template.Name = latest.Name
+	}
+	now := server.timestamp()
+	template.CreatedAt = now
+	template.CreatedByID = core.StringPtr(fakeUserID)
+	template.LastModifiedAt = now
+	template.LastModifiedByID = core.StringPtr(fakeUserID)
+
+	record.versions = append(record.versions, *template)
+	c.writeJSON(http.StatusCreated, template)
+}
@@ -0,0 +1,255 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newV2PolicyOptions(policyManagement *iampolicymanagementv1.IamPolicyManagementV1, accountID string, iamID string, serviceName string) *iampolicymanagementv1.CreateV2PolicyOptions {
+	control := &iampolicymanagementv1.Control{
+		Grant: &iampolicymanagementv1.Grant{
+			Roles: []iampolicymanagementv1.Roles{
+				{RoleID: core.StringPtr("crn:v1:bluemix:public:iam::::role:Viewer")},
+			},
+		},
+	}
+	options := policyManagement.NewCreateV2PolicyOptions(control, "access")
+	options.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+			{Key: core.StringPtr("iam_id"), Operator: core.StringPtr("stringEquals"), Value: iamID},
+		},
+	})
+	options.SetResource(&iampolicymanagementv1.V2PolicyResource{
+		Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+			{Key: core.StringPtr("accountId"), Operator: core.StringPtr("stringEquals"), Value: accountID},
+			{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: serviceName},
+		},
+	})
+	return options
+}
+
+func TestV2Policies(t *testing.T) {
+	server := newServer(t, nil)
+	policyManagement, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	accountID := server.AccountID()
+
+	for i := 0; i < 3; i++ {
+		policy, response, err := policyManagement.CreateV2Policy(newV2PolicyOptions(policyManagement, accountID, fmt.Sprintf("IBMid-%d", i), "kms"))
+		require.Nil(t, err)
+		assert.Equal(t, http.StatusCreated, response.StatusCode)
+		assert.Equal(t, "active", *policy.State)
+		assert.NotEmpty(t, response.Headers.Get("ETag"))
+	}
+
+	invalid := newV2PolicyOptions(policyManagement, "", "IBMid-x", "kms")
+	invalid.Resource.Attributes = invalid.Resource.Attributes[1:]
+	_, response, err := policyManagement.CreateV2Policy(invalid)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+
+	pager, err := policyManagement.NewV2PoliciesPager(policyManagement.NewListV2PoliciesOptions(accountID).SetLimit(2))
+	require.Nil(t, err)
+	policies, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, policies, 3)
+
+	filtered, _, err := policyManagement.ListV2Policies(policyManagement.NewListV2PoliciesOptions(accountID).SetIamID("IBMid-1"))
+	require.Nil(t, err)
+	require.Len(t, filtered.Policies, 1)
+	policyID := *filtered.Policies[0].ID
+
+	policy, response, err := policyManagement.GetV2Policy(policyManagement.NewGetV2PolicyOptions(policyID))
+	require.Nil(t, err)
+	etag := response.Headers.Get("ETag")
+
+	replace := newV2PolicyOptions(policyManagement, accountID, "IBMid-1", "cloud-object-storage")
+	replaceOptions := policyManagement.NewReplaceV2PolicyOptions(policyID, etag, replace.Control, "access")
+	replaceOptions.SetSubject(replace.Subject)
+	replaceOptions.SetResource(replace.Resource)
+	replaced, _, err := policyManagement.ReplaceV2Policy(replaceOptions)
+	require.Nil(t, err)
+	assert.Equal(t, *policy.CreatedAt, *replaced.CreatedAt)
+	assert.Equal(t, "cloud-object-storage", replaced.Resource.Attributes[1].Value)
+
+	_, response, err = policyManagement.ReplaceV2Policy(replaceOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+	response, err = policyManagement.DeleteV2Policy(policyManagement.NewDeleteV2PolicyOptions(policyID))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+	_, response, err = policyManagement.GetV2Policy(policyManagement.NewGetV2PolicyOptions(policyID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
+
+func TestRoles(t *testing.T) {
+	server := newServer(t, nil)
+	server.RegisterServiceRole("cloud-object-storage", iampolicymanagementv1.Role{
+		DisplayName: core.StringPtr("Writer"),
+		Actions:     []string{"cloud-object-storage.object.put"},
+		CRN:         core.StringPtr("crn:v1:bluemix:public:iam::::serviceRole:Writer"),
+	})
+	policyManagement, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	server.RegisterCustomRole(iampolicymanagementv1.CustomRole{
+		Name:        core.StringPtr("ObjectCleaner"),
+		DisplayName: core.StringPtr("Object cleaner"),
+		Actions:     []string{"cloud-object-storage.object.delete"},
+		AccountID:   core.StringPtr(server.AccountID()),
+		ServiceName: core.StringPtr("cloud-object-storage"),
+		CRN:         core.StringPtr("crn:v1:bluemix:public:iam-access-management::a/" + server.AccountID() + "::customRole:ObjectCleaner"),
+	})
+	roles, _, err := policyManagement.ListRoles(policyManagement.NewListRolesOptions().SetServiceName("cloud-object-storage"))
+	require.Nil(t, err)
+	assert.Empty(t, roles.CustomRoles)
+
+	roles, _, err = policyManagement.ListRoles(policyManagement.NewListRolesOptions().
+		SetAccountID(server.AccountID()).SetServiceName("cloud-object-storage"))
+	require.Nil(t, err)
+	require.Len(t, roles.CustomRoles, 1)
+	assert.Equal(t, "ObjectCleaner", *roles.CustomRoles[0].Name)
+	require.Len(t, roles.SystemRoles, 4)
+	assert.Equal(t, "crn:v1:bluemix:public:iam::::role:Viewer", *roles.SystemRoles[0].CRN)
+	require.Len(t, roles.ServiceRoles, 1)
+	assert.Equal(t, []string{"cloud-object-storage.object.put"}, roles.ServiceRoles[0].Actions)
+
+	roles, _, err = policyManagement.ListRoles(policyManagement.NewListRolesOptions())
+	require.Nil(t, err)
+	assert.Empty(t, roles.ServiceRoles)
+}
+
+func TestCustomRoles(t *testing.T) {
+	server := newServer(t, nil)
+	policyManagement, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	createOptions := policyManagement.NewCreateRoleOptions("Key reader", []string{"kms.secrets.read"},
+		"KeyReader", server.AccountID(), "kms")
+	role, response, err := policyManagement.CreateRole(createOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "crn:v1:bluemix:public:iam-access-management::a/"+server.AccountID()+"::customRole:KeyReader", *role.CRN)
+	etag := response.GetHeaders().Get("ETag")
+
+	_, response, err = policyManagement.CreateRole(createOptions)
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.GetStatusCode())
+	_, response, err = policyManagement.CreateRole(policyManagement.NewCreateRoleOptions("Empty", []string{},
+		"Empty", server.AccountID(), "kms"))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.GetStatusCode())
+
+	_, response, err = policyManagement.ReplaceRole(policyManagement.NewReplaceRoleOptions(*role.ID, `W/"0-stale"`,
+		"Key reader", []string{"kms.secrets.read", "kms.secrets.list"}))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusPreconditionFailed, response.GetStatusCode())
+	replaced, _, err := policyManagement.ReplaceRole(policyManagement.NewReplaceRoleOptions(*role.ID, etag,
+		"Key reader", []string{"kms.secrets.read", "kms.secrets.list"}))
+	require.Nil(t, err)
+	assert.Equal(t, "KeyReader", *replaced.Name)
+	assert.Len(t, replaced.Actions, 2)
+
+	fetched, _, err := policyManagement.GetRole(policyManagement.NewGetRoleOptions(*role.ID))
+	require.Nil(t, err)
+	assert.Equal(t, replaced.Actions, fetched.Actions)
+
+	_, err = policyManagement.DeleteRole(policyManagement.NewDeleteRoleOptions(*role.ID))
+	require.Nil(t, err)
+	_, response, err = policyManagement.GetRole(policyManagement.NewGetRoleOptions(*role.ID))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.GetStatusCode())
+}
+
+func TestPolicyTemplates(t *testing.T) {
+	server := newServer(t, nil)
+	policyManagement, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	newPolicy := func(role string) *iampolicymanagementv1.TemplatePolicy {
+		return &iampolicymanagementv1.TemplatePolicy{
+			Type: core.StringPtr("access"),
+			Resource: &iampolicymanagementv1.V2PolicyResource{
+				Attributes: []iampolicymanagementv1.V2PolicyResourceAttribute{
+					{Key: core.StringPtr("serviceName"), Operator: core.StringPtr("stringEquals"), Value: "kms"},
+				},
+			},
+			Control: &iampolicymanagementv1.Control{
+				Grant: &iampolicymanagementv1.Grant{
+					Roles: []iampolicymanagementv1.Roles{{RoleID: core.StringPtr(role)}},
+				},
+			},
+		}
+	}
+	for i := 0; i < 3; i++ {
+		_, _, err := policyManagement.CreatePolicyTemplate(policyManagement.NewCreatePolicyTemplateOptions(
+			fmt.Sprintf("template-%d", i), server.AccountID(), newPolicy("crn:v1:bluemix:public:iam::::role:Viewer")))
+		require.Nil(t, err)
+	}
+	_, response, err := policyManagement.CreatePolicyTemplate(policyManagement.NewCreatePolicyTemplateOptions(
+		"template-0", server.AccountID(), newPolicy("crn:v1:bluemix:public:iam::::role:Viewer")))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.GetStatusCode())
+
+	pager, err := policyManagement.NewPolicyTemplatesPager(&iampolicymanagementv1.ListPolicyTemplatesOptions{
+		AccountID: core.StringPtr(server.AccountID()),
+		Limit:     core.Int64Ptr(2),
+	})
+	require.Nil(t, err)
+	templates, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, templates, 3)
+	assert.Equal(t, "template-2", *templates[2].Name)
+
+	version, _, err := policyManagement.CreatePolicyTemplateVersion(policyManagement.NewCreatePolicyTemplateVersionOptions(
+		*templates[0].ID, newPolicy("crn:v1:bluemix:public:iam::::role:Editor")))
+	require.Nil(t, err)
+	assert.Equal(t, "2", *version.Version)
+	assert.Equal(t, "template-0", *version.Name)
+
+	template, _, err := policyManagement.GetPolicyTemplate(policyManagement.NewGetPolicyTemplateOptions(*templates[0].ID))
+	require.Nil(t, err)
+	assert.Equal(t, "2", *template.Version)
+	assert.Equal(t, "crn:v1:bluemix:public:iam::::role:Editor", *template.Policy.Control.Grant.Roles[0].RoleID)
+
+	result, _, err := policyManagement.ListPolicyTemplates(policyManagement.NewListPolicyTemplatesOptions(server.AccountID()).
+		SetName("template-0"))
+	require.Nil(t, err)
+	require.Len(t, result.PolicyTemplates, 1)
+	assert.Equal(t, "2", *result.PolicyTemplates[0].Version)
+}
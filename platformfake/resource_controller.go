@@ -0,0 +1,1127 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/crn"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// The user reported as the creator and updater of the resources managed by the fake.
+const fakeUserID = "IBMid-fake-user"
+
+// The reclamation retention period applied when ServerOptions.ReclamationEnabled is set.
+const reclamationRetention = 7 * 24 * time.Hour
+
+// The default and maximum page sizes of the resource controller list operations.
+const (
+	resourceControllerDefaultLimit = 100
+	resourceControllerMaxLimit     = 100
+)
+
+// Resource instance states.
+const (
+	instanceStateActive             = "active"
+	instanceStateProvisioning       = "provisioning"
+	instanceStateInactive           = "inactive"
+	instanceStateFailed             = "failed"
+	instanceStatePendingRemoval     = "pending_removal"
+	instanceStatePendingReclamation = "pending_reclamation"
+	instanceStateRemoved            = "removed"
+)
+
+// Last operation states.
+const (
+	operationStateInProgress = "in progress"
+	operationStateSucceeded  = "succeeded"
+	operationStateFailed     = "failed"
+)
+
+// resourceInstanceRecord holds a resource instance and the number of polls remaining
+// before its in-progress last operation completes.
+type resourceInstanceRecord struct {
+	instance     resourcecontrollerv2.ResourceInstance
+	pendingPolls int
+}
+
+type resourceKeyRecord struct {
+	key resourcecontrollerv2.ResourceKey
+}
+
+// response returns the key as returned by the fake. One-time credentials are only included if
+// "created" is true.
+func (record *resourceKeyRecord) response(created bool) resourcecontrollerv2.ResourceKey {
+	response := record.key
+	if !created && *record.key.OnetimeCredentials {
+		response.Credentials = &resourcecontrollerv2.Credentials{
+			Redacted: core.StringPtr(resourcecontrollerv2.CredentialsRedactedRedactedConst),
+		}
+	}
+	return response
+}
+
+type resourceAliasRecord struct {
+	alias resourcecontrollerv2.ResourceAlias
+}
+
+type reclamationRecord struct {
+	reclamation resourcecontrollerv2.Reclamation
+}
+
+func (server *Server) addResourceControllerRoutes() {
+	style := errorStyleResourceController
+	r := server.router
+	r.handle(http.MethodGet, "/v2/resource_instances", style, server.listResourceInstances)
+	r.handle(http.MethodPost, "/v2/resource_instances", style, server.createResourceInstance)
+	r.handle(http.MethodGet, "/v2/resource_instances/{id}", style, server.getResourceInstance)
+	r.handle(http.MethodPatch, "/v2/resource_instances/{id}", style, server.updateResourceInstance)
+	r.handle(http.MethodDelete, "/v2/resource_instances/{id}", style, server.deleteResourceInstance)
+	r.handle(http.MethodGet, "/v2/resource_instances/{id}/resource_keys", style, server.listResourceKeysForInstance)
+	r.handle(http.MethodGet, "/v2/resource_instances/{id}/resource_aliases", style, server.listResourceAliasesForInstance)
+	r.handle(http.MethodPost, "/v2/resource_instances/{id}/lock", style, server.lockResourceInstance)
+	r.handle(http.MethodDelete, "/v2/resource_instances/{id}/lock", style, server.unlockResourceInstance)
+	r.handle(http.MethodDelete, "/v2/resource_instances/{id}/last_operation", style, server.cancelLastopResourceInstance)
+	r.handle(http.MethodGet, "/v2/resource_keys", style, server.listResourceKeys)
+	r.handle(http.MethodPost, "/v2/resource_keys", style, server.createResourceKey)
+	r.handle(http.MethodGet, "/v2/resource_keys/{id}", style, server.getResourceKey)
+	r.handle(http.MethodPatch, "/v2/resource_keys/{id}", style, server.updateResourceKey)
+	r.handle(http.MethodDelete, "/v2/resource_keys/{id}", style, server.deleteResourceKey)
+	r.handle(http.MethodGet, "/v2/resource_aliases", style, server.listResourceAliases)
+	r.handle(http.MethodPost, "/v2/resource_aliases", style, server.createResourceAlias)
+	r.handle(http.MethodGet, "/v2/resource_aliases/{id}", style, server.getResourceAlias)
+	r.handle(http.MethodPatch, "/v2/resource_aliases/{id}", style, server.updateResourceAlias)
+	r.handle(http.MethodDelete, "/v2/resource_aliases/{id}", style, server.deleteResourceAlias)
+	r.handle(http.MethodGet, "/v2/resource_bindings", style, server.listResourceBindings)
+	r.handle(http.MethodGet, "/v1/reclamations", style, server.listReclamations)
+	r.handle(http.MethodPost, "/v1/reclamations/{id}/actions/{action_name}", style, server.runReclamationAction)
+}
+
+//
+// Resource instances
+//
+
+// findResourceInstance returns the instance whose GUID or CRN is "id".
+func (server *Server) findResourceInstance(id string) *resourceInstanceRecord {
+	for _, record := range server.resourceInstances {
+		if *record.instance.GUID == id || *record.instance.CRN == id {
+			return record
+		}
+	}
+	return nil
+}
+
+// lookupResourceInstance returns the instance identified by the "id" path parameter,
+// or writes a 404 response and returns nil.
+func (c *call) lookupResourceInstance() *resourceInstanceRecord {
+	id := c.pathParam("id")
+	record := c.server.findResourceInstance(id)
+	if record == nil {
+		c.notFound("resource instance", id)
+	}
+	return record
+}
+
+// planFor returns the registered plan with the specified ID, or a plan of the default fake service.
+func (server *Server) planFor(planID string) Plan {
+	if plan, ok := server.plans[planID]; ok {
+		return plan
+	}
+	return Plan{
+		ServiceName: "fake-service",
+		ResourceID:  "fake-service-id",
+	}
+}
+
+// startOperation records a new last operation on the instance. The operation completes
+// immediately unless ServerOptions.ProvisioningPolls is set.
+func (server *Server) startOperation(record *resourceInstanceRecord, operationType string, description string, inProgressState string) {
+	async := server.provisioningPolls > 0
+	record.instance.LastOperation = &resourcecontrollerv2.ResourceInstanceLastOperation{
+		Type:        core.StringPtr(operationType),
+		State:       core.StringPtr(operationStateInProgress),
+		Async:       core.BoolPtr(async),
+		Description: core.StringPtr(description),
+		Cancelable:  core.BoolPtr(async),
+		Poll:        core.BoolPtr(async),
+	}
+	record.pendingPolls = server.provisioningPolls
+	if async {
+		record.instance.State = core.StringPtr(inProgressState)
+	} else {
+		server.completeOperation(record)
+	}
+}
+
+// completeOperation completes the in-progress last operation of the instance successfully.
+func (server *Server) completeOperation(record *resourceInstanceRecord) {
+	instance := &record.instance
+	lastOperation := *instance.LastOperation
+	lastOperation.State = core.StringPtr(operationStateSucceeded)
+	lastOperation.Cancelable = core.BoolPtr(false)
+	lastOperation.Poll = core.BoolPtr(false)
+	record.pendingPolls = 0
+
+	switch *lastOperation.Type {
+	case "create":
+		lastOperation.Description = core.StringPtr("Completed create instance operation")
+		instance.State = core.StringPtr(instanceStateActive)
+	case "update":
+		lastOperation.Description = core.StringPtr("Completed update instance operation")
+		instance.State = core.StringPtr(instanceStateActive)
+	case "delete":
+		lastOperation.Description = core.StringPtr("Completed delete instance operation")
+		server.removeResourceInstance(record)
+	}
+	instance.LastOperation = &lastOperation
+	instance.UpdatedAt = server.timestamp()
+}
+
+// poll advances the in-progress last operation of the instance by one poll.
+func (server *Server) poll(record *resourceInstanceRecord) {
+	if record.pendingPolls <= 0 {
+		return
+	}
+	record.pendingPolls--
+	if record.pendingPolls == 0 {
+		server.completeOperation(record)
+	}
+}
+
+// inProgress returns true if the instance has a last operation that has not yet completed.
+func (record *resourceInstanceRecord) inProgress() bool {
+	lastOperation := record.instance.LastOperation
+	return lastOperation != nil && *lastOperation.State == operationStateInProgress
+}
+
+// removeResourceInstance moves the instance to the "removed" state.
+func (server *Server) removeResourceInstance(record *resourceInstanceRecord) {
+	record.instance.State = core.StringPtr(instanceStateRemoved)
+	record.instance.DeletedAt = server.timestamp()
+	record.instance.DeletedBy = core.StringPtr(fakeUserID)
+	record.instance.ScheduledReclaimAt = nil
+	record.instance.ScheduledReclaimBy = nil
+}
+
+func (server *Server) listResourceInstances(c *call) {
+	limit, ok := c.queryInt64("limit", resourceControllerDefaultLimit)
+	if !ok {
+		return
+	}
+	if limit > resourceControllerMaxLimit {
+		limit = resourceControllerMaxLimit
+	}
+	offset, ok := c.queryInt64("start", 0)
+	if !ok {
+		return
+	}
+
+	filters := map[string]func(*resourcecontrollerv2.ResourceInstance) *string{
+		"guid":              func(i *resourcecontrollerv2.ResourceInstance) *string { return i.GUID },
+		"name":              func(i *resourcecontrollerv2.ResourceInstance) *string { return i.Name },
+		"resource_group_id": func(i *resourcecontrollerv2.ResourceInstance) *string { return i.ResourceGroupID },
+		"resource_id":       func(i *resourcecontrollerv2.ResourceInstance) *string { return i.ResourceID },
+		"resource_plan_id":  func(i *resourcecontrollerv2.ResourceInstance) *string { return i.ResourcePlanID },
+		"type":              func(i *resourcecontrollerv2.ResourceInstance) *string { return i.Type },
+		"sub_type":          func(i *resourcecontrollerv2.ResourceInstance) *string { return i.SubType },
+	}
+	state := c.query("state")
+
+	var matches []resourcecontrollerv2.ResourceInstance
+	for _, record := range server.resourceInstances {
+		instance := record.instance
+		if state == "" && *instance.State != instanceStateActive && *instance.State != instanceStateProvisioning {
+			// Like the real API, only active and provisioning instances are listed by default.
+			continue
+		}
+		if state != "" && *instance.State != state {
+			continue
+		}
+		if !matchesFilters(c, filters, &instance) {
+			continue
+		}
+		matches = append(matches, instance)
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	result := &resourcecontrollerv2.ResourceInstancesList{
+		RowsCount: core.Int64Ptr(int64(end - start)),
+		Resources: append([]resourcecontrollerv2.ResourceInstance{}, matches[start:end]...),
+	}
+	if end < len(matches) {
+		result.NextURL = core.StringPtr(nextURL(c, "start", strconv.Itoa(end)))
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+// matchesFilters returns true if "model" matches each filter whose query parameter is present.
+func matchesFilters[M any](c *call, filters map[string]func(*M) *string, model *M) bool {
+	for queryParam, field := range filters {
+		want := c.query(queryParam)
+		if want == "" {
+			continue
+		}
+		if got := field(model); got == nil || *got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// nextURL returns the relative URL of the request with the query parameter "name" set to "value".
+func nextURL(c *call, name string, value string) string {
+	query := c.req.URL.Query()
+	query.Set(name, value)
+	return c.req.URL.Path + "?" + query.Encode()
+}
+
+func (server *Server) createResourceInstance(c *call) {
+	var body struct {
+		Name           *string                `json:"name"`
+		Target         *string                `json:"target"`
+		ResourceGroup  *string                `json:"resource_group"`
+		ResourcePlanID *string                `json:"resource_plan_id"`
+		Tags           []string               `json:"tags"`
+		AllowCleanup   *bool                  `json:"allow_cleanup"`
+		Parameters     map[string]interface{} `json:"parameters"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	for property, value := range map[string]*string{
+		"name":             body.Name,
+		"target":           body.Target,
+		"resource_group":   body.ResourceGroup,
+		"resource_plan_id": body.ResourcePlanID,
+	} {
+		if value == nil || *value == "" {
+			c.badRequest(fmt.Sprintf("The '%s' property is required.", property))
+			return
+		}
+	}
+	group := server.findResourceGroup(*body.ResourceGroup)
+	if group == nil {
+		c.badRequest(fmt.Sprintf("The resource group '%s' was not found.", *body.ResourceGroup))
+		return
+	}
+
+	plan := server.planFor(*body.ResourcePlanID)
+	guid := server.nextID()
+	crn := fmt.Sprintf("crn:v1:bluemix:public:%s:%s:a/%s:%s::", plan.ServiceName, *body.Target, server.accountID, guid)
+	path := "/v2/resource_instances/" + guid
+	now := server.timestamp()
+
+	record := &resourceInstanceRecord{
+		instance: resourcecontrollerv2.ResourceInstance{
+			ID:                  core.StringPtr(crn),
+			GUID:                core.StringPtr(guid),
+			URL:                 core.StringPtr(path),
+			CreatedAt:           now,
+			UpdatedAt:           now,
+			CreatedBy:           core.StringPtr(fakeUserID),
+			UpdatedBy:           core.StringPtr(fakeUserID),
+			Name:                body.Name,
+			RegionID:            body.Target,
+			AccountID:           core.StringPtr(server.accountID),
+			ResourcePlanID:      body.ResourcePlanID,
+			ResourceGroupID:     group.group.ID,
+			ResourceGroupCRN:    group.group.CRN,
+			TargetCRN:           core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:globalcatalog::::deployment:%s-%s", *body.ResourcePlanID, *body.Target)),
+			OnetimeCredentials:  core.BoolPtr(false),
+			Parameters:          body.Parameters,
+			AllowCleanup:        core.BoolPtr(body.AllowCleanup != nil && *body.AllowCleanup),
+			CRN:                 core.StringPtr(crn),
+			Type:                core.StringPtr("service_instance"),
+			ResourceID:          core.StringPtr(plan.ResourceID),
+			ResourceAliasesURL:  core.StringPtr(path + "/resource_aliases"),
+			ResourceBindingsURL: core.StringPtr(path + "/resource_bindings"),
+			ResourceKeysURL:     core.StringPtr(path + "/resource_keys"),
+			PlanHistory: []resourcecontrollerv2.PlanHistoryItem{
+				{
+					ResourcePlanID: body.ResourcePlanID,
+					StartDate:      now,
+					RequestorID:    core.StringPtr(fakeUserID),
+				},
+			},
+			Migrated: core.BoolPtr(false),
+			Locked:   core.BoolPtr(strings.EqualFold(c.req.Header.Get("Entity-Lock"), "true")),
+		},
+	}
+	server.resourceInstances = append(server.resourceInstances, record)
+	server.startOperation(record, "create", "Started create instance operation", instanceStateProvisioning)
+
+	for _, tagName := range body.Tags {
+		server.attachTag(tagTypeUser, crn, tagName)
+	}
+
+	statusCode := http.StatusCreated
+	if record.inProgress() {
+		statusCode = http.StatusAccepted
+	}
+	c.writeJSON(statusCode, record.instance)
+}
+
+func (server *Server) getResourceInstance(c *call) {
+	record := c.lookupResourceInstance()
+	if record == nil {
+		return
+	}
+	server.poll(record)
+	c.writeJSON(http.StatusOK, record.instance)
+}
+
+// checkInstanceMutable writes an error response and returns false if the instance cannot be
+// changed because it is removed, locked or has an operation in progress.
+func (c *call) checkInstanceMutable(record *resourceInstanceRecord) bool {
+	switch {
+	case *record.instance.State == instanceStateRemoved:
+		c.writeError(http.StatusGone, "gone", fmt.Sprintf("The resource instance '%s' has been removed.", *record.instance.GUID))
+	case *record.instance.State == instanceStatePendingReclamation:
+		c.conflict(fmt.Sprintf("The resource instance '%s' is pending reclamation.", *record.instance.GUID))
+	case *record.instance.Locked:
+		c.writeError(http.StatusUnprocessableEntity, "locked", fmt.Sprintf("The resource instance '%s' is locked.", *record.instance.GUID))
+	case record.inProgress():
+		c.conflict(fmt.Sprintf("An operation is in progress for the resource instance '%s'.", *record.instance.GUID))
+	default:
+		return true
+	}
+	return false
+}
+
+func (server *Server) updateResourceInstance(c *call) {
+	record := c.lookupResourceInstance()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Name           *string                `json:"name"`
+		Parameters     map[string]interface{} `json:"parameters"`
+		ResourcePlanID *string                `json:"resource_plan_id"`
+		AllowCleanup   *bool                  `json:"allow_cleanup"`
+	}
+	if !c.decodeBody(&body) || !c.checkInstanceMutable(record) {
+		return
+	}
+
+	instance := &record.instance
+	if body.Name != nil {
+		instance.Name = body.Name
+	}
+	if body.Parameters != nil {
+		parameters := make(map[string]interface{})
+		for k, v := range instance.Parameters {
+			parameters[k] = v
+		}
+		for k, v := range body.Parameters {
+			parameters[k] = v
+		}
+		instance.Parameters = parameters
+	}
+	if body.AllowCleanup != nil {
+		instance.AllowCleanup = body.AllowCleanup
+	}
+	if body.ResourcePlanID != nil && *body.ResourcePlanID != *instance.ResourcePlanID {
+		instance.ResourcePlanID = body.ResourcePlanID
+		instance.PlanHistory = append(append([]resourcecontrollerv2.PlanHistoryItem{}, instance.PlanHistory...),
+			resourcecontrollerv2.PlanHistoryItem{
+				ResourcePlanID: body.ResourcePlanID,
+				StartDate:      server.timestamp(),
+				RequestorID:    core.StringPtr(fakeUserID),
+			})
+	}
+	instance.UpdatedAt = server.timestamp()
+	instance.UpdatedBy = core.StringPtr(fakeUserID)
+	server.startOperation(record, "update", "Started update instance operation", *instance.State)
+
+	c.writeJSON(http.StatusOK, record.instance)
+}
+
+func (server *Server) deleteResourceInstance(c *call) {
+	record := c.lookupResourceInstance()
+	if record == nil || !c.checkInstanceMutable(record) {
+		return
+	}
+
+	var keys []*resourceKeyRecord
+	for _, key := range server.resourceKeys {
+		if *key.key.SourceCRN == *record.instance.CRN && *key.key.State != instanceStateRemoved {
+			keys = append(keys, key)
+		}
+	}
+	aliases := server.activeResourceAliases(*record.instance.GUID)
+	if len(keys)+len(aliases) > 0 && c.query("recursive") != "true" {
+		c.badRequest(fmt.Sprintf("The resource instance '%s' has resource keys or aliases; use the 'recursive' option to delete them.",
+			*record.instance.GUID))
+		return
+	}
+	for _, key := range keys {
+		server.removeResourceKey(key)
+	}
+	for _, alias := range aliases {
+		server.removeResourceAlias(alias)
+	}
+
+	instance := &record.instance
+	if server.reclamationEnabled {
+		reclaimAt := strfmt.DateTime(server.now().UTC().Add(reclamationRetention))
+		instance.State = core.StringPtr(instanceStatePendingReclamation)
+		instance.ScheduledReclaimAt = &reclaimAt
+		instance.ScheduledReclaimBy = core.StringPtr(fakeUserID)
+		instance.LastOperation = &resourcecontrollerv2.ResourceInstanceLastOperation{
+			Type:        core.StringPtr("reclamation"),
+			SubType:     core.StringPtr("delete"),
+			State:       core.StringPtr(operationStateSucceeded),
+			Async:       core.BoolPtr(false),
+			Description: core.StringPtr("Instance is pending reclamation"),
+			Cancelable:  core.BoolPtr(false),
+			Poll:        core.BoolPtr(false),
+		}
+		server.newReclamation(record, reclaimAt)
+		c.writeNoContent()
+		return
+	}
+
+	server.startOperation(record, "delete", "Started delete instance operation", instanceStatePendingRemoval)
+	if record.inProgress() {
+		c.res.WriteHeader(http.StatusAccepted)
+		return
+	}
+	c.writeNoContent()
+}
+
+func (server *Server) lockResourceInstance(c *call) {
+	server.setResourceInstanceLock(c, true)
+}
+
+func (server *Server) unlockResourceInstance(c *call) {
+	server.setResourceInstanceLock(c, false)
+}
+
+func (server *Server) setResourceInstanceLock(c *call, locked bool) {
+	record := c.lookupResourceInstance()
+	if record == nil {
+		return
+	}
+	if *record.instance.State == instanceStateRemoved {
+		c.writeError(http.StatusGone, "gone", fmt.Sprintf("The resource instance '%s' has been removed.", *record.instance.GUID))
+		return
+	}
+	record.instance.Locked = core.BoolPtr(locked)
+	record.instance.UpdatedAt = server.timestamp()
+	c.writeJSON(http.StatusOK, record.instance)
+}
+
+func (server *Server) cancelLastopResourceInstance(c *call) {
+	record := c.lookupResourceInstance()
+	if record == nil {
+		return
+	}
+	lastOperation := record.instance.LastOperation
+	if !record.inProgress() || !*lastOperation.Cancelable {
+		c.writeError(http.StatusUnprocessableEntity, "not_cancelable",
+			fmt.Sprintf("The resource instance '%s' has no cancelable operation in progress.", *record.instance.GUID))
+		return
+	}
+
+	server.failOperation(record, "The operation was canceled")
+	c.writeJSON(http.StatusOK, record.instance)
+}
+
+// FailResourceInstanceOperation makes the in-progress last operation of the resource instance
+// whose GUID or CRN is "id" fail with the specified description, as if the service broker had
+// reported a failure. A failed create leaves the instance in the "failed" state; other failed
+// operations leave it "active".
+// It returns false if the instance does not exist or has no operation in progress.
+func (server *Server) FailResourceInstanceOperation(id string, description string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	record := server.findResourceInstance(id)
+	if record == nil || !record.inProgress() {
+		return false
+	}
+	server.failOperation(record, description)
+	return true
+}
+
+// DeactivateResourceInstance moves the resource instance whose GUID or CRN is "id" to the
+// "inactive" state, as if the account had been suspended.
+// It returns false if the instance does not exist or is not active.
+func (server *Server) DeactivateResourceInstance(id string) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	record := server.findResourceInstance(id)
+	if record == nil || *record.instance.State != instanceStateActive {
+		return false
+	}
+	record.instance.State = core.StringPtr(instanceStateInactive)
+	record.instance.UpdatedAt = server.timestamp()
+	return true
+}
+
+// failOperation completes the in-progress last operation of the instance unsuccessfully.
+func (server *Server) failOperation(record *resourceInstanceRecord, description string) {
+	failed := *record.instance.LastOperation
+	failed.State = core.StringPtr(operationStateFailed)
+	failed.Description = core.StringPtr(description)
+	failed.Cancelable = core.BoolPtr(false)
+	failed.Poll = core.BoolPtr(false)
+	record.instance.LastOperation = &failed
+	record.pendingPolls = 0
+	if *failed.Type == "create" {
+		record.instance.State = core.StringPtr(instanceStateFailed)
+	} else {
+		record.instance.State = core.StringPtr(instanceStateActive)
+	}
+	record.instance.UpdatedAt = server.timestamp()
+}
+
+//
+// Resource keys
+//
+
+// findResourceKey returns the key whose GUID or CRN is "id".
+func (server *Server) findResourceKey(id string) *resourceKeyRecord {
+	for _, record := range server.resourceKeys {
+		if *record.key.GUID == id || *record.key.CRN == id {
+			return record
+		}
+	}
+	return nil
+}
+
+func (c *call) lookupResourceKey() *resourceKeyRecord {
+	id := c.pathParam("id")
+	record := c.server.findResourceKey(id)
+	if record == nil {
+		c.notFound("resource key", id)
+	}
+	return record
+}
+
+// removeResourceKey moves the key to the "removed" state.
+func (server *Server) removeResourceKey(record *resourceKeyRecord) {
+	record.key.State = core.StringPtr(instanceStateRemoved)
+	record.key.DeletedAt = server.timestamp()
+	record.key.DeletedBy = core.StringPtr(fakeUserID)
+}
+
+// listResourceBindings lists the resource bindings of the account. The fake does not support
+// the applications that resource bindings connect to, so the list is always empty.
+func (server *Server) listResourceBindings(c *call) {
+	c.writeJSON(http.StatusOK, &resourcecontrollerv2.ResourceBindingsList{
+		RowsCount: core.Int64Ptr(0),
+		Resources: []resourcecontrollerv2.ResourceBinding{},
+	})
+}
+
+func (server *Server) listResourceKeys(c *call) {
+	server.writeResourceKeys(c, "")
+}
+
+func (server *Server) listResourceKeysForInstance(c *call) {
+	record := c.lookupResourceInstance()
+	if record == nil {
+		return
+	}
+	server.writeResourceKeys(c, *record.instance.CRN)
+}
+
+// writeResourceKeys writes a page of the active keys that match the request's query parameters
+// and, if "sourceCRN" is not empty, belong to that source.
+func (server *Server) writeResourceKeys(c *call, sourceCRN string) {
+	limit, ok := c.queryInt64("limit", resourceControllerDefaultLimit)
+	if !ok {
+		return
+	}
+	if limit > resourceControllerMaxLimit {
+		limit = resourceControllerMaxLimit
+	}
+	offset, ok := c.queryInt64("start", 0)
+	if !ok {
+		return
+	}
+
+	filters := map[string]func(*resourcecontrollerv2.ResourceKey) *string{
+		"guid":              func(k *resourcecontrollerv2.ResourceKey) *string { return k.GUID },
+		"name":              func(k *resourcecontrollerv2.ResourceKey) *string { return k.Name },
+		"resource_group_id": func(k *resourcecontrollerv2.ResourceKey) *string { return k.ResourceGroupID },
+		"resource_id":       func(k *resourcecontrollerv2.ResourceKey) *string { return k.ResourceID },
+	}
+
+	var matches []resourcecontrollerv2.ResourceKey
+	for _, record := range server.resourceKeys {
+		key := record.key
+		if *key.State == instanceStateRemoved || (sourceCRN != "" && *key.SourceCRN != sourceCRN) {
+			continue
+		}
+		if !matchesFilters(c, filters, &key) {
+			continue
+		}
+		matches = append(matches, record.response(false))
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	result := &resourcecontrollerv2.ResourceKeysList{
+		RowsCount: core.Int64Ptr(int64(end - start)),
+		Resources: append([]resourcecontrollerv2.ResourceKey{}, matches[start:end]...),
+	}
+	if end < len(matches) {
+		result.NextURL = core.StringPtr(nextURL(c, "start", strconv.Itoa(end)))
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) createResourceKey(c *call) {
+	var body struct {
+		Name       *string                `json:"name"`
+		Source     *string                `json:"source"`
+		Parameters map[string]interface{} `json:"parameters"`
+		Role       *string                `json:"role"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		c.badRequest("The 'name' property is required.")
+		return
+	}
+	if body.Source == nil || *body.Source == "" {
+		c.badRequest("The 'source' property is required.")
+		return
+	}
+	source := server.findResourceInstance(*body.Source)
+	if source == nil || *source.instance.State == instanceStateRemoved {
+		c.notFound("resource instance", *body.Source)
+		return
+	}
+	if *source.instance.State != instanceStateActive {
+		c.conflict(fmt.Sprintf("The resource instance '%s' is not active.", *source.instance.GUID))
+		return
+	}
+
+	role := "Writer"
+	if body.Role != nil && *body.Role != "" {
+		role = *body.Role
+	}
+	roleCRN := role
+	if !strings.HasPrefix(role, "crn:") {
+		roleCRN = "crn:v1:bluemix:public:iam::::serviceRole:" + role
+	}
+
+	instance := &source.instance
+	guid := server.nextID()
+	keyCRN := crn.MustParse(*instance.CRN)
+	keyCRN.ResourceType = "resource-key"
+	keyCRN.Resource = guid
+	serviceIDCRN := fmt.Sprintf("crn:v1:bluemix:public:iam-identity::a/%s::serviceid:ServiceId-%s", server.accountID, guid)
+	if value, ok := body.Parameters["serviceid_crn"].(string); ok && value != "" {
+		serviceIDCRN = value
+	}
+	now := server.timestamp()
+
+	record := &resourceKeyRecord{
+		key: resourcecontrollerv2.ResourceKey{
+			ID:                 core.StringPtr(keyCRN.String()),
+			GUID:               core.StringPtr(guid),
+			URL:                core.StringPtr("/v2/resource_keys/" + guid),
+			CreatedAt:          now,
+			UpdatedAt:          now,
+			CreatedBy:          core.StringPtr(fakeUserID),
+			UpdatedBy:          core.StringPtr(fakeUserID),
+			SourceCRN:          instance.CRN,
+			Name:               body.Name,
+			CRN:                core.StringPtr(keyCRN.String()),
+			State:              core.StringPtr(instanceStateActive),
+			AccountID:          core.StringPtr(server.accountID),
+			ResourceGroupID:    instance.ResourceGroupID,
+			ResourceID:         instance.ResourceID,
+			OnetimeCredentials: core.BoolPtr(server.onetimeCredentials),
+			Credentials: &resourcecontrollerv2.Credentials{
+				Apikey:               core.StringPtr("fake-apikey-" + guid),
+				IamApikeyDescription: core.StringPtr(fmt.Sprintf("Auto-generated for key %s", keyCRN.String())),
+				IamApikeyName:        body.Name,
+				IamRoleCRN:           core.StringPtr(roleCRN),
+				IamServiceidCRN:      core.StringPtr(serviceIDCRN),
+			},
+			IamCompatible:       core.BoolPtr(true),
+			Migrated:            core.BoolPtr(false),
+			ResourceInstanceURL: instance.URL,
+		},
+	}
+	server.resourceKeys = append(server.resourceKeys, record)
+	c.writeJSON(http.StatusCreated, record.response(true))
+}
+
+func (server *Server) getResourceKey(c *call) {
+	record := c.lookupResourceKey()
+	if record == nil {
+		return
+	}
+	c.writeJSON(http.StatusOK, record.response(false))
+}
+
+func (server *Server) updateResourceKey(c *call) {
+	record := c.lookupResourceKey()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Name *string `json:"name"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		c.badRequest("The 'name' property is required.")
+		return
+	}
+	if *record.key.State == instanceStateRemoved {
+		c.writeError(http.StatusGone, "gone", fmt.Sprintf("The resource key '%s' has been removed.", *record.key.GUID))
+		return
+	}
+	record.key.Name = body.Name
+	record.key.UpdatedAt = server.timestamp()
+	c.writeJSON(http.StatusOK, record.response(false))
+}
+
+func (server *Server) deleteResourceKey(c *call) {
+	record := c.lookupResourceKey()
+	if record == nil {
+		return
+	}
+	if *record.key.State == instanceStateRemoved {
+		c.writeError(http.StatusGone, "gone", fmt.Sprintf("The resource key '%s' has been removed.", *record.key.GUID))
+		return
+	}
+	server.removeResourceKey(record)
+	c.writeNoContent()
+}
+
+//
+// Resource aliases
+//
+
+// findResourceAlias returns the alias whose GUID or CRN is "id".
+func (server *Server) findResourceAlias(id string) *resourceAliasRecord {
+	for _, record := range server.resourceAliases {
+		if *record.alias.GUID == id || *record.alias.CRN == id {
+			return record
+		}
+	}
+	return nil
+}
+
+func (c *call) lookupResourceAlias() *resourceAliasRecord {
+	id := c.pathParam("id")
+	record := c.server.findResourceAlias(id)
+	if record == nil {
+		c.notFound("resource alias", id)
+	}
+	return record
+}
+
+// activeResourceAliases returns the aliases of the instance with the specified GUID that have not been removed.
+func (server *Server) activeResourceAliases(instanceGUID string) []*resourceAliasRecord {
+	var aliases []*resourceAliasRecord
+	for _, record := range server.resourceAliases {
+		if *record.alias.ResourceInstanceID == instanceGUID && *record.alias.State != instanceStateRemoved {
+			aliases = append(aliases, record)
+		}
+	}
+	return aliases
+}
+
+// removeResourceAlias moves the alias to the "removed" state.
+func (server *Server) removeResourceAlias(record *resourceAliasRecord) {
+	record.alias.State = core.StringPtr(instanceStateRemoved)
+	record.alias.DeletedAt = server.timestamp()
+	record.alias.DeletedBy = core.StringPtr(fakeUserID)
+}
+
+func (server *Server) listResourceAliases(c *call) {
+	server.writeResourceAliases(c, "")
+}
+
+func (server *Server) listResourceAliasesForInstance(c *call) {
+	record := c.lookupResourceInstance()
+	if record == nil {
+		return
+	}
+	server.writeResourceAliases(c, *record.instance.GUID)
+}
+
+// writeResourceAliases writes a page of the active aliases that match the request's query
+// parameters and, if "instanceGUID" is not empty, belong to that instance.
+func (server *Server) writeResourceAliases(c *call, instanceGUID string) {
+	limit, ok := c.queryInt64("limit", resourceControllerDefaultLimit)
+	if !ok {
+		return
+	}
+	if limit > resourceControllerMaxLimit {
+		limit = resourceControllerMaxLimit
+	}
+	offset, ok := c.queryInt64("start", 0)
+	if !ok {
+		return
+	}
+
+	filters := map[string]func(*resourcecontrollerv2.ResourceAlias) *string{
+		"guid":                 func(a *resourcecontrollerv2.ResourceAlias) *string { return a.GUID },
+		"name":                 func(a *resourcecontrollerv2.ResourceAlias) *string { return a.Name },
+		"resource_instance_id": func(a *resourcecontrollerv2.ResourceAlias) *string { return a.ResourceInstanceID },
+		"resource_group_id":    func(a *resourcecontrollerv2.ResourceAlias) *string { return a.ResourceGroupID },
+		"resource_id":          func(a *resourcecontrollerv2.ResourceAlias) *string { return a.ResourceID },
+	}
+
+	var matches []resourcecontrollerv2.ResourceAlias
+	for _, record := range server.resourceAliases {
+		alias := record.alias
+		if *alias.State == instanceStateRemoved || (instanceGUID != "" && *alias.ResourceInstanceID != instanceGUID) {
+			continue
+		}
+		if !matchesFilters(c, filters, &alias) {
+			continue
+		}
+		matches = append(matches, alias)
+	}
+
+	start, end := paginate(len(matches), offset, limit)
+	result := &resourcecontrollerv2.ResourceAliasesList{
+		RowsCount: core.Int64Ptr(int64(end - start)),
+		Resources: append([]resourcecontrollerv2.ResourceAlias{}, matches[start:end]...),
+	}
+	if end < len(matches) {
+		result.NextURL = core.StringPtr(nextURL(c, "start", strconv.Itoa(end)))
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) createResourceAlias(c *call) {
+	var body struct {
+		Name   *string `json:"name"`
+		Source *string `json:"source"`
+		Target *string `json:"target"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	for property, value := range map[string]*string{
+		"name":   body.Name,
+		"source": body.Source,
+		"target": body.Target,
+	} {
+		if value == nil || *value == "" {
+			c.badRequest(fmt.Sprintf("The '%s' property is required.", property))
+			return
+		}
+	}
+	if !crn.IsValid(*body.Target) {
+		c.badRequest(fmt.Sprintf("The target '%s' is not a valid CRN.", *body.Target))
+		return
+	}
+	source := server.findResourceInstance(*body.Source)
+	if source == nil || *source.instance.State == instanceStateRemoved {
+		c.notFound("resource instance", *body.Source)
+		return
+	}
+	if *source.instance.State != instanceStateActive {
+		c.conflict(fmt.Sprintf("The resource instance '%s' is not active.", *source.instance.GUID))
+		return
+	}
+
+	instance := &source.instance
+	guid := server.nextID()
+	aliasCRN := crn.MustParse(*instance.CRN)
+	aliasCRN.ResourceType = "resource-alias"
+	aliasCRN.Resource = guid
+	now := server.timestamp()
+
+	record := &resourceAliasRecord{
+		alias: resourcecontrollerv2.ResourceAlias{
+			ID:                  core.StringPtr(aliasCRN.String()),
+			GUID:                core.StringPtr(guid),
+			URL:                 core.StringPtr("/v2/resource_aliases/" + guid),
+			CreatedAt:           now,
+			UpdatedAt:           now,
+			CreatedBy:           core.StringPtr(fakeUserID),
+			UpdatedBy:           core.StringPtr(fakeUserID),
+			Name:                body.Name,
+			ResourceInstanceID:  instance.GUID,
+			TargetCRN:           body.Target,
+			AccountID:           core.StringPtr(server.accountID),
+			ResourceID:          instance.ResourceID,
+			ResourceGroupID:     instance.ResourceGroupID,
+			CRN:                 core.StringPtr(aliasCRN.String()),
+			RegionInstanceID:    instance.GUID,
+			RegionInstanceCRN:   instance.CRN,
+			State:               core.StringPtr(instanceStateActive),
+			Migrated:            core.BoolPtr(false),
+			ResourceInstanceURL: instance.URL,
+			ResourceBindingsURL: core.StringPtr("/v2/resource_aliases/" + guid + "/resource_bindings"),
+			ResourceKeysURL:     core.StringPtr("/v2/resource_aliases/" + guid + "/resource_keys"),
+		},
+	}
+	server.resourceAliases = append(server.resourceAliases, record)
+	c.writeJSON(http.StatusCreated, record.alias)
+}
+
+func (server *Server) getResourceAlias(c *call) {
+	record := c.lookupResourceAlias()
+	if record == nil {
+		return
+	}
+	c.writeJSON(http.StatusOK, record.alias)
+}
+
+func (server *Server) updateResourceAlias(c *call) {
+	record := c.lookupResourceAlias()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Name *string `json:"name"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		c.badRequest("The 'name' property is required.")
+		return
+	}
+	if *record.alias.State == instanceStateRemoved {
+		c.writeError(http.StatusGone, "gone", fmt.Sprintf("The resource alias '%s' has been removed.", *record.alias.GUID))
+		return
+	}
+	record.alias.Name = body.Name
+	record.alias.UpdatedAt = server.timestamp()
+	c.writeJSON(http.StatusOK, record.alias)
+}
+
+func (server *Server) deleteResourceAlias(c *call) {
+	record := c.lookupResourceAlias()
+	if record == nil {
+		return
+	}
+	if *record.alias.State == instanceStateRemoved {
+		c.writeError(http.StatusGone, "gone", fmt.Sprintf("The resource alias '%s' has been removed.", *record.alias.GUID))
+		return
+	}
+	server.removeResourceAlias(record)
+	c.writeNoContent()
+}
+
+//
+// Reclamations
+//
+
+// newReclamation creates a scheduled reclamation for the instance.
+func (server *Server) newReclamation(record *resourceInstanceRecord, targetTime strfmt.DateTime) {
+	instance := &record.instance
+	now := server.timestamp()
+	server.reclamations = append(server.reclamations, &reclamationRecord{
+		reclamation: resourcecontrollerv2.Reclamation{
+			ID:                 core.StringPtr(server.nextID()),
+			EntityID:           instance.GUID,
+			EntityTypeID:       core.StringPtr("service_instance"),
+			EntityCRN:          instance.CRN,
+			ResourceInstanceID: instance.GUID,
+			ResourceGroupID:    instance.ResourceGroupID,
+			AccountID:          core.StringPtr(server.accountID),
+			PolicyID:           core.StringPtr("fake-reclamation-policy"),
+			State:              core.StringPtr("SCHEDULED"),
+			TargetTime:         core.StringPtr(targetTime.String()),
+			CreatedAt:          now,
+			CreatedBy:          core.StringPtr(fakeUserID),
+			UpdatedAt:          now,
+			UpdatedBy:          core.StringPtr(fakeUserID),
+		},
+	})
+}
+
+func (server *Server) listReclamations(c *call) {
+	filters := map[string]func(*resourcecontrollerv2.Reclamation) *string{
+		"account_id":           func(r *resourcecontrollerv2.Reclamation) *string { return r.AccountID },
+		"resource_instance_id": func(r *resourcecontrollerv2.Reclamation) *string { return r.ResourceInstanceID },
+		"resource_group_id":    func(r *resourcecontrollerv2.Reclamation) *string { return r.ResourceGroupID },
+	}
+
+	result := &resourcecontrollerv2.ReclamationsList{
+		Resources: []resourcecontrollerv2.Reclamation{},
+	}
+	for _, record := range server.reclamations {
+		reclamation := record.reclamation
+		if matchesFilters(c, filters, &reclamation) {
+			result.Resources = append(result.Resources, reclamation)
+		}
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) runReclamationAction(c *call) {
+	id := c.pathParam("id")
+	index := -1
+	for i, record := range server.reclamations {
+		if *record.reclamation.ID == id {
+			index = i
+		}
+	}
+	if index < 0 {
+		c.notFound("reclamation", id)
+		return
+	}
+	reclamation := server.reclamations[index].reclamation
+	instance := server.findResourceInstance(*reclamation.ResourceInstanceID)
+
+	actionName := c.pathParam("action_name")
+	switch actionName {
+	case "restore":
+		instance.instance.State = core.StringPtr(instanceStateActive)
+		instance.instance.RestoredAt = server.timestamp()
+		instance.instance.RestoredBy = core.StringPtr(fakeUserID)
+		instance.instance.ScheduledReclaimAt = nil
+		instance.instance.ScheduledReclaimBy = nil
+		instance.instance.LastOperation = &resourcecontrollerv2.ResourceInstanceLastOperation{
+			Type:        core.StringPtr("reclamation"),
+			SubType:     core.StringPtr("restore"),
+			State:       core.StringPtr(operationStateSucceeded),
+			Async:       core.BoolPtr(false),
+			Description: core.StringPtr("Instance was restored"),
+			Cancelable:  core.BoolPtr(false),
+			Poll:        core.BoolPtr(false),
+		}
+		reclamation.State = core.StringPtr("RESTORING")
+	case "reclaim":
+		server.removeResourceInstance(instance)
+		reclamation.State = core.StringPtr("RECLAIMING")
+	default:
+		c.badRequest(fmt.Sprintf("The reclamation action '%s' is not valid; use 'restore' or 'reclaim'.", actionName))
+		return
+	}
+	reclamation.UpdatedAt = server.timestamp()
+	reclamation.UpdatedBy = core.StringPtr(fakeUserID)
+
+	// The reclamation is complete once its action has been performed.
+	server.reclamations = append(server.reclamations[:index], server.reclamations[index+1:]...)
+	c.writeJSON(http.StatusOK, reclamation)
+}
@@ -0,0 +1,308 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createInstance(t *testing.T, server *platformfake.Server, resourceController *resourcecontrollerv2.ResourceControllerV2, name string) *resourcecontrollerv2.ResourceInstance {
+	createOptions := resourceController.NewCreateResourceInstanceOptions(name, "us-south", server.DefaultResourceGroupID(), "lite-plan")
+	instance, _, err := resourceController.CreateResourceInstance(createOptions)
+	require.Nil(t, err)
+	return instance
+}
+
+func TestResourceInstances(t *testing.T) {
+	server := newServer(t, nil)
+	server.RegisterPlan("lite-plan", platformfake.Plan{ServiceName: "cloud-object-storage", ResourceID: "cos-id"})
+	resourceController := newResourceController(t, server)
+
+	createOptions := resourceController.NewCreateResourceInstanceOptions("my-instance", "global", server.DefaultResourceGroupID(), "lite-plan")
+	createOptions.SetTags([]string{"env:test"})
+	createOptions.SetParameters(map[string]interface{}{"size": "small"})
+	instance, response, err := resourceController.CreateResourceInstance(createOptions)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, "active", *instance.State)
+	assert.Equal(t, "cos-id", *instance.ResourceID)
+	assert.Equal(t, fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:global:a/%s:%s::", server.AccountID(), *instance.GUID), *instance.CRN)
+	assert.Equal(t, "succeeded", *instance.LastOperation.State)
+	assert.Len(t, instance.PlanHistory, 1)
+
+	// Instances can be retrieved by GUID or CRN.
+	for _, id := range []string{*instance.GUID, *instance.CRN} {
+		got, _, err := resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(id))
+		require.Nil(t, err)
+		assert.Equal(t, *instance.GUID, *got.GUID)
+	}
+
+	updateOptions := resourceController.NewUpdateResourceInstanceOptions(*instance.GUID)
+	updateOptions.SetName("renamed")
+	updateOptions.SetResourcePlanID("standard-plan")
+	updateOptions.SetParameters(map[string]interface{}{"tier": "gold"})
+	updated, _, err := resourceController.UpdateResourceInstance(updateOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "renamed", *updated.Name)
+	assert.Equal(t, "standard-plan", *updated.ResourcePlanID)
+	assert.Len(t, updated.PlanHistory, 2)
+	assert.Equal(t, map[string]interface{}{"size": "small", "tier": "gold"}, updated.Parameters)
+
+	// A locked instance cannot be updated or deleted.
+	locked, _, err := resourceController.LockResourceInstance(resourceController.NewLockResourceInstanceOptions(*instance.GUID))
+	require.Nil(t, err)
+	assert.True(t, *locked.Locked)
+	_, response, err = resourceController.UpdateResourceInstance(updateOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+	_, _, err = resourceController.UnlockResourceInstance(resourceController.NewUnlockResourceInstanceOptions(*instance.GUID))
+	require.Nil(t, err)
+
+	// An instance with keys can only be deleted recursively.
+	key, response, err := resourceController.CreateResourceKey(resourceController.NewCreateResourceKeyOptions("my-key", *instance.GUID).SetRole("Manager"))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, *instance.CRN, *key.SourceCRN)
+	assert.Equal(t, "crn:v1:bluemix:public:iam::::serviceRole:Manager", *key.Credentials.IamRoleCRN)
+	assert.NotEmpty(t, *key.Credentials.Apikey)
+
+	deleteOptions := resourceController.NewDeleteResourceInstanceOptions(*instance.GUID)
+	response, err = resourceController.DeleteResourceInstance(deleteOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	response, err = resourceController.DeleteResourceInstance(deleteOptions.SetRecursive(true))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	removed, _, err := resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(*instance.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "removed", *removed.State)
+	removedKey, _, err := resourceController.GetResourceKey(resourceController.NewGetResourceKeyOptions(*key.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "removed", *removedKey.State)
+
+	list, _, err := resourceController.ListResourceInstances(resourceController.NewListResourceInstancesOptions())
+	require.Nil(t, err)
+	assert.Empty(t, list.Resources)
+	list, _, err = resourceController.ListResourceInstances(resourceController.NewListResourceInstancesOptions().SetState("removed"))
+	require.Nil(t, err)
+	assert.Len(t, list.Resources, 1)
+
+	response, err = resourceController.DeleteResourceInstance(deleteOptions)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusGone, response.StatusCode)
+}
+
+func TestResourceInstancesPagination(t *testing.T) {
+	server := newServer(t, nil)
+	resourceController := newResourceController(t, server)
+	for i := 0; i < 5; i++ {
+		createInstance(t, server, resourceController, fmt.Sprintf("instance-%d", i))
+	}
+
+	pager, err := resourceController.NewResourceInstancesPager(resourceController.NewListResourceInstancesOptions().SetLimit(2))
+	require.Nil(t, err)
+	var pages int
+	var names []string
+	for pager.HasNext() {
+		page, err := pager.GetNext()
+		require.Nil(t, err)
+		pages++
+		for _, instance := range page {
+			names = append(names, *instance.Name)
+		}
+	}
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, []string{"instance-0", "instance-1", "instance-2", "instance-3", "instance-4"}, names)
+
+	list, _, err := resourceController.ListResourceInstances(resourceController.NewListResourceInstancesOptions().SetName("instance-3"))
+	require.Nil(t, err)
+	assert.Len(t, list.Resources, 1)
+	assert.Nil(t, list.NextURL)
+}
+
+func TestResourceInstanceAsyncOperations(t *testing.T) {
+	server := newServer(t, &platformfake.ServerOptions{ProvisioningPolls: 2})
+	resourceController := newResourceController(t, server)
+
+	instance := createInstance(t, server, resourceController, "slow")
+	assert.Equal(t, "provisioning", *instance.State)
+	assert.Equal(t, "in progress", *instance.LastOperation.State)
+
+	getOptions := resourceController.NewGetResourceInstanceOptions(*instance.GUID)
+	got, _, err := resourceController.GetResourceInstance(getOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "provisioning", *got.State)
+
+	// An operation cannot be started while another is in progress.
+	response, err := resourceController.DeleteResourceInstance(resourceController.NewDeleteResourceInstanceOptions(*instance.GUID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	got, _, err = resourceController.GetResourceInstance(getOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "active", *got.State)
+	assert.Equal(t, "succeeded", *got.LastOperation.State)
+
+	response, err = resourceController.DeleteResourceInstance(resourceController.NewDeleteResourceInstanceOptions(*instance.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusAccepted, response.StatusCode)
+	got, _, err = resourceController.GetResourceInstance(getOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "pending_removal", *got.State)
+	got, _, err = resourceController.GetResourceInstance(getOptions)
+	require.Nil(t, err)
+	assert.Equal(t, "removed", *got.State)
+
+	// A pending operation can be canceled.
+	canceled := createInstance(t, server, resourceController, "canceled")
+	got, _, err = resourceController.CancelLastopResourceInstance(resourceController.NewCancelLastopResourceInstanceOptions(*canceled.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "failed", *got.State)
+	assert.Equal(t, "failed", *got.LastOperation.State)
+	_, response, err = resourceController.CancelLastopResourceInstance(resourceController.NewCancelLastopResourceInstanceOptions(*canceled.GUID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, response.StatusCode)
+
+	// A failure of the service broker can be simulated.
+	failed := createInstance(t, server, resourceController, "failed")
+	assert.True(t, server.FailResourceInstanceOperation(*failed.GUID, "Broker error"))
+	assert.False(t, server.FailResourceInstanceOperation(*failed.GUID, "Broker error"))
+	assert.False(t, server.FailResourceInstanceOperation("unknown", "Broker error"))
+	got, _, err = resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(*failed.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "failed", *got.State)
+	assert.Equal(t, "Broker error", *got.LastOperation.Description)
+}
+
+func TestResourceInstancesDefaultState(t *testing.T) {
+	server := newServer(t, &platformfake.ServerOptions{ProvisioningPolls: 1})
+	resourceController := newResourceController(t, server)
+
+	provisioning := createInstance(t, server, resourceController, "provisioning")
+	failed := createInstance(t, server, resourceController, "failed")
+	require.True(t, server.FailResourceInstanceOperation(*failed.GUID, "Broker error"))
+	inactive := createInstance(t, server, resourceController, "inactive")
+	assert.False(t, server.DeactivateResourceInstance(*inactive.GUID))
+	_, _, err := resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(*inactive.GUID))
+	require.Nil(t, err)
+	require.True(t, server.DeactivateResourceInstance(*inactive.GUID))
+	assert.False(t, server.DeactivateResourceInstance("unknown"))
+
+	// Only active and provisioning instances are listed when no state is given.
+	list, _, err := resourceController.ListResourceInstances(resourceController.NewListResourceInstancesOptions())
+	require.Nil(t, err)
+	require.Len(t, list.Resources, 1)
+	assert.Equal(t, *provisioning.GUID, *list.Resources[0].GUID)
+	assert.Equal(t, "provisioning", *list.Resources[0].State)
+
+	list, _, err = resourceController.ListResourceInstances(resourceController.NewListResourceInstancesOptions().SetState("failed"))
+	require.Nil(t, err)
+	require.Len(t, list.Resources, 1)
+	assert.Equal(t, *failed.GUID, *list.Resources[0].GUID)
+
+	list, _, err = resourceController.ListResourceInstances(resourceController.NewListResourceInstancesOptions().SetState("inactive"))
+	require.Nil(t, err)
+	require.Len(t, list.Resources, 1)
+	assert.Equal(t, *inactive.GUID, *list.Resources[0].GUID)
+}
+
+func TestReclamations(t *testing.T) {
+	server := newServer(t, &platformfake.ServerOptions{ReclamationEnabled: true})
+	resourceController := newResourceController(t, server)
+
+	restored := createInstance(t, server, resourceController, "restored")
+	reclaimed := createInstance(t, server, resourceController, "reclaimed")
+	for _, instance := range []*resourcecontrollerv2.ResourceInstance{restored, reclaimed} {
+		_, err := resourceController.DeleteResourceInstance(resourceController.NewDeleteResourceInstanceOptions(*instance.GUID))
+		require.Nil(t, err)
+	}
+
+	got, _, err := resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(*restored.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "pending_reclamation", *got.State)
+	assert.NotNil(t, got.ScheduledReclaimAt)
+
+	reclamations, _, err := resourceController.ListReclamations(resourceController.NewListReclamationsOptions().SetAccountID(server.AccountID()))
+	require.Nil(t, err)
+	require.Len(t, reclamations.Resources, 2)
+	assert.Equal(t, "SCHEDULED", *reclamations.Resources[0].State)
+
+	for i, action := range []string{"restore", "reclaim"} {
+		reclamation, _, err := resourceController.RunReclamationAction(
+			resourceController.NewRunReclamationActionOptions(*reclamations.Resources[i].ID, action))
+		require.Nil(t, err)
+		assert.Equal(t, *reclamations.Resources[i].ResourceInstanceID, *reclamation.ResourceInstanceID)
+	}
+
+	got, _, err = resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(*restored.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "active", *got.State)
+	assert.NotNil(t, got.RestoredAt)
+	got, _, err = resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions(*reclaimed.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "removed", *got.State)
+
+	reclamations, _, err = resourceController.ListReclamations(resourceController.NewListReclamationsOptions())
+	require.Nil(t, err)
+	assert.Empty(t, reclamations.Resources)
+}
+
+func TestResourceAliases(t *testing.T) {
+	server := newServer(t, nil)
+	resourceController := newResourceController(t, server)
+	instance := createInstance(t, server, resourceController, "aliased")
+
+	createOptions := resourceController.NewCreateResourceAliasOptions("my-alias", *instance.GUID, "crn:v1:bluemix:public:cf:eu-gb:o/org::cf-space:space")
+	alias, response, err := resourceController.CreateResourceAlias(createOptions)
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, "active", *alias.State)
+	assert.Equal(t, *instance.GUID, *alias.ResourceInstanceID)
+
+	updated, _, err := resourceController.UpdateResourceAlias(resourceController.NewUpdateResourceAliasOptions(*alias.GUID, "renamed"))
+	require.Nil(t, err)
+	assert.Equal(t, "renamed", *updated.Name)
+
+	list, _, err := resourceController.ListResourceAliasesForInstance(resourceController.NewListResourceAliasesForInstanceOptions(*instance.CRN))
+	require.Nil(t, err)
+	require.Len(t, list.Resources, 1)
+	assert.Equal(t, "renamed", *list.Resources[0].Name)
+
+	// An instance with aliases can only be deleted recursively.
+	_, err = resourceController.DeleteResourceInstance(resourceController.NewDeleteResourceInstanceOptions(*instance.GUID))
+	assert.NotNil(t, err)
+	_, err = resourceController.DeleteResourceInstance(resourceController.NewDeleteResourceInstanceOptions(*instance.GUID).SetRecursive(true))
+	require.Nil(t, err)
+	got, _, err := resourceController.GetResourceAlias(resourceController.NewGetResourceAliasOptions(*alias.GUID))
+	require.Nil(t, err)
+	assert.Equal(t, "removed", *got.State)
+	all, _, err := resourceController.ListResourceAliases(resourceController.NewListResourceAliasesOptions())
+	require.Nil(t, err)
+	assert.Empty(t, all.Resources)
+
+	response, err = resourceController.DeleteResourceAlias(resourceController.NewDeleteResourceAliasOptions(*alias.GUID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusGone, response.StatusCode)
+}
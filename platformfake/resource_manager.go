@@ -0,0 +1,240 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+)
+
+// DefaultQuotaID is the ID of the quota definition used by the resource groups of a Server.
+const DefaultQuotaID = "fake-quota-id"
+
+// Resource group states.
+const (
+	resourceGroupStateActive  = "ACTIVE"
+	resourceGroupStateDeleted = "DELETED"
+)
+
+type resourceGroupRecord struct {
+	group resourcemanagerv2.ResourceGroup
+}
+
+func (record *resourceGroupRecord) deleted() bool {
+	return *record.group.State == resourceGroupStateDeleted
+}
+
+func (server *Server) addResourceManagerRoutes() {
+	style := errorStyleResourceController
+	r := server.router
+	r.handle(http.MethodGet, "/v2/resource_groups", style, server.listResourceGroups)
+	r.handle(http.MethodPost, "/v2/resource_groups", style, server.createResourceGroup)
+	r.handle(http.MethodGet, "/v2/resource_groups/{id}", style, server.getResourceGroup)
+	r.handle(http.MethodPatch, "/v2/resource_groups/{id}", style, server.updateResourceGroup)
+	r.handle(http.MethodDelete, "/v2/resource_groups/{id}", style, server.deleteResourceGroup)
+	r.handle(http.MethodGet, "/v2/quota_definitions", style, server.listQuotaDefinitions)
+	r.handle(http.MethodGet, "/v2/quota_definitions/{id}", style, server.getQuotaDefinition)
+}
+
+// newDefaultQuotaDefinition returns the quota definition DefaultQuotaID, which limits
+// the number of service instances but sets no limits for individual services.
+func newDefaultQuotaDefinition() resourcemanagerv2.QuotaDefinition {
+	return resourcemanagerv2.QuotaDefinition{
+		ID:                                  core.StringPtr(DefaultQuotaID),
+		Name:                                core.StringPtr("Trial Quota"),
+		Type:                                core.StringPtr("trial"),
+		NumberOfApps:                        core.Float64Ptr(100),
+		NumberOfServiceInstances:            core.Float64Ptr(100),
+		DefaultNumberOfInstancesPerLitePlan: core.Float64Ptr(1),
+		InstancesPerApp:                     core.Float64Ptr(10),
+		InstanceMemory:                      core.StringPtr("1G"),
+		TotalAppMemory:                      core.StringPtr("2G"),
+		VsiLimit:                            core.Float64Ptr(0),
+		ResourceQuotas:                      []resourcemanagerv2.ResourceQuota{},
+	}
+}
+
+// newResourceGroup creates and returns a new active resource group.
+func (server *Server) newResourceGroup(name string, isDefault bool) *resourceGroupRecord {
+	id := server.nextID()
+	now := server.timestamp()
+	record := &resourceGroupRecord{
+		group: resourcemanagerv2.ResourceGroup{
+			ID:                core.StringPtr(id),
+			CRN:               core.StringPtr(fmt.Sprintf("crn:v1:bluemix:public:resource-controller::a/%s::resource-group:%s", server.accountID, id)),
+			AccountID:         core.StringPtr(server.accountID),
+			Name:              core.StringPtr(name),
+			State:             core.StringPtr(resourceGroupStateActive),
+			Default:           core.BoolPtr(isDefault),
+			QuotaID:           core.StringPtr(DefaultQuotaID),
+			QuotaURL:          core.StringPtr("/v2/quota_definitions/" + DefaultQuotaID),
+			PaymentMethodsURL: core.StringPtr(fmt.Sprintf("/v2/resource_groups/%s/payment_methods", id)),
+			ResourceLinkages:  []interface{}{},
+			TeamsURL:          core.StringPtr(fmt.Sprintf("/v2/resource_groups/%s/teams", id)),
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		},
+	}
+	server.resourceGroups = append(server.resourceGroups, record)
+	return record
+}
+
+// findResourceGroup returns the resource group with the specified ID, or nil if the group
+// does not exist or has been deleted.
+func (server *Server) findResourceGroup(id string) *resourceGroupRecord {
+	for _, record := range server.resourceGroups {
+		if *record.group.ID == id && !record.deleted() {
+			return record
+		}
+	}
+	return nil
+}
+
+func (c *call) lookupResourceGroup() *resourceGroupRecord {
+	id := c.pathParam("id")
+	record := c.server.findResourceGroup(id)
+	if record == nil {
+		c.notFound("resource group", id)
+	}
+	return record
+}
+
+func (server *Server) listResourceGroups(c *call) {
+	filters := map[string]func(*resourcemanagerv2.ResourceGroup) *string{
+		"account_id": func(g *resourcemanagerv2.ResourceGroup) *string { return g.AccountID },
+		"name":       func(g *resourcemanagerv2.ResourceGroup) *string { return g.Name },
+	}
+	isDefault := c.query("default")
+	includeDeleted := c.query("include_deleted") == "true"
+
+	result := &resourcemanagerv2.ResourceGroupList{
+		Resources: []resourcemanagerv2.ResourceGroup{},
+	}
+	for _, record := range server.resourceGroups {
+		group := record.group
+		if record.deleted() && !includeDeleted {
+			continue
+		}
+		if isDefault != "" && fmt.Sprint(*group.Default) != isDefault {
+			continue
+		}
+		if matchesFilters(c, filters, &group) {
+			result.Resources = append(result.Resources, group)
+		}
+	}
+	c.writeJSON(http.StatusOK, result)
+}
+
+func (server *Server) createResourceGroup(c *call) {
+	var body struct {
+		Name      *string `json:"name"`
+		AccountID *string `json:"account_id"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if body.Name == nil || *body.Name == "" {
+		c.badRequest("The 'name' property is required.")
+		return
+	}
+	if body.AccountID != nil && *body.AccountID != server.accountID {
+		c.writeError(http.StatusForbidden, "forbidden", fmt.Sprintf("The account '%s' is not accessible.", *body.AccountID))
+		return
+	}
+	for _, existing := range server.resourceGroups {
+		if *existing.group.Name == *body.Name && !existing.deleted() {
+			c.conflict(fmt.Sprintf("A resource group named '%s' already exists.", *body.Name))
+			return
+		}
+	}
+
+	record := server.newResourceGroup(*body.Name, false)
+	c.writeJSON(http.StatusCreated, &resourcemanagerv2.ResCreateResourceGroup{
+		ID:  record.group.ID,
+		CRN: record.group.CRN,
+	})
+}
+
+func (server *Server) getResourceGroup(c *call) {
+	record := c.lookupResourceGroup()
+	if record == nil {
+		return
+	}
+	c.writeJSON(http.StatusOK, record.group)
+}
+
+func (server *Server) updateResourceGroup(c *call) {
+	record := c.lookupResourceGroup()
+	if record == nil {
+		return
+	}
+	var body struct {
+		Name  *string `json:"name"`
+		State *string `json:"state"`
+	}
+	if !c.decodeBody(&body) {
+		return
+	}
+	if body.Name != nil {
+		record.group.Name = body.Name
+	}
+	if body.State != nil {
+		record.group.State = body.State
+	}
+	record.group.UpdatedAt = server.timestamp()
+	c.writeJSON(http.StatusOK, record.group)
+}
+
+func (server *Server) deleteResourceGroup(c *call) {
+	record := c.lookupResourceGroup()
+	if record == nil {
+		return
+	}
+	if *record.group.Default {
+		c.badRequest(fmt.Sprintf("The default resource group '%s' cannot be deleted.", *record.group.ID))
+		return
+	}
+	for _, instance := range server.resourceInstances {
+		if *instance.instance.ResourceGroupID == *record.group.ID && *instance.instance.State != instanceStateRemoved {
+			c.conflict(fmt.Sprintf("The resource group '%s' is not empty.", *record.group.ID))
+			return
+		}
+	}
+	record.group.State = core.StringPtr(resourceGroupStateDeleted)
+	record.group.UpdatedAt = server.timestamp()
+	c.writeNoContent()
+}
+
+func (server *Server) listQuotaDefinitions(c *call) {
+	c.writeJSON(http.StatusOK, &resourcemanagerv2.QuotaDefinitionList{
+		Resources: append([]resourcemanagerv2.QuotaDefinition{}, server.quotaDefinitions...),
+	})
+}
+
+func (server *Server) getQuotaDefinition(c *call) {
+	id := c.pathParam("id")
+	for _, quota := range server.quotaDefinitions {
+		if *quota.ID == id {
+			c.writeJSON(http.StatusOK, quota)
+			return
+		}
+	}
+	c.notFound("quota definition", id)
+}
@@ -0,0 +1,112 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceGroups(t *testing.T) {
+	server := newServer(t, nil)
+	resourceController := newResourceController(t, server)
+	resourceManager, err := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	created, response, err := resourceManager.CreateResourceGroup(resourceManager.NewCreateResourceGroupOptions().SetName("team"))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+
+	_, response, err = resourceManager.CreateResourceGroup(resourceManager.NewCreateResourceGroupOptions().SetName("team"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	groups, _, err := resourceManager.ListResourceGroups(resourceManager.NewListResourceGroupsOptions().SetAccountID(server.AccountID()))
+	require.Nil(t, err)
+	assert.Len(t, groups.Resources, 2)
+	groups, _, err = resourceManager.ListResourceGroups(resourceManager.NewListResourceGroupsOptions().SetDefault(true))
+	require.Nil(t, err)
+	require.Len(t, groups.Resources, 1)
+	assert.Equal(t, server.DefaultResourceGroupID(), *groups.Resources[0].ID)
+
+	instance, _, err := resourceController.CreateResourceInstance(
+		resourceController.NewCreateResourceInstanceOptions("in-group", "us-south", *created.ID, "lite-plan"))
+	require.Nil(t, err)
+	assert.Equal(t, *created.ID, *instance.ResourceGroupID)
+	assert.Equal(t, *created.CRN, *instance.ResourceGroupCRN)
+
+	// A resource group that contains resources cannot be deleted.
+	response, err = resourceManager.DeleteResourceGroup(resourceManager.NewDeleteResourceGroupOptions(*created.ID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusConflict, response.StatusCode)
+
+	_, err = resourceController.DeleteResourceInstance(resourceController.NewDeleteResourceInstanceOptions(*instance.GUID))
+	require.Nil(t, err)
+	response, err = resourceManager.DeleteResourceGroup(resourceManager.NewDeleteResourceGroupOptions(*created.ID))
+	require.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, response.StatusCode)
+
+	_, response, err = resourceManager.GetResourceGroup(resourceManager.NewGetResourceGroupOptions(*created.ID))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+
+	_, response, err = resourceController.CreateResourceInstance(
+		resourceController.NewCreateResourceInstanceOptions("no-group", "us-south", *created.ID, "lite-plan"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+}
+
+func TestQuotaDefinitions(t *testing.T) {
+	server := newServer(t, nil)
+	resourceManager, err := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+
+	quota, _, err := resourceManager.GetQuotaDefinition(resourceManager.NewGetQuotaDefinitionOptions(platformfake.DefaultQuotaID))
+	require.Nil(t, err)
+	assert.Equal(t, "Trial Quota", *quota.Name)
+	assert.Empty(t, quota.ResourceQuotas)
+
+	server.RegisterQuotaDefinition(resourcemanagerv2.QuotaDefinition{
+		ID:   core.StringPtr(platformfake.DefaultQuotaID),
+		Name: core.StringPtr("Limited"),
+		ResourceQuotas: []resourcemanagerv2.ResourceQuota{
+			{ResourceID: core.StringPtr("cloud-object-storage"), Limit: core.Float64Ptr(2)},
+		},
+	})
+	server.RegisterQuotaDefinition(resourcemanagerv2.QuotaDefinition{ID: core.StringPtr("other"), Name: core.StringPtr("Other")})
+	quotas, _, err := resourceManager.ListQuotaDefinitions(resourceManager.NewListQuotaDefinitionsOptions())
+	require.Nil(t, err)
+	require.Len(t, quotas.Resources, 2)
+	assert.Equal(t, "Limited", *quotas.Resources[0].Name)
+	assert.Equal(t, 2.0, *quotas.Resources[0].ResourceQuotas[0].Limit)
+
+	_, response, err := resourceManager.GetQuotaDefinition(resourceManager.NewGetQuotaDefinitionOptions("unknown"))
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+}
@@ -0,0 +1,101 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake
+
+import (
+	"net/url"
+	"strings"
+)
+
+// route associates a method and path pattern (e.g. "/v2/resource_instances/{id}") with a handler.
+type route struct {
+	method   string
+	segments []string
+	style    errorStyle
+	handler  func(c *call)
+}
+
+// router matches requests to routes. Literal path segments take precedence over
+// path parameters, so "/v1/zones/serviceref_targets" is not matched by "/v1/zones/{zone_id}".
+type router struct {
+	routes []*route
+}
+
+func newRouter() *router {
+	return &router{}
+}
+
+// handle adds a route to the router.
+func (r *router) handle(method string, pattern string, style errorStyle, handler func(c *call)) {
+	r.routes = append(r.routes, &route{
+		method:   method,
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		style:    style,
+		handler:  handler,
+	})
+}
+
+// match returns the route that matches "method" and the escaped path "path" along with its
+// (unescaped) path parameters. Each segment is unescaped separately, so that a path parameter
+// may contain an escaped "/" (e.g. a CRN).
+// If no route matches, "methodAllowed" reports whether a route matched the path with a different method.
+func (r *router) match(method string, path string) (matched *route, pathParams map[string]string, methodAllowed bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segments[i] = unescaped
+		}
+	}
+	bestScore := -1
+	for _, candidate := range r.routes {
+		params, score, ok := candidate.matchPath(segments)
+		if !ok {
+			continue
+		}
+		if candidate.method != method {
+			methodAllowed = true
+			continue
+		}
+		if score > bestScore {
+			matched, pathParams, bestScore = candidate, params, score
+		}
+	}
+	return
+}
+
+// matchPath matches the route's pattern against the path segments and returns the
+// path parameters and the number of literal segments that matched.
+func (rt *route) matchPath(segments []string) (params map[string]string, score int, ok bool) {
+	if len(segments) != len(rt.segments) {
+		return
+	}
+	params = make(map[string]string)
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if segments[i] == "" {
+				return nil, 0, false
+			}
+			params[segment[1:len(segment)-1]] = segments[i]
+		} else if segment == segments[i] {
+			score++
+		} else {
+			return nil, 0, false
+		}
+	}
+	ok = true
+	return
+}
@@ -0,0 +1,614 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package platformfake provides an in-process fake of the IBM Cloud platform APIs
+// that can be used to test code built on this SDK without network access.
+//
+// The fake keeps its state in memory and implements the core operations of the
+// following services:
+//
+//   - Resource Controller: resource instances, resource keys, resource aliases and reclamations
+//   - Resource Manager: resource groups and quota definitions
+//   - IAM Access Groups: access groups and their members
+//   - IAM Policy Management: v2 policies, custom roles, policy templates, platform roles and
+//     the service roles registered with the Server
+//   - IAM Identity: inactivity reports of the identities registered with the Server
+//   - Global Tagging: tags and tag attachments
+//   - Context Based Restrictions: zones and rules
+//   - Global Catalog: the services and plans registered with the Server
+//
+// Each service client is pointed at the fake by setting its URL option to the URL of the Server:
+//
+//	server := platformfake.NewServer(nil)
+//	defer server.Close()
+//
+//	resourceController, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+//		URL:           server.URL,
+//		Authenticator: &core.NoAuthAuthenticator{},
+//	})
+//
+// List operations are paginated in the same way as the real services, ETag-protected
+// resources return an ETag header and enforce If-Match, and errors are returned with the
+// status codes and body shapes documented for each service.
+package platformfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultAccountID is the account ID used by a Server when ServerOptions.AccountID is not set.
+const DefaultAccountID = "fake-account-id"
+
+// DefaultResourceGroupName is the name of the default resource group created by NewServer().
+const DefaultResourceGroupName = "Default"
+
+// ServerOptions : The options used to configure a Server.
+type ServerOptions struct {
+	// The account that owns the resources managed by the fake (defaults to DefaultAccountID).
+	AccountID string
+
+	// The number of times a newly created or updated resource instance is reported with an
+	// "in progress" last operation before the operation completes.
+	// The default (0) completes every operation synchronously.
+	ProvisioningPolls int
+
+	// If true, deleting a resource instance moves it to the "pending_reclamation" state and
+	// creates a reclamation, as in an account with a reclamation policy.
+	// Otherwise deleted instances move directly to the "removed" state.
+	ReclamationEnabled bool
+
+	// If true, resource keys are created with one-time credentials, which are only returned in
+	// the response to the request that creates the key.
+	OnetimeCredentials bool
+
+	// The function used to obtain the current time (defaults to time.Now).
+	Now func() time.Time
+}
+
+// Plan : A service plan known to the fake, registered with Server.RegisterPlan().
+type Plan struct {
+	// The name of the service that offers the plan (e.g. "cloud-object-storage").
+	ServiceName string
+
+	// The catalog ID of the service that offers the plan.
+	ResourceID string
+
+	// The programmatic name of the plan in the catalog (defaults to the plan ID).
+	Name string
+
+	// The locations in which the plan is available. An empty list means all locations.
+	Locations []string
+
+	// If true, the plan is reported as disabled by the catalog.
+	Disabled bool
+}
+
+// Service : A catalog service known to the fake, registered with Server.RegisterService().
+type Service struct {
+	// The programmatic name of the service (e.g. "cloud-object-storage").
+	Name string
+
+	// If true, the catalog reports that the plan of the service's instances cannot be changed.
+	FixedPlan bool
+}
+
+// Request : A request received by a Server, as returned by Server.Requests().
+type Request struct {
+	// The HTTP method of the request.
+	Method string
+
+	// The path of the request URL.
+	Path string
+
+	// The query string of the request URL (without the leading "?").
+	RawQuery string
+}
+
+// Server : An in-process fake of the IBM Cloud platform APIs.
+// The embedded httptest.Server provides the URL of the fake and the Close() method.
+type Server struct {
+	*httptest.Server
+
+	accountID          string
+	provisioningPolls  int
+	reclamationEnabled bool
+	onetimeCredentials bool
+	now                func() time.Time
+
+	mutex    sync.Mutex
+	router   *router
+	sequence int64
+	faults   []*fault
+	requests []Request
+	plans    map[string]Plan
+	services map[string]Service
+
+	serviceRoles map[string][]iampolicymanagementv1.Role
+	customRoles  []*customRoleRecord
+	identities   []Identity
+
+	defaultResourceGroupID string
+
+	resourceInstances []*resourceInstanceRecord
+	resourceKeys      []*resourceKeyRecord
+	resourceAliases   []*resourceAliasRecord
+	reclamations      []*reclamationRecord
+	resourceGroups    []*resourceGroupRecord
+	quotaDefinitions  []resourcemanagerv2.QuotaDefinition
+	accessGroups      []*accessGroupRecord
+	policies          []*policyRecord
+	policyTemplates   []*policyTemplateRecord
+	tags              map[string][]*tagRecord
+	zones             []*zoneRecord
+	rules             []*ruleRecord
+	activityReports   []*activityReportRecord
+}
+
+// fault is an error response queued with Server.Fail().
+type fault struct {
+	method     string
+	path       string
+	statusCode int
+	remaining  int
+}
+
+// NewServer starts and returns a new Server. The caller should call Close() when finished with it.
+// The fake starts with a single default resource group; all other resources must be created
+// through the APIs (or the service clients) before they are used.
+func NewServer(options *ServerOptions) *Server {
+	if options == nil {
+		options = &ServerOptions{}
+	}
+
+	server := &Server{
+		accountID:          options.AccountID,
+		provisioningPolls:  options.ProvisioningPolls,
+		reclamationEnabled: options.ReclamationEnabled,
+		onetimeCredentials: options.OnetimeCredentials,
+		now:                options.Now,
+		plans:              make(map[string]Plan),
+		services:           make(map[string]Service),
+		serviceRoles:       make(map[string][]iampolicymanagementv1.Role),
+		tags:               make(map[string][]*tagRecord),
+	}
+	if server.accountID == "" {
+		server.accountID = DefaultAccountID
+	}
+	if server.now == nil {
+		server.now = time.Now
+	}
+
+	server.router = newRouter()
+	server.addResourceControllerRoutes()
+	server.addResourceManagerRoutes()
+	server.addAccessGroupsRoutes()
+	server.addPolicyManagementRoutes()
+	server.addGlobalTaggingRoutes()
+	server.addContextBasedRestrictionsRoutes()
+	server.addGlobalCatalogRoutes()
+	server.addIdentityRoutes()
+
+	server.defaultResourceGroupID = *server.newResourceGroup(DefaultResourceGroupName, true).group.ID
+	server.quotaDefinitions = []resourcemanagerv2.QuotaDefinition{newDefaultQuotaDefinition()}
+
+	server.Server = httptest.NewServer(http.HandlerFunc(server.serveHTTP))
+	return server
+}
+
+// AccountID returns the ID of the account that owns the resources managed by the fake.
+func (server *Server) AccountID() string {
+	return server.accountID
+}
+
+// DefaultResourceGroupID returns the ID of the default resource group created by NewServer().
+func (server *Server) DefaultResourceGroupID() string {
+	return server.defaultResourceGroupID
+}
+
+// RegisterPlan makes the specified service plan known to the fake, so that resource instances
+// created with the plan report the plan's service in their ResourceID and CRN, and the Global
+// Catalog lists the plan as a child of its service.
+// Instances may be created with any plan ID; unregistered plans are attributed to a service
+// named "fake-service".
+func (server *Server) RegisterPlan(planID string, plan Plan) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.plans[planID] = plan
+}
+
+// RegisterService makes the service with the catalog ID "resourceID" known to the fake.
+// The services of registered plans are known to the catalog even if they are not registered,
+// and allow plan changes.
+func (server *Server) RegisterService(resourceID string, service Service) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.services[resourceID] = service
+}
+
+// RegisterServiceRole makes the specified service role (e.g. "Writer") known to the fake, so that
+// the ListRoles operation returns it for the service "serviceName".
+// The platform roles (Viewer, Operator, Editor and Administrator) are always returned.
+func (server *Server) RegisterServiceRole(serviceName string, role iampolicymanagementv1.Role) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.serviceRoles[serviceName] = append(server.serviceRoles[serviceName], role)
+}
+
+// RegisterCustomRole makes the specified custom role known to the fake, so that the ListRoles
+// operation returns it for the account in role.AccountID and the service in role.ServiceName.
+// The ID and CRN of the role are generated if they are not set. It returns the ID of the role.
+func (server *Server) RegisterCustomRole(role iampolicymanagementv1.CustomRole) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return *server.addCustomRole(role, nil).role.ID
+}
+
+// RegisterIdentity makes the specified identity known to the fake, so that the inactivity
+// reports of IAM Identity list it if it has not authenticated within the duration of the report.
+// Registering an identity again replaces it.
+func (server *Server) RegisterIdentity(identity Identity) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for i := range server.identities {
+		if server.identities[i].IamID == identity.IamID {
+			server.identities[i] = identity
+			return
+		}
+	}
+	server.identities = append(server.identities, identity)
+}
+
+// RegisterQuotaDefinition adds the specified quota definition to the fake, or replaces the
+// definition with the same ID. Resource groups use the quota definition DefaultQuotaID, which
+// can be replaced to set the limits of the account.
+func (server *Server) RegisterQuotaDefinition(quota resourcemanagerv2.QuotaDefinition) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for i := range server.quotaDefinitions {
+		if *server.quotaDefinitions[i].ID == *quota.ID {
+			server.quotaDefinitions[i] = quota
+			return
+		}
+	}
+	server.quotaDefinitions = append(server.quotaDefinitions, quota)
+}
+
+// Fail causes the next "count" requests with the specified method and path to fail with
+// "statusCode" before they are processed, which is useful to test error handling and retries.
+// An empty method matches requests with any method. Responses with status code 429 or 503
+// include a "Retry-After" header.
+func (server *Server) Fail(method string, path string, statusCode int, count int) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.faults = append(server.faults, &fault{
+		method:     method,
+		path:       path,
+		statusCode: statusCode,
+		remaining:  count,
+	})
+}
+
+// Requests returns the requests received by the fake, in the order in which they were received.
+func (server *Server) Requests() []Request {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	requests := make([]Request, len(server.requests))
+	copy(requests, server.requests)
+	return requests
+}
+
+// ResetRequests discards the requests recorded so far.
+func (server *Server) ResetRequests() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.requests = nil
+}
+
+// serveHTTP dispatches each request to the handler of the matching route while holding the
+// server's mutex, so handlers never run concurrently.
+func (server *Server) serveHTTP(res http.ResponseWriter, req *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	server.requests = append(server.requests, Request{
+		Method:   req.Method,
+		Path:     req.URL.Path,
+		RawQuery: req.URL.RawQuery,
+	})
+
+	route, pathParams, methodAllowed := server.router.match(req.Method, req.URL.EscapedPath())
+	if route == nil {
+		style := styleForPath(req.URL.Path)
+		if methodAllowed {
+			server.writeError(res, req, style, http.StatusMethodNotAllowed, "method_not_allowed",
+				fmt.Sprintf("The method %s is not allowed for %s.", req.Method, req.URL.Path))
+		} else {
+			server.writeError(res, req, style, http.StatusNotFound, "not_found",
+				fmt.Sprintf("The path %s was not found.", req.URL.Path))
+		}
+		return
+	}
+
+	if statusCode := server.takeFault(req); statusCode != 0 {
+		if statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable {
+			res.Header().Set("Retry-After", "1")
+		}
+		server.writeError(res, req, route.style, statusCode, "injected_fault",
+			fmt.Sprintf("The request failed with an injected status code %d.", statusCode))
+		return
+	}
+
+	route.handler(&call{
+		server:     server,
+		res:        res,
+		req:        req,
+		pathParams: pathParams,
+		style:      route.style,
+	})
+}
+
+// takeFault returns the status code of the first queued fault that matches "req", or 0.
+func (server *Server) takeFault(req *http.Request) int {
+	for i, f := range server.faults {
+		if (f.method == "" || f.method == req.Method) && f.path == req.URL.Path {
+			f.remaining--
+			if f.remaining <= 0 {
+				server.faults = append(server.faults[:i], server.faults[i+1:]...)
+			}
+			return f.statusCode
+		}
+	}
+	return 0
+}
+
+// nextID returns a new unique identifier in the format of a UUID.
+func (server *Server) nextID() string {
+	server.sequence++
+	return fmt.Sprintf("%08x-0000-4000-8000-%012x", server.sequence, server.sequence)
+}
+
+// timestamp returns the current time as a DateTime.
+func (server *Server) timestamp() *strfmt.DateTime {
+	now := strfmt.DateTime(server.now().UTC())
+	return &now
+}
+
+// call holds the state of a single request being processed by a route handler.
+type call struct {
+	server     *Server
+	res        http.ResponseWriter
+	req        *http.Request
+	pathParams map[string]string
+	style      errorStyle
+}
+
+// pathParam returns the value of the named path parameter.
+func (c *call) pathParam(name string) string {
+	return c.pathParams[name]
+}
+
+// query returns the value of the named query parameter.
+func (c *call) query(name string) string {
+	return c.req.URL.Query().Get(name)
+}
+
+// queryInt64 returns the value of the named query parameter as an integer, or "defaultValue"
+// if the parameter is not present. It writes an error response and returns false if the
+// value is not a valid integer.
+func (c *call) queryInt64(name string, defaultValue int64) (int64, bool) {
+	value := c.query(name)
+	if value == "" {
+		return defaultValue, true
+	}
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || i < 0 {
+		c.badRequest(fmt.Sprintf("The value of the '%s' query parameter must be a non-negative integer.", name))
+		return 0, false
+	}
+	return i, true
+}
+
+// decodeBody unmarshals the JSON request body into "result". It writes an error response and
+// returns false if the body is not valid JSON.
+func (c *call) decodeBody(result interface{}) bool {
+	err := json.NewDecoder(c.req.Body).Decode(result)
+	if err != nil {
+		c.badRequest("The request body is not valid JSON: " + err.Error())
+		return false
+	}
+	return true
+}
+
+// decodeModel unmarshals the JSON request body into the SDK model "result" using "unmarshaller",
+// the generated unmarshal function of the model. It writes an error response and returns false
+// if the body cannot be unmarshalled.
+func (c *call) decodeModel(result interface{}, unmarshaller core.ModelUnmarshaller) bool {
+	var rawBody map[string]json.RawMessage
+	if !c.decodeBody(&rawBody) {
+		return false
+	}
+	err := core.UnmarshalModel(rawBody, "", result, unmarshaller)
+	if err != nil {
+		c.badRequest("The request body is not valid: " + err.Error())
+		return false
+	}
+	return true
+}
+
+// writeJSON writes "result" as the JSON response body with the specified status code.
+func (c *call) writeJSON(statusCode int, result interface{}) {
+	c.res.Header().Set("Content-Type", "application/json")
+	c.res.WriteHeader(statusCode)
+	_ = json.NewEncoder(c.res).Encode(result)
+}
+
+// writeJSONWithETag writes "result" as the JSON response body along with an ETag header.
+func (c *call) writeJSONWithETag(statusCode int, etag string, result interface{}) {
+	c.res.Header().Set("ETag", etag)
+	c.writeJSON(statusCode, result)
+}
+
+// writeNoContent writes an empty response with status code 204.
+func (c *call) writeNoContent() {
+	c.res.WriteHeader(http.StatusNoContent)
+}
+
+// checkIfMatch verifies the If-Match header of the request against the current ETag of the
+// resource. It writes an error response and returns false if the header is missing or stale.
+func (c *call) checkIfMatch(etag string) bool {
+	ifMatch := c.req.Header.Get("If-Match")
+	if ifMatch == "" {
+		c.writeError(http.StatusPreconditionRequired, "precondition_required",
+			"The If-Match header is required to update this resource.")
+		return false
+	}
+	if ifMatch != "*" && ifMatch != etag {
+		c.writeError(http.StatusPreconditionFailed, "precondition_failed",
+			"The If-Match header does not match the current ETag of the resource.")
+		return false
+	}
+	return true
+}
+
+// writeError writes an error response in the style of the service that owns the route.
+func (c *call) writeError(statusCode int, code string, message string) {
+	c.server.writeError(c.res, c.req, c.style, statusCode, code, message)
+}
+
+func (c *call) badRequest(message string) {
+	c.writeError(http.StatusBadRequest, "bad_request", message)
+}
+
+func (c *call) notFound(kind string, id string) {
+	c.writeError(http.StatusNotFound, "not_found", fmt.Sprintf("The %s '%s' was not found.", kind, id))
+}
+
+func (c *call) conflict(message string) {
+	c.writeError(http.StatusConflict, "conflict", message)
+}
+
+// href returns the absolute URL of the specified path on the fake.
+func (c *call) href(path string) string {
+	return "http://" + c.req.Host + path
+}
+
+// etag returns an ETag value for version "version" of the resource with the specified ID.
+func etag(id string, version int) string {
+	return fmt.Sprintf(`W/"%d-%s"`, version, id)
+}
+
+// errorStyle identifies the shape of the error bodies returned by a service.
+type errorStyle int
+
+const (
+	// {"trace": ..., "errors": [{"code": ..., "message": ...}], "status_code": ...}
+	// (IAM Access Groups, IAM Policy Management, Global Tagging, Global Catalog).
+	errorStyleIAM errorStyle = iota
+
+	// {"error_code": ..., "message": ..., "status_code": ..., "transaction_id": ...}
+	// (Resource Controller, Resource Manager).
+	errorStyleResourceController
+
+	// {"code": ..., "message": ..., "status_code": ..., "trace": ...}
+	// (Context Based Restrictions).
+	errorStyleContextBasedRestrictions
+)
+
+// styleForPath returns the error style used for a request that does not match any route.
+func styleForPath(path string) errorStyle {
+	switch {
+	case strings.HasPrefix(path, "/v2/resource_"), strings.HasPrefix(path, "/v1/reclamations"):
+		return errorStyleResourceController
+	case strings.HasPrefix(path, "/v1/zones"), strings.HasPrefix(path, "/v1/rules"):
+		return errorStyleContextBasedRestrictions
+	default:
+		return errorStyleIAM
+	}
+}
+
+// transactionID returns the transaction ID supplied by the client in "req", or a new one.
+func (server *Server) transactionID(req *http.Request) string {
+	for _, headerName := range []string{"Transaction-Id", "X-Correlation-Id", "X-Request-Id"} {
+		if value := req.Header.Get(headerName); value != "" {
+			return value
+		}
+	}
+	return "fake-" + server.nextID()
+}
+
+// writeError writes an error response with the body shape used by "style".
+func (server *Server) writeError(res http.ResponseWriter, req *http.Request, style errorStyle, statusCode int, code string, message string) {
+	transactionID := server.transactionID(req)
+
+	var body map[string]interface{}
+	switch style {
+	case errorStyleResourceController:
+		body = map[string]interface{}{
+			"error_code":     code,
+			"message":        message,
+			"status_code":    statusCode,
+			"transaction_id": transactionID,
+		}
+	case errorStyleContextBasedRestrictions:
+		body = map[string]interface{}{
+			"code":        code,
+			"message":     message,
+			"status_code": statusCode,
+			"trace":       transactionID,
+		}
+	default:
+		body = map[string]interface{}{
+			"trace": transactionID,
+			"errors": []map[string]interface{}{
+				{
+					"code":    code,
+					"message": message,
+				},
+			},
+			"status_code": statusCode,
+		}
+	}
+
+	res.Header().Set("Transaction-Id", transactionID)
+	res.Header().Set("Content-Type", "application/json")
+	res.WriteHeader(statusCode)
+	_ = json.NewEncoder(res).Encode(body)
+}
+
+// paginate returns the window of "total" items selected by "offset" and "limit".
+func paginate(total int, offset int64, limit int64) (start int, end int) {
+	start = int(offset)
+	if start > total {
+		start = total
+	}
+	end = start + int(limit)
+	if end > total || limit <= 0 {
+		end = total
+	}
+	return
+}
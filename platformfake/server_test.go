@@ -0,0 +1,104 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package platformfake_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newServer starts a Server that is closed when the test completes.
+func newServer(t *testing.T, options *platformfake.ServerOptions) *platformfake.Server {
+	server := platformfake.NewServer(options)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newResourceController(t *testing.T, server *platformfake.Server) *resourcecontrollerv2.ResourceControllerV2 {
+	service, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return service
+}
+
+func TestUnknownPath(t *testing.T) {
+	server := newServer(t, nil)
+
+	res, err := http.Get(server.URL + "/v2/unknown")
+	require.Nil(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	var body map[string]interface{}
+	require.Nil(t, json.NewDecoder(res.Body).Decode(&body))
+	assert.Equal(t, float64(404), body["status_code"])
+	assert.NotEmpty(t, body["errors"])
+
+	res, err = http.Post(server.URL+"/v2/resource_keys/some-id", "application/json", nil)
+	require.Nil(t, err)
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, res.StatusCode)
+}
+
+func TestFail(t *testing.T) {
+	server := newServer(t, nil)
+	resourceController := newResourceController(t, server)
+
+	server.Fail(http.MethodGet, "/v2/resource_instances", http.StatusTooManyRequests, 2)
+
+	listOptions := resourceController.NewListResourceInstancesOptions()
+	for i := 0; i < 2; i++ {
+		_, response, err := resourceController.ListResourceInstances(listOptions)
+		assert.NotNil(t, err)
+		require.NotNil(t, response)
+		assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+		assert.Equal(t, "1", response.Headers.Get("Retry-After"))
+	}
+
+	result, response, err := resourceController.ListResourceInstances(listOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Empty(t, result.Resources)
+
+	requests := server.Requests()
+	assert.Len(t, requests, 3)
+	assert.Equal(t, platformfake.Request{Method: http.MethodGet, Path: "/v2/resource_instances"}, requests[2])
+	server.ResetRequests()
+	assert.Empty(t, server.Requests())
+}
+
+func TestErrorMessage(t *testing.T) {
+	server := newServer(t, nil)
+	resourceController := newResourceController(t, server)
+
+	_, response, err := resourceController.GetResourceInstance(resourceController.NewGetResourceInstanceOptions("missing"))
+	require.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, response.StatusCode)
+	assert.Contains(t, err.Error(), "The resource instance 'missing' was not found.")
+	assert.NotEmpty(t, response.Headers.Get("Transaction-Id"))
+}
@@ -0,0 +1,252 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package policybundle copies the IAM access policies, custom roles and policy templates of an
+// account to other accounts, through a portable bundle.
+//
+// An Exporter writes the objects of a source account into a Bundle, in which the identifiers
+// that only have a meaning in the source account are replaced by symbolic references:
+//
+//	exporter, err := policybundle.NewExporter(&policybundle.ExporterOptions{
+//		IamPolicyManagement: iamPolicyManagement,
+//		IamAccessGroups:     iamAccessGroups,
+//		ResourceController:  resourceController,
+//		AccountID:           sourceAccountID,
+//	})
+//	...
+//	bundle, err := exporter.Export(ctx)
+//	...
+//	err = bundle.WriteYAML(file)
+//
+// An Importer resolves the references of a bundle read with ReadBundle() in a target account,
+// then creates the objects that are missing from the account and updates the objects that
+// differ from the bundle. Importing the same bundle again changes nothing:
+//
+//	importer, err := policybundle.NewImporter(&policybundle.ImporterOptions{
+//		IamPolicyManagement: iamPolicyManagement,
+//		IamAccessGroups:     iamAccessGroups,
+//		ResourceController:  resourceController,
+//		AccountID:           targetAccountID,
+//		Variables:           map[string]string{"admin": "IBMid-123456"},
+//	})
+//	...
+//	result, err := importer.Import(ctx, bundle)
+//	...
+//	fmt.Println(result.String())
+//
+// The following references are supported:
+//
+//   - ${account_id}: the ID of the account (including inside CRNs)
+//   - ${access_group:NAME}: the ID of the access group named NAME
+//   - ${service_instance:NAME}: the GUID of the service instance named NAME
+//   - ${service_instance_crn:NAME}: the CRN of the service instance named NAME
+//   - ${var:NAME}: the value of the variable NAME, supplied by the caller
+//
+// Policies created from policy template assignments are not exported; they are managed through
+// their templates.
+package policybundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// BundleVersion is the version of the bundle format written by this package.
+const BundleVersion = 1
+
+// The kinds of symbolic references that can appear in a bundle.
+const (
+	ReferenceAccountID          = "account_id"
+	ReferenceAccessGroup        = "access_group"
+	ReferenceServiceInstance    = "service_instance"
+	ReferenceServiceInstanceCRN = "service_instance_crn"
+	ReferenceVariable           = "var"
+)
+
+// referencePattern matches the symbolic references in the strings of a bundle.
+var referencePattern = regexp.MustCompile(`\$\{([a-z_]+)(?::([^}]+))?\}`)
+
+// Reference returns the symbolic reference to the object of the specified kind (one of the
+// Reference constants) and name, e.g. "${access_group:Auditors}". The name of a
+// ReferenceAccountID reference is empty.
+func Reference(kind string, name string) string {
+	if name == "" {
+		return "${" + kind + "}"
+	}
+	return "${" + kind + ":" + name + "}"
+}
+
+// Bundle : The policies, custom roles and policy templates of an account, with symbolic
+// references in place of the identifiers that are specific to the account.
+type Bundle struct {
+	// The version of the bundle format (BundleVersion).
+	Version int `json:"version" yaml:"version"`
+
+	// The account from which the bundle was exported.
+	SourceAccountID string `json:"source_account_id" yaml:"source_account_id"`
+
+	// The time at which the bundle was exported.
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+
+	// The custom roles, sorted by name.
+	CustomRoles []*CustomRole `json:"custom_roles" yaml:"custom_roles"`
+
+	// The policy templates, sorted by name.
+	PolicyTemplates []*PolicyTemplate `json:"policy_templates" yaml:"policy_templates"`
+
+	// The access and authorization policies, sorted by subject and resource.
+	Policies []Policy `json:"policies" yaml:"policies"`
+}
+
+// CustomRole : A custom role of a bundle.
+type CustomRole struct {
+	// The name of the role, which identifies it within the account.
+	Name string `json:"name" yaml:"name"`
+
+	// The display name of the role.
+	DisplayName string `json:"display_name" yaml:"display_name"`
+
+	// The description of the role.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// The service whose resources the role applies to.
+	ServiceName string `json:"service_name" yaml:"service_name"`
+
+	// The actions of the role.
+	Actions []string `json:"actions" yaml:"actions"`
+}
+
+// PolicyTemplate : The latest version of a policy template of a bundle.
+type PolicyTemplate struct {
+	// The name of the template, which identifies it within the account.
+	Name string `json:"name" yaml:"name"`
+
+	// The description of the template.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// The policy of the template.
+	Policy Policy `json:"policy" yaml:"policy"`
+}
+
+// Policy : A policy of a bundle, in the JSON format of the body of a CreateV2Policy request
+// (type, description, subject, resource, pattern, rule and control).
+type Policy map[string]interface{}
+
+// newPolicy converts "model" (e.g. a V2PolicyTemplateMetaData or a TemplatePolicy) into a
+// Policy, keeping only the properties of a TemplatePolicy.
+func newPolicy(model interface{}) (Policy, error) {
+	templatePolicy, err := toTemplatePolicy(model)
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := json.Marshal(templatePolicy)
+	if err != nil {
+		return nil, err
+	}
+	policy := Policy{}
+	if err = json.Unmarshal(buffer, &policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// toTemplatePolicy converts "model" into a TemplatePolicy through its JSON representation.
+func toTemplatePolicy(model interface{}) (*iampolicymanagementv1.TemplatePolicy, error) {
+	buffer, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	var rawPolicy map[string]json.RawMessage
+	if err = json.Unmarshal(buffer, &rawPolicy); err != nil {
+		return nil, err
+	}
+	var templatePolicy *iampolicymanagementv1.TemplatePolicy
+	if err = core.UnmarshalModel(rawPolicy, "", &templatePolicy, iampolicymanagementv1.UnmarshalTemplatePolicy); err != nil {
+		return nil, err
+	}
+	if templatePolicy.Type == nil {
+		return nil, fmt.Errorf("the policy has no type")
+	}
+	if templatePolicy.Control == nil || templatePolicy.Control.Grant == nil || len(templatePolicy.Control.Grant.Roles) == 0 {
+		return nil, fmt.Errorf("the policy grants no role")
+	}
+	return templatePolicy, nil
+}
+
+// getPolicyKey returns a string that identifies the policy within an account: its type, its
+// subject and its resource. Two policies with the same key are considered the same policy.
+func getPolicyKey(policy *iampolicymanagementv1.TemplatePolicy) string {
+	var subject, resource, tags []string
+	if policy.Subject != nil {
+		for _, attribute := range policy.Subject.Attributes {
+			subject = append(subject, formatAttribute(attribute.Key, attribute.Operator, attribute.Value))
+		}
+	}
+	if policy.Resource != nil {
+		for _, attribute := range policy.Resource.Attributes {
+			resource = append(resource, formatAttribute(attribute.Key, attribute.Operator, attribute.Value))
+		}
+		for _, tag := range policy.Resource.Tags {
+			tags = append(tags, formatAttribute(tag.Key, tag.Operator, tag.Value))
+		}
+	}
+	sort.Strings(subject)
+	sort.Strings(resource)
+	sort.Strings(tags)
+	key := *policy.Type + " " + strings.Join(subject, ",") + " on " + strings.Join(resource, ",")
+	if len(tags) > 0 {
+		key += " tagged " + strings.Join(tags, ",")
+	}
+	return key
+}
+
+func formatAttribute(key *string, operator *string, value interface{}) string {
+	if operator == nil || *operator == "stringEquals" {
+		return fmt.Sprintf("%s=%v", core.StringNilMapper(key), value)
+	}
+	return fmt.Sprintf("%s[%s]=%v", core.StringNilMapper(key), *operator, value)
+}
+
+// rewriteStrings returns a copy of "value", a JSON-compatible value, in which each string
+// (other than map keys) is replaced by the result of "rewrite".
+func rewriteStrings(value interface{}, rewrite func(string) string) interface{} {
+	switch value := value.(type) {
+	case string:
+		return rewrite(value)
+	case Policy:
+		return Policy(rewriteStrings(map[string]interface{}(value), rewrite).(map[string]interface{}))
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			result[k] = rewriteStrings(v, rewrite)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, v := range value {
+			result[i] = rewriteStrings(v, rewrite)
+		}
+		return result
+	}
+	return value
+}
@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policybundle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// ExporterOptions : The options used to create an Exporter with NewExporter().
+type ExporterOptions struct {
+	// The client used to export the policies, custom roles and policy templates (required).
+	IamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+
+	// The client used to replace access group IDs with ${access_group:NAME} references.
+	// Access group IDs are exported as is if it is not set.
+	IamAccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+
+	// The client used to replace service instance GUIDs and CRNs with ${service_instance:NAME}
+	// and ${service_instance_crn:NAME} references. They are exported as is if it is not set.
+	ResourceController *resourcecontrollerv2.ResourceControllerV2
+
+	// The account whose objects are exported (required).
+	AccountID string
+
+	// Values to replace with ${var:NAME} references, indexed by NAME (e.g. the IAM ID of a user
+	// that differs between accounts). A value is replaced only where it makes up a whole string.
+	Variables map[string]string
+
+	// The function used to obtain the creation time of a bundle (defaults to time.Now).
+	Now func() time.Time
+}
+
+// Exporter : Exports the policies, custom roles and policy templates of an account into a Bundle.
+type Exporter struct {
+	options ExporterOptions
+}
+
+// NewExporter returns a new Exporter.
+func NewExporter(options *ExporterOptions) (*Exporter, error) {
+	if options == nil || options.IamPolicyManagement == nil {
+		return nil, fmt.Errorf("an IamPolicyManagement client is required")
+	}
+	if options.AccountID == "" {
+		return nil, fmt.Errorf("an account ID is required")
+	}
+	exporter := &Exporter{options: *options}
+	if exporter.options.Now == nil {
+		exporter.options.Now = time.Now
+	}
+	return exporter, nil
+}
+
+// Export retrieves the custom roles, the latest version of the policy templates and the
+// active policies of the account and returns them as a bundle.
+//
+// Access group IDs and service instance GUIDs and CRNs are replaced with references to the name
+// of the object, unless several objects of the account share that name.
+func (exporter *Exporter) Export(ctx context.Context) (*Bundle, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	references, err := exporter.getReferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+	accountID := exporter.options.AccountID
+	accountReference := Reference(ReferenceAccountID, "")
+	rewrite := func(s string) string {
+		if reference, ok := references[s]; ok {
+			return reference
+		}
+		return strings.ReplaceAll(s, accountID, accountReference)
+	}
+
+	bundle := &Bundle{
+		Version:         BundleVersion,
+		SourceAccountID: accountID,
+		CreatedAt:       exporter.options.Now().UTC(),
+	}
+	if bundle.CustomRoles, err = exporter.exportCustomRoles(ctx); err != nil {
+		return nil, err
+	}
+	if bundle.PolicyTemplates, err = exporter.exportPolicyTemplates(ctx, rewrite); err != nil {
+		return nil, err
+	}
+	if bundle.Policies, err = exporter.exportPolicies(ctx, rewrite); err != nil {
+		return nil, err
+	}
+	return bundle, nil
+}
+
+// getReferences returns the references that replace the identifiers of the account,
+// indexed by identifier.
+func (exporter *Exporter) getReferences(ctx context.Context) (map[string]string, error) {
+	objects, err := listObjects(ctx, exporter.options.IamAccessGroups, exporter.options.ResourceController,
+		exporter.options.AccountID)
+	if err != nil {
+		return nil, err
+	}
+	counts := map[string]int{}
+	for _, object := range objects {
+		counts[object.reference]++
+	}
+	references := map[string]string{}
+	for _, object := range objects {
+		if counts[object.reference] == 1 {
+			references[object.value] = object.reference
+		}
+	}
+	for name, value := range exporter.options.Variables {
+		if value != "" {
+			references[value] = Reference(ReferenceVariable, name)
+		}
+	}
+	return references, nil
+}
+
+// referencedObject : An object of an account that a reference can designate.
+type referencedObject struct {
+	// The reference to the object, e.g. "${access_group:Auditors}".
+	reference string
+
+	// The value of the reference in the account, e.g. the ID of the access group.
+	value string
+}
+
+// listObjects returns the access groups and service instances of the account that can be the
+// target of a reference. Service instances are listed in every state but "removed". The objects of a service are not listed if its client is nil.
+func listObjects(ctx context.Context, iamAccessGroups *iamaccessgroupsv2.IamAccessGroupsV2,
+	resourceController *resourcecontrollerv2.ResourceControllerV2, accountID string) ([]referencedObject, error) {
+	objects := []referencedObject{}
+	if iamAccessGroups != nil {
+		pager, err := iamAccessGroups.NewAccessGroupsPager(iamAccessGroups.NewListAccessGroupsOptions(accountID))
+		if err != nil {
+			return nil, err
+		}
+		groups, err := pager.GetAllWithContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error listing the access groups of account '%s': %w", accountID, err)
+		}
+		for _, group := range groups {
+			if group.ID != nil && group.Name != nil {
+				objects = append(objects, referencedObject{Reference(ReferenceAccessGroup, *group.Name), *group.ID})
+			}
+		}
+	}
+	if resourceController != nil {
+		instances, err := resourceController.ListNonRemovedResourceInstances(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error listing resource instances: %w", err)
+		}
+		for _, instance := range instances {
+			if instance.Name == nil || (instance.AccountID != nil && *instance.AccountID != accountID) {
+				continue
+			}
+			if instance.GUID != nil {
+				objects = append(objects, referencedObject{Reference(ReferenceServiceInstance, *instance.Name), *instance.GUID})
+			}
+			if instance.CRN != nil {
+				objects = append(objects, referencedObject{Reference(ReferenceServiceInstanceCRN, *instance.Name), *instance.CRN})
+			}
+		}
+	}
+	return objects, nil
+}
+
+func (exporter *Exporter) exportCustomRoles(ctx context.Context) ([]*CustomRole, error) {
+	iamPolicyManagement := exporter.options.IamPolicyManagement
+	roles, _, err := iamPolicyManagement.ListRolesWithContext(ctx, iamPolicyManagement.NewListRolesOptions().
+		SetAccountID(exporter.options.AccountID))
+	if err != nil {
+		return nil, fmt.Errorf("error listing the custom roles of account '%s': %w", exporter.options.AccountID, err)
+	}
+	result := []*CustomRole{}
+	for _, role := range roles.CustomRoles {
+		result = append(result, &CustomRole{
+			Name:        core.StringNilMapper(role.Name),
+			DisplayName: core.StringNilMapper(role.DisplayName),
+			Description: core.StringNilMapper(role.Description),
+			ServiceName: core.StringNilMapper(role.ServiceName),
+			Actions:     role.Actions,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (exporter *Exporter) exportPolicyTemplates(ctx context.Context, rewrite func(string) string) ([]*PolicyTemplate, error) {
+	iamPolicyManagement := exporter.options.IamPolicyManagement
+	pager, err := iamPolicyManagement.NewPolicyTemplatesPager(iamPolicyManagement.NewListPolicyTemplatesOptions(exporter.options.AccountID))
+	if err != nil {
+		return nil, err
+	}
+	templates, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the policy templates of account '%s': %w", exporter.options.AccountID, err)
+	}
+	result := []*PolicyTemplate{}
+	for _, template := range templates {
+		policy, err := newPolicy(template.Policy)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting policy template '%s': %w", core.StringNilMapper(template.Name), err)
+		}
+		result = append(result, &PolicyTemplate{
+			Name:        core.StringNilMapper(template.Name),
+			Description: rewrite(core.StringNilMapper(template.Description)),
+			Policy:      rewriteStrings(policy, rewrite).(Policy),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (exporter *Exporter) exportPolicies(ctx context.Context, rewrite func(string) string) ([]Policy, error) {
+	iamPolicyManagement := exporter.options.IamPolicyManagement
+	pager, err := iamPolicyManagement.NewV2PoliciesPager(iamPolicyManagement.NewListV2PoliciesOptions(exporter.options.AccountID).
+		SetState(iampolicymanagementv1.ListV2PoliciesOptionsStateActiveConst))
+	if err != nil {
+		return nil, err
+	}
+	policies, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the policies of account '%s': %w", exporter.options.AccountID, err)
+	}
+	type keyedPolicy struct {
+		key    string
+		policy Policy
+	}
+	exported := []keyedPolicy{}
+	for i := range policies {
+		if policies[i].Template != nil {
+			continue
+		}
+		templatePolicy, err := toTemplatePolicy(&policies[i])
+		if err != nil {
+			return nil, fmt.Errorf("error exporting policy '%s': %w", core.StringNilMapper(policies[i].ID), err)
+		}
+		policy, err := newPolicy(templatePolicy)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting policy '%s': %w", core.StringNilMapper(policies[i].ID), err)
+		}
+		policy = rewriteStrings(policy, rewrite).(Policy)
+		exported = append(exported, keyedPolicy{key: getPolicyKey(templatePolicy), policy: policy})
+	}
+	sort.SliceStable(exported, func(i, j int) bool { return exported[i].key < exported[j].key })
+	result := make([]Policy, len(exported))
+	for i := range exported {
+		result[i] = exported[i].policy
+	}
+	return result, nil
+}
@@ -0,0 +1,291 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policybundle_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/policybundle"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sourceAccountID = "aaaa1111aaaa1111aaaa1111aaaa1111"
+	targetAccountID = "bbbb2222bbbb2222bbbb2222bbbb2222"
+	viewerRole      = "crn:v1:bluemix:public:iam::::role:Viewer"
+	editorRole      = "crn:v1:bluemix:public:iam::::role:Editor"
+)
+
+var exportTime = time.Date(2024, 6, 1, 8, 30, 0, 0, time.UTC)
+
+// account : A fake account and the clients of its services.
+type account struct {
+	server              *platformfake.Server
+	iamAccessGroups     *iamaccessgroupsv2.IamAccessGroupsV2
+	iamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+	resourceController  *resourcecontrollerv2.ResourceControllerV2
+}
+
+func newAccount(t *testing.T, accountID string) *account {
+	server := platformfake.NewServer(&platformfake.ServerOptions{AccountID: accountID})
+	t.Cleanup(server.Close)
+
+	a := &account{server: server}
+	var err error
+	a.iamAccessGroups, err = iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	a.iamPolicyManagement, err = iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	a.resourceController, err = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		URL:           server.URL,
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	require.Nil(t, err)
+	return a
+}
+
+func (a *account) createAccessGroup(t *testing.T, name string) string {
+	group, _, err := a.iamAccessGroups.CreateAccessGroup(a.iamAccessGroups.NewCreateAccessGroupOptions(a.server.AccountID(), name))
+	require.Nil(t, err)
+	return *group.ID
+}
+
+func (a *account) createInstance(t *testing.T, name string) *resourcecontrollerv2.ResourceInstance {
+	createOptions := a.resourceController.NewCreateResourceInstanceOptions(name, "us-south", a.server.DefaultResourceGroupID(), "lite-plan")
+	instance, _, err := a.resourceController.CreateResourceInstance(createOptions)
+	require.Nil(t, err)
+	return instance
+}
+
+func (a *account) customRoleCRN(name string) string {
+	return "crn:v1:bluemix:public:iam-access-management::a/" + a.server.AccountID() + "::customRole:" + name
+}
+
+func (a *account) createCustomRole(t *testing.T, name string, actions ...string) string {
+	role, _, err := a.iamPolicyManagement.CreateRole(a.iamPolicyManagement.NewCreateRoleOptions(name, actions, name,
+		a.server.AccountID(), "kms"))
+	require.Nil(t, err)
+	return *role.ID
+}
+
+func newResource(attributes map[string]string) *iampolicymanagementv1.V2PolicyResource {
+	resource := &iampolicymanagementv1.V2PolicyResource{}
+	for _, key := range []string{"accountId", "serviceName", "serviceInstance"} {
+		if value, ok := attributes[key]; ok {
+			resource.Attributes = append(resource.Attributes, iampolicymanagementv1.V2PolicyResourceAttribute{
+				Key: core.StringPtr(key), Operator: core.StringPtr("stringEquals"), Value: value,
+			})
+		}
+	}
+	return resource
+}
+
+func newControl(roles ...string) *iampolicymanagementv1.Control {
+	control := &iampolicymanagementv1.Control{Grant: &iampolicymanagementv1.Grant{}}
+	for _, role := range roles {
+		control.Grant.Roles = append(control.Grant.Roles, iampolicymanagementv1.Roles{RoleID: core.StringPtr(role)})
+	}
+	return control
+}
+
+func (a *account) createPolicy(t *testing.T, subjectKey string, subjectValue string, resource map[string]string, roles ...string) string {
+	options := a.iamPolicyManagement.NewCreateV2PolicyOptions(newControl(roles...), "access")
+	options.SetSubject(&iampolicymanagementv1.V2PolicySubject{
+		Attributes: []iampolicymanagementv1.V2PolicySubjectAttribute{
+			{Key: core.StringPtr(subjectKey), Operator: core.StringPtr("stringEquals"), Value: subjectValue},
+		},
+	})
+	resource["accountId"] = a.server.AccountID()
+	options.SetResource(newResource(resource))
+	policy, _, err := a.iamPolicyManagement.CreateV2Policy(options)
+	require.Nil(t, err)
+	return *policy.ID
+}
+
+func (a *account) createPolicyTemplate(t *testing.T, name string, serviceName string, roles ...string) string {
+	options := a.iamPolicyManagement.NewCreatePolicyTemplateOptions(name, a.server.AccountID(), &iampolicymanagementv1.TemplatePolicy{
+		Type:     core.StringPtr("access"),
+		Resource: newResource(map[string]string{"serviceName": serviceName}),
+		Control:  newControl(roles...),
+	})
+	options.SetDescription("Access to " + serviceName)
+	template, _, err := a.iamPolicyManagement.CreatePolicyTemplate(options)
+	require.Nil(t, err)
+	return *template.ID
+}
+
+// newSourceAccount returns an account with a custom role, a policy template and policies that
+// reference an access group, a service instance, the custom role and an administrator.
+func newSourceAccount(t *testing.T) *account {
+	source := newAccount(t, sourceAccountID)
+	group := source.createAccessGroup(t, "auditors")
+	instance := source.createInstance(t, "keys")
+	source.createCustomRole(t, "KeyReader", "kms.secrets.read")
+	source.createPolicyTemplate(t, "kms-viewer", "kms", viewerRole)
+	source.createPolicy(t, "access_group_id", group, map[string]string{"serviceName": "kms", "serviceInstance": *instance.GUID},
+		source.customRoleCRN("KeyReader"))
+	source.createPolicy(t, "iam_id", "IBMid-source-admin", map[string]string{}, viewerRole, editorRole)
+	return source
+}
+
+func (a *account) export(t *testing.T) *policybundle.Bundle {
+	exporter, err := policybundle.NewExporter(&policybundle.ExporterOptions{
+		IamPolicyManagement: a.iamPolicyManagement,
+		IamAccessGroups:     a.iamAccessGroups,
+		ResourceController:  a.resourceController,
+		AccountID:           a.server.AccountID(),
+		Variables:           map[string]string{"admin": "IBMid-source-admin"},
+		Now:                 func() time.Time { return exportTime },
+	})
+	require.Nil(t, err)
+	bundle, err := exporter.Export(context.Background())
+	require.Nil(t, err)
+	return bundle
+}
+
+func TestExport(t *testing.T) {
+	source := newSourceAccount(t)
+	bundle := source.export(t)
+	assert.Equal(t, policybundle.BundleVersion, bundle.Version)
+	assert.Equal(t, sourceAccountID, bundle.SourceAccountID)
+	assert.Equal(t, exportTime, bundle.CreatedAt)
+
+	assert.Equal(t, []*policybundle.CustomRole{{
+		Name:        "KeyReader",
+		DisplayName: "KeyReader",
+		ServiceName: "kms",
+		Actions:     []string{"kms.secrets.read"},
+	}}, bundle.CustomRoles)
+
+	require.Len(t, bundle.PolicyTemplates, 1)
+	template := bundle.PolicyTemplates[0]
+	assert.Equal(t, "kms-viewer", template.Name)
+	assert.Equal(t, "Access to kms", template.Description)
+	assert.Equal(t, "access", template.Policy["type"])
+
+	// The policies are sorted by subject and every account-specific value is a reference.
+	require.Len(t, bundle.Policies, 2)
+	assert.Equal(t, policybundle.Policy{
+		"type": "access",
+		"subject": map[string]interface{}{"attributes": []interface{}{
+			map[string]interface{}{"key": "access_group_id", "operator": "stringEquals", "value": "${access_group:auditors}"},
+		}},
+		"resource": map[string]interface{}{"attributes": []interface{}{
+			map[string]interface{}{"key": "accountId", "operator": "stringEquals", "value": "${account_id}"},
+			map[string]interface{}{"key": "serviceName", "operator": "stringEquals", "value": "kms"},
+			map[string]interface{}{"key": "serviceInstance", "operator": "stringEquals", "value": "${service_instance:keys}"},
+		}},
+		"control": map[string]interface{}{"grant": map[string]interface{}{"roles": []interface{}{
+			map[string]interface{}{"role_id": "crn:v1:bluemix:public:iam-access-management::a/${account_id}::customRole:KeyReader"},
+		}}},
+	}, bundle.Policies[0])
+	subject := bundle.Policies[1]["subject"].(map[string]interface{})["attributes"].([]interface{})[0]
+	assert.Equal(t, "${var:admin}", subject.(map[string]interface{})["value"])
+
+	// Without the optional clients, access group IDs and instance GUIDs are exported as is.
+	exporter, err := policybundle.NewExporter(&policybundle.ExporterOptions{
+		IamPolicyManagement: source.iamPolicyManagement,
+		AccountID:           sourceAccountID,
+	})
+	require.Nil(t, err)
+	bundle, err = exporter.Export(context.Background())
+	require.Nil(t, err)
+	require.Len(t, bundle.Policies, 2)
+	subject = bundle.Policies[0]["subject"].(map[string]interface{})["attributes"].([]interface{})[0]
+	assert.True(t, strings.HasPrefix(subject.(map[string]interface{})["value"].(string), "AccessGroupId-"))
+}
+
+func TestExportInactiveInstance(t *testing.T) {
+	source := newAccount(t, sourceAccountID)
+	instance := source.createInstance(t, "suspended-keys")
+	require.True(t, source.server.DeactivateResourceInstance(*instance.GUID))
+	source.createPolicy(t, "iam_id", "IBMid-source-admin", map[string]string{"serviceName": "kms", "serviceInstance": *instance.GUID},
+		viewerRole)
+
+	bundle := source.export(t)
+	require.Len(t, bundle.Policies, 1)
+	attributes := bundle.Policies[0]["resource"].(map[string]interface{})["attributes"].([]interface{})
+	assert.Equal(t, "${service_instance:suspended-keys}", attributes[2].(map[string]interface{})["value"])
+}
+
+func TestExportErrors(t *testing.T) {
+	source := newAccount(t, sourceAccountID)
+	_, err := policybundle.NewExporter(&policybundle.ExporterOptions{AccountID: sourceAccountID})
+	assert.NotNil(t, err)
+	_, err = policybundle.NewExporter(&policybundle.ExporterOptions{IamPolicyManagement: source.iamPolicyManagement})
+	assert.NotNil(t, err)
+
+	exporter, err := policybundle.NewExporter(&policybundle.ExporterOptions{
+		IamPolicyManagement: source.iamPolicyManagement,
+		IamAccessGroups:     source.iamAccessGroups,
+		AccountID:           sourceAccountID,
+	})
+	require.Nil(t, err)
+	source.server.Fail(http.MethodGet, "/v2/groups", http.StatusForbidden, 1)
+	_, err = exporter.Export(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing the access groups of account")
+
+	source.server.Fail(http.MethodGet, "/v1/policy_templates", http.StatusForbidden, 1)
+	_, err = exporter.Export(context.Background())
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error listing the policy templates of account")
+}
+
+func TestBundleFormats(t *testing.T) {
+	bundle := newSourceAccount(t).export(t)
+
+	var jsonBuffer, yamlBuffer bytes.Buffer
+	require.Nil(t, bundle.WriteJSON(&jsonBuffer))
+	require.Nil(t, bundle.WriteYAML(&yamlBuffer))
+	assert.Contains(t, yamlBuffer.String(), "value: ${access_group:auditors}")
+
+	for _, buffer := range []*bytes.Buffer{&jsonBuffer, &yamlBuffer} {
+		read, err := policybundle.ReadBundle(buffer)
+		require.Nil(t, err)
+		assert.Equal(t, bundle.CustomRoles, read.CustomRoles)
+		assert.Equal(t, bundle.PolicyTemplates, read.PolicyTemplates)
+		assert.Equal(t, bundle.Policies, read.Policies)
+		assert.True(t, bundle.CreatedAt.Equal(read.CreatedAt))
+	}
+
+	_, err := policybundle.ReadBundle(strings.NewReader("version: 2\n"))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the bundle version 2 is not supported")
+	_, err = policybundle.ReadBundle(strings.NewReader(`{"version": 1, "custom_roles": [{"actions": []}]}`))
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "custom role 0 has no name")
+	_, err = policybundle.ReadBundle(strings.NewReader("{"))
+	assert.NotNil(t, err)
+}
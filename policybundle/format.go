@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policybundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WriteJSON writes the bundle to "w" as a single, indented JSON document.
+func (bundle *Bundle) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(bundle); err != nil {
+		return fmt.Errorf("error writing the bundle: %w", err)
+	}
+	return nil
+}
+
+// WriteYAML writes the bundle to "w" as a YAML document.
+func (bundle *Bundle) WriteYAML(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(bundle); err != nil {
+		return fmt.Errorf("error writing the bundle: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("error writing the bundle: %w", err)
+	}
+	return nil
+}
+
+// ReadBundle reads a bundle written by Bundle.WriteJSON() or Bundle.WriteYAML(); the format
+// is detected from the content. An error is returned if the bundle was written in a newer
+// version of the format.
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the bundle: %w", err)
+	}
+	bundle := &Bundle{}
+	if bytes.HasPrefix(bytes.TrimSpace(content), []byte("{")) {
+		err = json.Unmarshal(content, bundle)
+	} else {
+		err = yaml.Unmarshal(content, bundle)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("the bundle is not valid: %w", err)
+	}
+	if bundle.Version < 1 || bundle.Version > BundleVersion {
+		return nil, fmt.Errorf("the bundle version %d is not supported (supported versions: 1 to %d)", bundle.Version, BundleVersion)
+	}
+	// YAML documents are decoded with the type of the policies for every nested object; the
+	// policies are converted to the types used by encoding/json.
+	for i := range bundle.Policies {
+		if bundle.Policies[i], err = normalizePolicy(bundle.Policies[i]); err != nil {
+			return nil, fmt.Errorf("the bundle is not valid: policy %d: %w", i, err)
+		}
+	}
+	for i, template := range bundle.PolicyTemplates {
+		if template != nil {
+			if template.Policy, err = normalizePolicy(template.Policy); err != nil {
+				return nil, fmt.Errorf("the bundle is not valid: policy template %d: %w", i, err)
+			}
+		}
+	}
+	for i, role := range bundle.CustomRoles {
+		if role == nil || role.Name == "" {
+			return nil, fmt.Errorf("the bundle is not valid: custom role %d has no name", i)
+		}
+	}
+	for i, template := range bundle.PolicyTemplates {
+		if template == nil || template.Name == "" {
+			return nil, fmt.Errorf("the bundle is not valid: policy template %d has no name", i)
+		}
+	}
+	return bundle, nil
+}
+
+// normalizePolicy returns a copy of the policy in which every value has the type used by
+// encoding/json.
+func normalizePolicy(policy Policy) (Policy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	buffer, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	result := Policy{}
+	if err = json.Unmarshal(buffer, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
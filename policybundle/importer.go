@@ -0,0 +1,535 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policybundle
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// The types of the objects imported from a bundle.
+const (
+	ObjectTypeCustomRole     = "custom_role"
+	ObjectTypePolicyTemplate = "policy_template"
+	ObjectTypePolicy         = "policy"
+)
+
+// The actions taken on the objects imported from a bundle.
+const (
+	ActionCreated   = "created"
+	ActionUpdated   = "updated"
+	ActionUnchanged = "unchanged"
+)
+
+// Change : The action taken on an object of a bundle during an import.
+type Change struct {
+	// The type of the object (one of the ObjectType constants).
+	ObjectType string `json:"object_type"`
+
+	// The name of the custom role or policy template, or the type, subject and resource
+	// of the policy.
+	Name string `json:"name"`
+
+	// The ID of the object in the target account (empty for objects created in a dry run).
+	ID string `json:"id,omitempty"`
+
+	// The action taken on the object (one of the Action constants).
+	Action string `json:"action"`
+}
+
+// String returns a one-line description of the change.
+func (change *Change) String() string {
+	return fmt.Sprintf("%s %s '%s'", change.Action, strings.ReplaceAll(change.ObjectType, "_", " "), change.Name)
+}
+
+// Result : The outcome of the import of a bundle.
+type Result struct {
+	// The account into which the bundle was imported.
+	AccountID string `json:"account_id"`
+
+	// Whether the import was a dry run, in which case the changes were not made.
+	DryRun bool `json:"dry_run"`
+
+	// The changes, custom roles first, then policy templates and policies, in the order of
+	// the bundle.
+	Changes []Change `json:"changes"`
+}
+
+// GetChanges returns the changes with the specified action (one of the Action constants).
+func (result *Result) GetChanges(action string) []Change {
+	changes := []Change{}
+	for _, change := range result.Changes {
+		if change.Action == action {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// String returns a summary of the changes, e.g. "2 created, 1 updated, 5 unchanged".
+func (result *Result) String() string {
+	summary := fmt.Sprintf("%d created, %d updated, %d unchanged", len(result.GetChanges(ActionCreated)),
+		len(result.GetChanges(ActionUpdated)), len(result.GetChanges(ActionUnchanged)))
+	if result.DryRun {
+		summary += " (dry run)"
+	}
+	return summary
+}
+
+// ImporterOptions : The options used to create an Importer with NewImporter().
+type ImporterOptions struct {
+	// The client used to import the policies, custom roles and policy templates (required).
+	IamPolicyManagement *iampolicymanagementv1.IamPolicyManagementV1
+
+	// The client used to resolve ${access_group:NAME} references. Required only if the
+	// bundle contains such references.
+	IamAccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+
+	// The client used to resolve ${service_instance:NAME} and ${service_instance_crn:NAME}
+	// references. Required only if the bundle contains such references.
+	ResourceController *resourcecontrollerv2.ResourceControllerV2
+
+	// The account into which the bundle is imported (required).
+	AccountID string
+
+	// The values of the ${var:NAME} references, indexed by NAME.
+	Variables map[string]string
+
+	// If true, the changes are computed and returned, but not made.
+	DryRun bool
+}
+
+// Importer : Imports the policies, custom roles and policy templates of a Bundle into an account.
+type Importer struct {
+	options ImporterOptions
+}
+
+// NewImporter returns a new Importer.
+func NewImporter(options *ImporterOptions) (*Importer, error) {
+	if options == nil || options.IamPolicyManagement == nil {
+		return nil, fmt.Errorf("an IamPolicyManagement client is required")
+	}
+	if options.AccountID == "" {
+		return nil, fmt.Errorf("an account ID is required")
+	}
+	return &Importer{options: *options}, nil
+}
+
+// Import imports the bundle into the account:
+//
+//  1. Every reference of the bundle is resolved in the account. Nothing is changed if a
+//     reference cannot be resolved.
+//  2. Custom roles are matched by name, and created or replaced.
+//  3. Policy templates are matched by name, and created or given a new version.
+//  4. Policies are matched by type, subject and resource, and created or replaced.
+//
+// Objects of the account that are not in the bundle are left as they are.
+func (importer *Importer) Import(ctx context.Context, bundle *Bundle) (*Result, error) {
+	if bundle == nil {
+		return nil, fmt.Errorf("a bundle is required")
+	}
+	if bundle.Version < 1 || bundle.Version > BundleVersion {
+		return nil, fmt.Errorf("the bundle version %d is not supported (supported versions: 1 to %d)", bundle.Version, BundleVersion)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resolved, err := importer.resolve(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := parsePolicies(resolved.Policies)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		AccountID: importer.options.AccountID,
+		DryRun:    importer.options.DryRun,
+		Changes:   []Change{},
+	}
+	if err = importer.importCustomRoles(ctx, resolved.CustomRoles, result); err != nil {
+		return nil, err
+	}
+	if err = importer.importPolicyTemplates(ctx, resolved.PolicyTemplates, result); err != nil {
+		return nil, err
+	}
+	if err = importer.importPolicies(ctx, policies, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// resolve returns a copy of the bundle in which the references are replaced by their value in
+// the account. It returns an error that lists the references that cannot be resolved.
+func (importer *Importer) resolve(ctx context.Context, bundle *Bundle) (*Bundle, error) {
+	// Find the kinds of references used by the bundle, to list only the objects needed.
+	kinds := map[string]bool{}
+	rewriteBundle(bundle, func(s string) string {
+		for _, match := range referencePattern.FindAllStringSubmatch(s, -1) {
+			kinds[match[1]] = true
+		}
+		return s
+	})
+	var iamAccessGroups *iamaccessgroupsv2.IamAccessGroupsV2
+	if kinds[ReferenceAccessGroup] {
+		iamAccessGroups = importer.options.IamAccessGroups
+	}
+	var resourceController *resourcecontrollerv2.ResourceControllerV2
+	if kinds[ReferenceServiceInstance] || kinds[ReferenceServiceInstanceCRN] {
+		resourceController = importer.options.ResourceController
+	}
+	objects, err := listObjects(ctx, iamAccessGroups, resourceController, importer.options.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A reference to a name shared by several objects is ambiguous, and is not resolved.
+	values := map[string]string{}
+	ambiguous := map[string]bool{}
+	for _, object := range objects {
+		if _, ok := values[object.reference]; ok {
+			ambiguous[object.reference] = true
+		}
+		values[object.reference] = object.value
+	}
+	for reference := range ambiguous {
+		delete(values, reference)
+	}
+	values[Reference(ReferenceAccountID, "")] = importer.options.AccountID
+	for name, value := range importer.options.Variables {
+		values[Reference(ReferenceVariable, name)] = value
+	}
+
+	unresolved := map[string]bool{}
+	resolved := rewriteBundle(bundle, func(s string) string {
+		return referencePattern.ReplaceAllStringFunc(s, func(reference string) string {
+			value, ok := values[reference]
+			if !ok {
+				unresolved[reference] = true
+			}
+			return value
+		})
+	})
+	if len(unresolved) > 0 {
+		references := make([]string, 0, len(unresolved))
+		for reference := range unresolved {
+			references = append(references, reference)
+		}
+		sort.Strings(references)
+		return nil, fmt.Errorf("the references %s cannot be resolved in account '%s'",
+			strings.Join(references, ", "), importer.options.AccountID)
+	}
+	return resolved, nil
+}
+
+// rewriteBundle returns a copy of the bundle in which the strings of the policy templates and
+// policies are replaced by the result of "rewrite".
+func rewriteBundle(bundle *Bundle, rewrite func(string) string) *Bundle {
+	result := *bundle
+	result.PolicyTemplates = make([]*PolicyTemplate, len(bundle.PolicyTemplates))
+	for i, template := range bundle.PolicyTemplates {
+		result.PolicyTemplates[i] = &PolicyTemplate{
+			Name:        template.Name,
+			Description: rewrite(template.Description),
+			Policy:      rewriteStrings(template.Policy, rewrite).(Policy),
+		}
+	}
+	result.Policies = make([]Policy, len(bundle.Policies))
+	for i, policy := range bundle.Policies {
+		result.Policies[i] = rewriteStrings(policy, rewrite).(Policy)
+	}
+	return &result
+}
+
+// parsedPolicy : A policy of a bundle or of the account, in the forms needed to compare and
+// write it.
+type parsedPolicy struct {
+	key            string
+	templatePolicy *iampolicymanagementv1.TemplatePolicy
+	policy         Policy
+}
+
+func parsePolicy(model interface{}) (*parsedPolicy, error) {
+	templatePolicy, err := toTemplatePolicy(model)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := newPolicy(templatePolicy)
+	if err != nil {
+		return nil, err
+	}
+	return &parsedPolicy{key: getPolicyKey(templatePolicy), templatePolicy: templatePolicy, policy: policy}, nil
+}
+
+// parsePolicies parses the policies of a bundle. It returns an error if a policy is not valid,
+// or if two policies have the same type, subject and resource.
+func parsePolicies(policies []Policy) ([]*parsedPolicy, error) {
+	result := make([]*parsedPolicy, len(policies))
+	indexes := map[string]int{}
+	for i, policy := range policies {
+		parsed, err := parsePolicy(policy)
+		if err != nil {
+			return nil, fmt.Errorf("policy %d of the bundle is not valid: %w", i, err)
+		}
+		if j, ok := indexes[parsed.key]; ok {
+			return nil, fmt.Errorf("policies %d and %d of the bundle apply to the same subject and resource: %s", j, i, parsed.key)
+		}
+		indexes[parsed.key] = i
+		result[i] = parsed
+	}
+	return result, nil
+}
+
+func (importer *Importer) importCustomRoles(ctx context.Context, roles []*CustomRole, result *Result) error {
+	iamPolicyManagement := importer.options.IamPolicyManagement
+	accountID := importer.options.AccountID
+	collection, _, err := iamPolicyManagement.ListRolesWithContext(ctx, iamPolicyManagement.NewListRolesOptions().
+		SetAccountID(accountID))
+	if err != nil {
+		return fmt.Errorf("error listing the custom roles of account '%s': %w", accountID, err)
+	}
+	existingRoles := map[string]iampolicymanagementv1.CustomRole{}
+	for _, role := range collection.CustomRoles {
+		existingRoles[core.StringNilMapper(role.Name)] = role
+	}
+
+	for _, role := range roles {
+		change := Change{ObjectType: ObjectTypeCustomRole, Name: role.Name, Action: ActionUnchanged}
+		existing, ok := existingRoles[role.Name]
+		switch {
+		case !ok:
+			change.Action = ActionCreated
+			if importer.options.DryRun {
+				break
+			}
+			options := iamPolicyManagement.NewCreateRoleOptions(role.DisplayName, role.Actions, role.Name, accountID, role.ServiceName)
+			if role.Description != "" {
+				options.SetDescription(role.Description)
+			}
+			created, _, err := iamPolicyManagement.CreateRoleWithContext(ctx, options)
+			if err != nil {
+				return fmt.Errorf("error creating custom role '%s': %w", role.Name, err)
+			}
+			change.ID = core.StringNilMapper(created.ID)
+		case core.StringNilMapper(existing.ServiceName) != role.ServiceName:
+			return fmt.Errorf("the custom role '%s' of account '%s' applies to service '%s' instead of '%s'",
+				role.Name, accountID, core.StringNilMapper(existing.ServiceName), role.ServiceName)
+		case core.StringNilMapper(existing.DisplayName) != role.DisplayName ||
+			core.StringNilMapper(existing.Description) != role.Description || !sameStrings(existing.Actions, role.Actions):
+			change.ID = core.StringNilMapper(existing.ID)
+			change.Action = ActionUpdated
+			if importer.options.DryRun {
+				break
+			}
+			_, response, err := iamPolicyManagement.GetRoleWithContext(ctx, iamPolicyManagement.NewGetRoleOptions(change.ID))
+			if err != nil {
+				return fmt.Errorf("error retrieving custom role '%s': %w", role.Name, err)
+			}
+			options := iamPolicyManagement.NewReplaceRoleOptions(change.ID, response.GetHeaders().Get("ETag"),
+				role.DisplayName, role.Actions)
+			// The description is always replaced, so that an empty description clears the existing one.
+			options.SetDescription(role.Description)
+			if _, _, err = iamPolicyManagement.ReplaceRoleWithContext(ctx, options); err != nil {
+				return fmt.Errorf("error updating custom role '%s': %w", role.Name, err)
+			}
+		default:
+			change.ID = core.StringNilMapper(existing.ID)
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return nil
+}
+
+func (importer *Importer) importPolicyTemplates(ctx context.Context, templates []*PolicyTemplate, result *Result) error {
+	if len(templates) == 0 {
+		return nil
+	}
+	iamPolicyManagement := importer.options.IamPolicyManagement
+	accountID := importer.options.AccountID
+	pager, err := iamPolicyManagement.NewPolicyTemplatesPager(iamPolicyManagement.NewListPolicyTemplatesOptions(accountID))
+	if err != nil {
+		return err
+	}
+	list, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing the policy templates of account '%s': %w", accountID, err)
+	}
+	existingTemplates := map[string]iampolicymanagementv1.PolicyTemplate{}
+	for _, template := range list {
+		existingTemplates[core.StringNilMapper(template.Name)] = template
+	}
+
+	for _, template := range templates {
+		parsed, err := parsePolicy(template.Policy)
+		if err != nil {
+			return fmt.Errorf("the policy of policy template '%s' is not valid: %w", template.Name, err)
+		}
+		change := Change{ObjectType: ObjectTypePolicyTemplate, Name: template.Name, Action: ActionUnchanged}
+		existing, ok := existingTemplates[template.Name]
+		if ok {
+			change.ID = core.StringNilMapper(existing.ID)
+			existingPolicy, err := newPolicy(existing.Policy)
+			if err != nil || core.StringNilMapper(existing.Description) != template.Description ||
+				!reflect.DeepEqual(existingPolicy, parsed.policy) {
+				change.Action = ActionUpdated
+			}
+		} else {
+			change.Action = ActionCreated
+		}
+
+		if !importer.options.DryRun {
+			switch change.Action {
+			case ActionCreated:
+				options := iamPolicyManagement.NewCreatePolicyTemplateOptions(template.Name, accountID, parsed.templatePolicy)
+				if template.Description != "" {
+					options.SetDescription(template.Description)
+				}
+				created, _, err := iamPolicyManagement.CreatePolicyTemplateWithContext(ctx, options)
+				if err != nil {
+					return fmt.Errorf("error creating policy template '%s': %w", template.Name, err)
+				}
+				change.ID = core.StringNilMapper(created.ID)
+			case ActionUpdated:
+				options := iamPolicyManagement.NewCreatePolicyTemplateVersionOptions(change.ID, parsed.templatePolicy)
+				if template.Description != "" {
+					options.SetDescription(template.Description)
+				}
+				if _, _, err = iamPolicyManagement.CreatePolicyTemplateVersionWithContext(ctx, options); err != nil {
+					return fmt.Errorf("error creating a version of policy template '%s': %w", template.Name, err)
+				}
+			}
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return nil
+}
+
+func (importer *Importer) importPolicies(ctx context.Context, policies []*parsedPolicy, result *Result) error {
+	if len(policies) == 0 {
+		return nil
+	}
+	iamPolicyManagement := importer.options.IamPolicyManagement
+	accountID := importer.options.AccountID
+	pager, err := iamPolicyManagement.NewV2PoliciesPager(iamPolicyManagement.NewListV2PoliciesOptions(accountID).
+		SetState(iampolicymanagementv1.ListV2PoliciesOptionsStateActiveConst))
+	if err != nil {
+		return err
+	}
+	list, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing the policies of account '%s': %w", accountID, err)
+	}
+	// The policies of the account, indexed by key. Policies created from templates are managed
+	// through their templates, and are ignored.
+	type existingPolicy struct {
+		id     string
+		policy Policy
+	}
+	existingPolicies := map[string][]existingPolicy{}
+	for i := range list {
+		if list[i].Template != nil {
+			continue
+		}
+		parsed, err := parsePolicy(&list[i])
+		if err != nil {
+			return fmt.Errorf("error reading policy '%s' of account '%s': %w", core.StringNilMapper(list[i].ID), accountID, err)
+		}
+		existingPolicies[parsed.key] = append(existingPolicies[parsed.key],
+			existingPolicy{id: core.StringNilMapper(list[i].ID), policy: parsed.policy})
+	}
+
+	for _, policy := range policies {
+		change := Change{ObjectType: ObjectTypePolicy, Name: policy.key, Action: ActionCreated}
+		// Prefer an identical policy of the account, if there are several candidates.
+		candidates := existingPolicies[policy.key]
+		if len(candidates) > 0 {
+			change.ID = candidates[0].id
+			change.Action = ActionUpdated
+			for _, candidate := range candidates {
+				if reflect.DeepEqual(candidate.policy, policy.policy) {
+					change.ID = candidate.id
+					change.Action = ActionUnchanged
+					break
+				}
+			}
+		}
+		if !importer.options.DryRun {
+			if err = importer.writePolicy(ctx, policy, &change); err != nil {
+				return err
+			}
+		}
+		result.Changes = append(result.Changes, change)
+	}
+	return nil
+}
+
+// writePolicy creates or replaces the policy, depending on the action of "change".
+func (importer *Importer) writePolicy(ctx context.Context, policy *parsedPolicy, change *Change) error {
+	iamPolicyManagement := importer.options.IamPolicyManagement
+	templatePolicy := policy.templatePolicy
+	switch change.Action {
+	case ActionCreated:
+		options := iamPolicyManagement.NewCreateV2PolicyOptions(templatePolicy.Control, *templatePolicy.Type)
+		options.Description = templatePolicy.Description
+		options.Subject = templatePolicy.Subject
+		options.Resource = templatePolicy.Resource
+		options.Pattern = templatePolicy.Pattern
+		options.Rule = templatePolicy.Rule
+		created, _, err := iamPolicyManagement.CreateV2PolicyWithContext(ctx, options)
+		if err != nil {
+			return fmt.Errorf("error creating policy '%s': %w", policy.key, err)
+		}
+		change.ID = core.StringNilMapper(created.ID)
+	case ActionUpdated:
+		_, response, err := iamPolicyManagement.GetV2PolicyWithContext(ctx, iamPolicyManagement.NewGetV2PolicyOptions(change.ID))
+		if err != nil {
+			return fmt.Errorf("error retrieving policy '%s': %w", change.ID, err)
+		}
+		options := iamPolicyManagement.NewReplaceV2PolicyOptions(change.ID, response.GetHeaders().Get("ETag"),
+			templatePolicy.Control, *templatePolicy.Type)
+		options.Description = templatePolicy.Description
+		options.Subject = templatePolicy.Subject
+		options.Resource = templatePolicy.Resource
+		options.Pattern = templatePolicy.Pattern
+		options.Rule = templatePolicy.Rule
+		if _, _, err = iamPolicyManagement.ReplaceV2PolicyWithContext(ctx, options); err != nil {
+			return fmt.Errorf("error updating policy '%s': %w", change.ID, err)
+		}
+	}
+	return nil
+}
+
+// sameStrings returns true if "a" and "b" contain the same strings, in any order.
+func sameStrings(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string{}, a...)
+	sortedB := append([]string{}, b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	return reflect.DeepEqual(sortedA, sortedB)
+}
@@ -0,0 +1,235 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package policybundle_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/IBM/platform-services-go-sdk/policybundle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (a *account) newImporter(t *testing.T, dryRun bool) *policybundle.Importer {
+	importer, err := policybundle.NewImporter(&policybundle.ImporterOptions{
+		IamPolicyManagement: a.iamPolicyManagement,
+		IamAccessGroups:     a.iamAccessGroups,
+		ResourceController:  a.resourceController,
+		AccountID:           a.server.AccountID(),
+		Variables:           map[string]string{"admin": "IBMid-target-admin"},
+		DryRun:              dryRun,
+	})
+	require.Nil(t, err)
+	return importer
+}
+
+func (a *account) listPolicies(t *testing.T) []iampolicymanagementv1.V2PolicyTemplateMetaData {
+	pager, err := a.iamPolicyManagement.NewV2PoliciesPager(a.iamPolicyManagement.NewListV2PoliciesOptions(a.server.AccountID()))
+	require.Nil(t, err)
+	policies, err := pager.GetAll()
+	require.Nil(t, err)
+	return policies
+}
+
+func getActions(result *policybundle.Result) map[string][]string {
+	actions := map[string][]string{}
+	for _, change := range result.Changes {
+		actions[change.ObjectType] = append(actions[change.ObjectType], change.Action)
+	}
+	return actions
+}
+
+func TestImport(t *testing.T) {
+	source := newSourceAccount(t)
+	target := newAccount(t, targetAccountID)
+	group := target.createAccessGroup(t, "auditors")
+	instance := target.createInstance(t, "keys")
+
+	result, err := target.newImporter(t, false).Import(context.Background(), source.export(t))
+	require.Nil(t, err)
+	assert.Equal(t, targetAccountID, result.AccountID)
+	assert.Equal(t, "4 created, 0 updated, 0 unchanged", result.String())
+	assert.Equal(t, "created custom role 'KeyReader'", result.Changes[0].String())
+	for _, change := range result.Changes {
+		assert.NotEmpty(t, change.ID)
+	}
+
+	// The references are resolved in the target account.
+	policies := target.listPolicies(t)
+	require.Len(t, policies, 2)
+	groupPolicy := policies[0]
+	assert.Equal(t, group, groupPolicy.Subject.Attributes[0].Value)
+	assert.Equal(t, targetAccountID, groupPolicy.Resource.Attributes[0].Value)
+	assert.Equal(t, *instance.GUID, groupPolicy.Resource.Attributes[2].Value)
+	control := groupPolicy.Control.(*iampolicymanagementv1.ControlResponse)
+	assert.Equal(t, target.customRoleCRN("KeyReader"), *control.Grant.Roles[0].RoleID)
+	assert.Equal(t, "IBMid-target-admin", policies[1].Subject.Attributes[0].Value)
+	templates, _, err := target.iamPolicyManagement.ListPolicyTemplates(target.iamPolicyManagement.NewListPolicyTemplatesOptions(targetAccountID))
+	require.Nil(t, err)
+	require.Len(t, templates.PolicyTemplates, 1)
+	assert.Equal(t, "Access to kms", *templates.PolicyTemplates[0].Description)
+
+	// Importing the same bundle again changes nothing.
+	result, err = target.newImporter(t, false).Import(context.Background(), source.export(t))
+	require.Nil(t, err)
+	assert.Equal(t, "0 created, 0 updated, 4 unchanged", result.String())
+
+	// Changes to the source account are applied to the objects created by the first import.
+	roles, _, err := source.iamPolicyManagement.ListRoles(source.iamPolicyManagement.NewListRolesOptions().SetAccountID(sourceAccountID))
+	require.Nil(t, err)
+	role, response, err := source.iamPolicyManagement.GetRole(source.iamPolicyManagement.NewGetRoleOptions(*roles.CustomRoles[0].ID))
+	require.Nil(t, err)
+	_, _, err = source.iamPolicyManagement.ReplaceRole(source.iamPolicyManagement.NewReplaceRoleOptions(*role.ID,
+		response.GetHeaders().Get("ETag"), *role.DisplayName, []string{"kms.secrets.read", "kms.secrets.list"}))
+	require.Nil(t, err)
+	source.createPolicy(t, "iam_id", "IBMid-source-admin", map[string]string{"serviceName": "kms"}, editorRole)
+	source.createPolicyTemplate(t, "cos-viewer", "cloud-object-storage", viewerRole)
+	bundle := source.export(t)
+	bundle.Policies[1]["description"] = "Administrator of the account"
+
+	result, err = target.newImporter(t, true).Import(context.Background(), bundle)
+	require.Nil(t, err)
+	assert.Equal(t, "2 created, 2 updated, 2 unchanged (dry run)", result.String())
+	assert.Len(t, target.listPolicies(t), 2)
+
+	result, err = target.newImporter(t, false).Import(context.Background(), bundle)
+	require.Nil(t, err)
+	assert.Equal(t, map[string][]string{
+		policybundle.ObjectTypeCustomRole:     {policybundle.ActionUpdated},
+		policybundle.ObjectTypePolicyTemplate: {policybundle.ActionCreated, policybundle.ActionUnchanged},
+		policybundle.ObjectTypePolicy: {policybundle.ActionUnchanged, policybundle.ActionUpdated,
+			policybundle.ActionCreated},
+	}, getActions(result))
+	assert.Len(t, result.GetChanges(policybundle.ActionUpdated), 2)
+
+	role, _, err = target.iamPolicyManagement.GetRole(target.iamPolicyManagement.NewGetRoleOptions(result.Changes[0].ID))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"kms.secrets.read", "kms.secrets.list"}, role.Actions)
+	policy, _, err := target.iamPolicyManagement.GetV2Policy(target.iamPolicyManagement.NewGetV2PolicyOptions(result.Changes[4].ID))
+	require.Nil(t, err)
+	assert.Equal(t, "Administrator of the account", *policy.Description)
+
+	result, err = target.newImporter(t, false).Import(context.Background(), bundle)
+	require.Nil(t, err)
+	assert.Equal(t, "0 created, 0 updated, 6 unchanged", result.String())
+}
+
+func TestImportInactiveInstance(t *testing.T) {
+	source := newAccount(t, sourceAccountID)
+	sourceInstance := source.createInstance(t, "suspended-keys")
+	source.createPolicy(t, "iam_id", "IBMid-source-admin", map[string]string{"serviceName": "kms", "serviceInstance": *sourceInstance.GUID},
+		viewerRole)
+	target := newAccount(t, targetAccountID)
+	instance := target.createInstance(t, "suspended-keys")
+	require.True(t, target.server.DeactivateResourceInstance(*instance.GUID))
+
+	result, err := target.newImporter(t, false).Import(context.Background(), source.export(t))
+	require.Nil(t, err)
+	assert.Equal(t, "1 created, 0 updated, 0 unchanged", result.String())
+	policies := target.listPolicies(t)
+	require.Len(t, policies, 1)
+	assert.Equal(t, *instance.GUID, policies[0].Resource.Attributes[2].Value)
+}
+
+func TestImportErrors(t *testing.T) {
+	source := newSourceAccount(t)
+	target := newAccount(t, targetAccountID)
+	_, err := policybundle.NewImporter(&policybundle.ImporterOptions{AccountID: targetAccountID})
+	assert.NotNil(t, err)
+	_, err = policybundle.NewImporter(&policybundle.ImporterOptions{IamPolicyManagement: target.iamPolicyManagement})
+	assert.NotNil(t, err)
+
+	// Nothing is imported if a reference cannot be resolved.
+	bundle := source.export(t)
+	importer := target.newImporter(t, false)
+	_, err = importer.Import(context.Background(), bundle)
+	require.NotNil(t, err)
+	assert.Equal(t, "the references ${access_group:auditors}, ${service_instance:keys} cannot be resolved in account '"+
+		targetAccountID+"'", err.Error())
+	assert.Empty(t, target.listPolicies(t))
+
+	// A name shared by several objects is ambiguous.
+	target.createAccessGroup(t, "auditors")
+	target.createInstance(t, "keys")
+	target.createInstance(t, "keys")
+	_, err = importer.Import(context.Background(), bundle)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the references ${service_instance:keys} cannot be resolved")
+
+	bundle.Policies = []policybundle.Policy{bundle.Policies[1], bundle.Policies[1]}
+	_, err = importer.Import(context.Background(), bundle)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "policies 0 and 1 of the bundle apply to the same subject and resource")
+
+	bundle = &policybundle.Bundle{Version: 1, Policies: []policybundle.Policy{{"type": "access"}}}
+	_, err = importer.Import(context.Background(), bundle)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "policy 0 of the bundle is not valid: the policy grants no role")
+	_, err = importer.Import(context.Background(), &policybundle.Bundle{Version: 3})
+	require.NotNil(t, err)
+
+	// A custom role cannot be moved to another service.
+	target.server.RegisterCustomRole(iampolicymanagementv1.CustomRole{
+		Name:        core.StringPtr("KeyReader"),
+		DisplayName: core.StringPtr("KeyReader"),
+		Actions:     []string{"cloud-object-storage.object.get"},
+		AccountID:   core.StringPtr(targetAccountID),
+		ServiceName: core.StringPtr("cloud-object-storage"),
+	})
+	_, err = importer.Import(context.Background(), &policybundle.Bundle{Version: 1, CustomRoles: source.export(t).CustomRoles})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "applies to service 'cloud-object-storage' instead of 'kms'")
+
+	target.server.Fail(http.MethodPost, "/v1/policy_templates", http.StatusForbidden, 1)
+	_, err = importer.Import(context.Background(), &policybundle.Bundle{Version: 1, PolicyTemplates: source.export(t).PolicyTemplates})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error creating policy template 'kms-viewer'")
+}
+
+func TestImportCustomRoleWithoutDescription(t *testing.T) {
+	target := newAccount(t, targetAccountID)
+	target.server.RegisterCustomRole(iampolicymanagementv1.CustomRole{
+		Name:        core.StringPtr("KeyReader"),
+		DisplayName: core.StringPtr("KeyReader"),
+		Description: core.StringPtr("Reads the secrets of a key"),
+		Actions:     []string{"kms.secrets.read"},
+		AccountID:   core.StringPtr(targetAccountID),
+		ServiceName: core.StringPtr("kms"),
+	})
+	bundle := &policybundle.Bundle{Version: 1, CustomRoles: []*policybundle.CustomRole{{
+		Name:        "KeyReader",
+		DisplayName: "KeyReader",
+		ServiceName: "kms",
+		Actions:     []string{"kms.secrets.read"},
+	}}}
+
+	// The empty description of the bundle replaces the existing one, so a second import changes nothing.
+	result, err := target.newImporter(t, false).Import(context.Background(), bundle)
+	require.Nil(t, err)
+	assert.Equal(t, "0 created, 1 updated, 0 unchanged", result.String())
+	role, _, err := target.iamPolicyManagement.GetRole(target.iamPolicyManagement.NewGetRoleOptions(result.Changes[0].ID))
+	require.Nil(t, err)
+	assert.Empty(t, core.StringNilMapper(role.Description))
+
+	result, err = target.newImporter(t, false).Import(context.Background(), bundle)
+	require.Nil(t, err)
+	assert.Equal(t, "0 created, 0 updated, 1 unchanged", result.String())
+}
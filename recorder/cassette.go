@@ -0,0 +1,127 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"unicode/utf8"
+)
+
+// bodyEncodingBase64 is the encoding used for bodies that are not valid UTF-8.
+const bodyEncodingBase64 = "base64"
+
+// Cassette : The set of interactions recorded in a cassette file.
+type Cassette struct {
+	// The recorded interactions, in the order in which they occurred.
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Interaction : A single recorded request and the response that was received for it.
+type Interaction struct {
+	Request  *RecordedRequest  `json:"request"`
+	Response *RecordedResponse `json:"response"`
+}
+
+// RecordedRequest : A request as it is stored in a cassette.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+
+	// "base64" if Body contains the base64 encoding of a binary body.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// RecordedResponse : A response as it is stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+
+	// "base64" if Body contains the base64 encoding of a binary body.
+	BodyEncoding string `json:"body_encoding,omitempty"`
+}
+
+// GetBody returns the decoded request body.
+func (request *RecordedRequest) GetBody() []byte {
+	return decodeBody(request.Body, request.BodyEncoding)
+}
+
+// SetBody stores "body" in the request, encoding it if necessary.
+func (request *RecordedRequest) SetBody(body []byte) {
+	request.Body, request.BodyEncoding = encodeBody(body)
+}
+
+// GetBody returns the decoded response body.
+func (response *RecordedResponse) GetBody() []byte {
+	return decodeBody(response.Body, response.BodyEncoding)
+}
+
+// SetBody stores "body" in the response, encoding it if necessary.
+func (response *RecordedResponse) SetBody(body []byte) {
+	response.Body, response.BodyEncoding = encodeBody(body)
+}
+
+// LoadCassette reads the cassette stored in the file at "path".
+func LoadCassette(path string) (*Cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	err = json.Unmarshal(b, cassette)
+	if err != nil {
+		return nil, fmt.Errorf("the cassette file '%s' is not valid: %s", path, err.Error())
+	}
+	return cassette, nil
+}
+
+// Save writes the cassette to the file at "path", creating its directory if needed.
+func (cassette *Cassette) Save(path string) error {
+	b, err := json.MarshalIndent(cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0644)
+}
+
+func encodeBody(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), bodyEncodingBase64
+}
+
+func decodeBody(body string, encoding string) []byte {
+	if encoding == bodyEncodingBase64 {
+		b, err := base64.StdEncoding.DecodeString(body)
+		if err == nil {
+			return b
+		}
+	}
+	return []byte(body)
+}
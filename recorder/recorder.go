@@ -0,0 +1,386 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package recorder provides an http.RoundTripper that records the interactions between
+// a service client and a live service into a cassette file, and replays them later
+// without network access.
+//
+// Credentials (bearer tokens, API keys, refresh tokens and passwords) are scrubbed from
+// each interaction before it is stored, along with any account IDs listed in Options.AccountIDs.
+//
+// A Recorder is typically installed on each service client used by a test:
+//
+//	rec, err := recorder.New("testdata/resource_controller.json", &recorder.Options{
+//		Mode:       recorder.ModeRecordOnce,
+//		AccountIDs: []string{accountID},
+//	})
+//	...
+//	defer rec.Stop()
+//	rec.Install(resourceController.Service)
+package recorder
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Mode determines whether a Recorder sends requests to the live service, replays them
+// from its cassette, or both.
+type Mode int
+
+const (
+	// ModeReplay serves every request from the cassette; requests are never sent to the live service.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends every request to the live service and records the interactions in
+	// the cassette, replacing any existing content when the Recorder is stopped.
+	ModeRecord
+
+	// ModeRecordOnce behaves as ModeReplay if the cassette file exists and as ModeRecord otherwise.
+	ModeRecordOnce
+
+	// ModePassthrough sends every request to the live service without recording it.
+	ModePassthrough
+)
+
+// ModeEnvironmentVariable is the environment variable read by GetModeFromEnvironment().
+const ModeEnvironmentVariable = "IBM_RECORDER_MODE"
+
+var modeNames = map[Mode]string{
+	ModeReplay:      "replay",
+	ModeRecord:      "record",
+	ModeRecordOnce:  "record_once",
+	ModePassthrough: "passthrough",
+}
+
+// String returns the name of the mode (e.g. "replay").
+func (mode Mode) String() string {
+	if name, ok := modeNames[mode]; ok {
+		return name
+	}
+	return "Mode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+// ParseMode returns the Mode with the specified name: "replay", "record", "record_once" or "passthrough".
+func ParseMode(name string) (Mode, error) {
+	for mode, modeName := range modeNames {
+		if strings.EqualFold(name, modeName) {
+			return mode, nil
+		}
+	}
+	return ModeReplay, fmt.Errorf("'%s' is not a valid recorder mode", name)
+}
+
+// GetModeFromEnvironment returns the Mode named by the IBM_RECORDER_MODE environment variable,
+// or "defaultMode" if the variable is not set.
+// This allows a test suite to be re-recorded against the live services without code changes.
+func GetModeFromEnvironment(defaultMode Mode) (Mode, error) {
+	name := os.Getenv(ModeEnvironmentVariable)
+	if name == "" {
+		return defaultMode, nil
+	}
+	return ParseMode(name)
+}
+
+// Matcher reports whether a live request matches a request recorded in a cassette.
+// Both requests have been scrubbed before the Matcher is invoked.
+type Matcher func(live *RecordedRequest, recorded *RecordedRequest) bool
+
+// DefaultMatcher matches requests with the same method, URL and body.
+func DefaultMatcher(live *RecordedRequest, recorded *RecordedRequest) bool {
+	return MatchMethodAndURL(live, recorded) && live.Body == recorded.Body
+}
+
+// MatchMethodAndURL matches requests with the same method and URL, regardless of their bodies.
+func MatchMethodAndURL(live *RecordedRequest, recorded *RecordedRequest) bool {
+	return live.Method == recorded.Method && live.URL == recorded.URL
+}
+
+// Options : The options used to configure a Recorder.
+type Options struct {
+	// The mode of the Recorder (defaults to ModeReplay).
+	Mode Mode
+
+	// The RoundTripper used by RoundTrip() to send requests to the live service
+	// (defaults to http.DefaultTransport).
+	// RoundTrippers returned by Wrap() use the RoundTripper that they wrap instead.
+	Transport http.RoundTripper
+
+	// Account IDs that are replaced with ScrubbedAccountID in each interaction.
+	AccountIDs []string
+
+	// Additional scrubbers, applied after the built-in scrubbing of credentials and account IDs.
+	Scrubbers []Scrubber
+
+	// The function used to match live requests with recorded requests during replay
+	// (defaults to DefaultMatcher).
+	Matcher Matcher
+}
+
+// Recorder is an http.RoundTripper that records interactions into a cassette file
+// or replays them from it.
+// Each recorded interaction is replayed at most once, so a cassette may contain several
+// interactions for the same request (e.g. while polling for a state change).
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrubbers []Scrubber
+	matcher   Matcher
+
+	mutex    sync.Mutex
+	cassette *Cassette
+	used     []bool
+}
+
+// New returns a Recorder that uses the cassette file at "path".
+// In ModeReplay, or in ModeRecordOnce when the file exists, the cassette is loaded immediately
+// and an error is returned if it cannot be read.
+func New(path string, options *Options) (*Recorder, error) {
+	if options == nil {
+		options = &Options{}
+	}
+
+	recorder := &Recorder{
+		path:      path,
+		mode:      options.Mode,
+		transport: options.Transport,
+		matcher:   options.Matcher,
+		cassette:  &Cassette{},
+	}
+	if recorder.transport == nil {
+		recorder.transport = http.DefaultTransport
+	}
+	if recorder.matcher == nil {
+		recorder.matcher = DefaultMatcher
+	}
+
+	recorder.scrubbers = append(recorder.scrubbers, scrubCredentials)
+	for _, accountID := range options.AccountIDs {
+		recorder.scrubbers = append(recorder.scrubbers, ReplaceString(accountID, ScrubbedAccountID))
+	}
+	recorder.scrubbers = append(recorder.scrubbers, options.Scrubbers...)
+
+	if recorder.mode == ModeRecordOnce {
+		_, err := os.Stat(path)
+		switch {
+		case err == nil:
+			recorder.mode = ModeReplay
+		case errors.Is(err, os.ErrNotExist):
+			recorder.mode = ModeRecord
+		default:
+			return nil, err
+		}
+	}
+
+	if recorder.mode == ModeReplay {
+		cassette, err := LoadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		recorder.cassette = cassette
+		recorder.used = make([]bool, len(cassette.Interactions))
+	}
+
+	return recorder, nil
+}
+
+// Mode returns the effective mode of the Recorder.
+// For a Recorder created with ModeRecordOnce, this is either ModeReplay or ModeRecord.
+func (recorder *Recorder) Mode() Mode {
+	return recorder.mode
+}
+
+// Interactions returns the interactions recorded or loaded so far.
+func (recorder *Recorder) Interactions() []*Interaction {
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return append([]*Interaction(nil), recorder.cassette.Interactions...)
+}
+
+// Stop saves the cassette if the Recorder is recording.
+// In other modes, Stop does nothing.
+func (recorder *Recorder) Stop() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	return recorder.cassette.Save(recorder.path)
+}
+
+// RoundTrip records or replays a single request, using Options.Transport to send
+// requests to the live service.
+func (recorder *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	return recorder.roundTrip(request, recorder.transport)
+}
+
+// Wrap returns an http.RoundTripper that records or replays requests using this Recorder,
+// sending requests to the live service with "next" (or http.DefaultTransport if "next" is nil).
+func (recorder *Recorder) Wrap(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		return recorder.roundTrip(request, next)
+	})
+}
+
+// Install configures "service" to send its requests through the Recorder.
+// The service's existing transport is wrapped, so settings such as automatic retries are preserved.
+// If the service uses an IamAuthenticator, the requests that it sends to the IAM token
+// server are recorded as well.
+func (recorder *Recorder) Install(service *core.BaseService) {
+	service.SetHTTPClient(recorder.wrapClient(service.GetHTTPClient()))
+
+	if service.Options != nil {
+		if authenticator, ok := service.Options.Authenticator.(*core.IamAuthenticator); ok {
+			client := authenticator.Client
+			if client == nil {
+				client = core.DefaultHTTPClient()
+				client.Timeout = time.Second * 30
+			}
+			authenticator.Client = recorder.wrapClient(client)
+		}
+	}
+}
+
+// wrapClient returns a copy of "client" whose transport is wrapped by the Recorder.
+func (recorder *Recorder) wrapClient(client *http.Client) *http.Client {
+	wrapped := &http.Client{}
+	if client != nil {
+		*wrapped = *client
+	}
+	wrapped.Transport = recorder.Wrap(wrapped.Transport)
+	return wrapped
+}
+
+func (recorder *Recorder) roundTrip(request *http.Request, next http.RoundTripper) (*http.Response, error) {
+	if recorder.mode == ModePassthrough {
+		return next.RoundTrip(request)
+	}
+
+	var body []byte
+	if request.Body != nil && request.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	live := &RecordedRequest{
+		Method: request.Method,
+		URL:    request.URL.String(),
+		Header: request.Header.Clone(),
+	}
+	live.SetBody(body)
+
+	if recorder.mode == ModeReplay {
+		return recorder.replay(request, live)
+	}
+	return recorder.record(request, live, body, next)
+}
+
+// replay returns the response of the first unused interaction that matches "live".
+func (recorder *Recorder) replay(request *http.Request, live *RecordedRequest) (*http.Response, error) {
+	recorder.scrub(&Interaction{Request: live})
+
+	recorder.mutex.Lock()
+	defer recorder.mutex.Unlock()
+	for i, interaction := range recorder.cassette.Interactions {
+		if recorder.used[i] || !recorder.matcher(live, interaction.Request) {
+			continue
+		}
+		recorder.used[i] = true
+
+		recorded := interaction.Response
+		body := recorded.GetBody()
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+			StatusCode:    recorded.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        recorded.Header.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       request,
+		}, nil
+	}
+	return nil, fmt.Errorf("no unused interaction in cassette '%s' matches the request %s %s", recorder.path, live.Method, live.URL)
+}
+
+// record sends the request with "next" and stores the scrubbed interaction in the cassette.
+func (recorder *Recorder) record(request *http.Request, live *RecordedRequest, body []byte, next http.RoundTripper) (*http.Response, error) {
+	outgoing := request.Clone(request.Context())
+	if body != nil {
+		outgoing.Body = io.NopCloser(bytes.NewReader(body))
+		outgoing.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	response, err := next.RoundTrip(outgoing)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	interaction := &Interaction{
+		Request: live,
+		Response: &RecordedResponse{
+			StatusCode: response.StatusCode,
+			Header:     response.Header.Clone(),
+		},
+	}
+	interaction.Response.SetBody(responseBody)
+	recorder.scrub(interaction)
+
+	recorder.mutex.Lock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interaction)
+	recorder.mutex.Unlock()
+
+	return response, nil
+}
+
+func (recorder *Recorder) scrub(interaction *Interaction) {
+	for _, scrubber := range recorder.scrubbers {
+		scrubber(interaction)
+	}
+}
+
+type roundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
@@ -0,0 +1,183 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/recorder"
+	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAPIKey = "my-secret-apikey"
+
+func newTokenServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"secret-access-token","refresh_token":"secret-refresh-token","token_type":"Bearer","expires_in":3600,"expiration":%d}`,
+			time.Now().Unix()+3600)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newResourceManager(t *testing.T, serviceURL string, tokenURL string) *resourcemanagerv2.ResourceManagerV2 {
+	authenticator, err := core.NewIamAuthenticatorBuilder().SetApiKey(testAPIKey).SetURL(tokenURL).Build()
+	require.Nil(t, err)
+	resourceManager, err := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+	require.Nil(t, err)
+	return resourceManager
+}
+
+func exerciseResourceManager(t *testing.T, resourceManager *resourcemanagerv2.ResourceManagerV2, accountID string) *resourcemanagerv2.ResourceGroupList {
+	createOptions := resourceManager.NewCreateResourceGroupOptions().SetName("recorded").SetAccountID(accountID)
+	_, _, err := resourceManager.CreateResourceGroup(createOptions)
+	require.Nil(t, err)
+	list, _, err := resourceManager.ListResourceGroups(resourceManager.NewListResourceGroupsOptions().SetAccountID(accountID))
+	require.Nil(t, err)
+	return list
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	cassettePath := filepath.Join(t.TempDir(), "cassettes", "resource_manager.json")
+	accountID := platformfake.DefaultAccountID
+
+	// Record the interactions with the live (fake) services.
+	server := platformfake.NewServer(nil)
+	tokenServer := newTokenServer(t)
+	rec, err := recorder.New(cassettePath, &recorder.Options{Mode: recorder.ModeRecord, AccountIDs: []string{accountID}})
+	require.Nil(t, err)
+	resourceManager := newResourceManager(t, server.URL, tokenServer.URL)
+	rec.Install(resourceManager.Service)
+
+	recorded := exerciseResourceManager(t, resourceManager, accountID)
+	assert.Len(t, recorded.Resources, 2)
+	assert.Equal(t, accountID, *recorded.Resources[0].AccountID)
+	require.Nil(t, rec.Stop())
+	assert.Len(t, rec.Interactions(), 3)
+	server.Close()
+	tokenServer.Close()
+
+	// Credentials and account IDs are scrubbed from the cassette.
+	b, err := os.ReadFile(cassettePath)
+	require.Nil(t, err)
+	contents := string(b)
+	for _, secret := range []string{testAPIKey, "secret-access-token", "secret-refresh-token", accountID} {
+		assert.NotContains(t, contents, secret)
+	}
+	cassette, err := recorder.LoadCassette(cassettePath)
+	require.Nil(t, err)
+	require.Len(t, cassette.Interactions, 3)
+	assert.Contains(t, cassette.Interactions[0].Request.Body, "apikey="+recorder.ScrubbedValue)
+	assert.Equal(t, "Bearer "+recorder.ScrubbedValue, cassette.Interactions[1].Request.Header.Get("Authorization"))
+	assert.Contains(t, cassette.Interactions[2].Request.URL, "account_id="+recorder.ScrubbedAccountID)
+
+	// Replay the interactions without the services.
+	rec, err = recorder.New(cassettePath, &recorder.Options{Mode: recorder.ModeRecordOnce, AccountIDs: []string{accountID}})
+	require.Nil(t, err)
+	assert.Equal(t, recorder.ModeReplay, rec.Mode())
+	resourceManager = newResourceManager(t, server.URL, tokenServer.URL)
+	rec.Install(resourceManager.Service)
+
+	replayed := exerciseResourceManager(t, resourceManager, accountID)
+	assert.Len(t, replayed.Resources, 2)
+	assert.Equal(t, recorder.ScrubbedAccountID, *replayed.Resources[0].AccountID)
+	assert.Equal(t, *recorded.Resources[1].ID, *replayed.Resources[1].ID)
+
+	// Each interaction is only replayed once.
+	_, _, err = resourceManager.ListResourceGroups(resourceManager.NewListResourceGroupsOptions().SetAccountID(accountID))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no unused interaction")
+	require.Nil(t, rec.Stop())
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	_, err := recorder.New(filepath.Join(t.TempDir(), "missing.json"), nil)
+	assert.NotNil(t, err)
+
+	rec, err := recorder.New(filepath.Join(t.TempDir(), "missing.json"), &recorder.Options{Mode: recorder.ModeRecordOnce})
+	require.Nil(t, err)
+	assert.Equal(t, recorder.ModeRecord, rec.Mode())
+}
+
+func TestScrubbing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"credentials":{"apikey":"key-value","iam_apikey_name":"name"},"items":[{"password":"pw"}],"custom":"internal-host"}`)
+	}))
+	defer server.Close()
+
+	cassettePath := filepath.Join(t.TempDir(), "scrubbed.json")
+	rec, err := recorder.New(cassettePath, &recorder.Options{
+		Mode:      recorder.ModeRecord,
+		Scrubbers: []recorder.Scrubber{recorder.ReplaceString("internal-host", "example.com")},
+	})
+	require.Nil(t, err)
+	client := &http.Client{Transport: rec}
+
+	response, err := client.Get(server.URL + "/v1/keys")
+	require.Nil(t, err)
+	defer response.Body.Close()
+
+	// The caller receives the unscrubbed response.
+	result := map[string]interface{}{}
+	require.Nil(t, json.NewDecoder(response.Body).Decode(&result))
+	assert.Equal(t, "key-value", result["credentials"].(map[string]interface{})["apikey"])
+
+	interaction := rec.Interactions()[0]
+	assert.Empty(t, interaction.Response.Header.Get("Set-Cookie"))
+	stored := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal([]byte(interaction.Response.Body), &stored))
+	assert.Equal(t, recorder.ScrubbedValue, stored["credentials"].(map[string]interface{})["apikey"])
+	assert.Equal(t, "name", stored["credentials"].(map[string]interface{})["iam_apikey_name"])
+	assert.Equal(t, recorder.ScrubbedValue, stored["items"].([]interface{})[0].(map[string]interface{})["password"])
+	assert.Equal(t, "example.com", stored["custom"])
+}
+
+func TestParseMode(t *testing.T) {
+	for _, mode := range []recorder.Mode{recorder.ModeReplay, recorder.ModeRecord, recorder.ModeRecordOnce, recorder.ModePassthrough} {
+		parsed, err := recorder.ParseMode(mode.String())
+		assert.Nil(t, err)
+		assert.Equal(t, mode, parsed)
+	}
+	_, err := recorder.ParseMode("rewind")
+	assert.NotNil(t, err)
+
+	t.Setenv(recorder.ModeEnvironmentVariable, "")
+	mode, err := recorder.GetModeFromEnvironment(recorder.ModeRecordOnce)
+	assert.Nil(t, err)
+	assert.Equal(t, recorder.ModeRecordOnce, mode)
+	t.Setenv(recorder.ModeEnvironmentVariable, "RECORD")
+	mode, err = recorder.GetModeFromEnvironment(recorder.ModeReplay)
+	assert.Nil(t, err)
+	assert.Equal(t, recorder.ModeRecord, mode)
+}
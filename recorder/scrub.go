@@ -0,0 +1,208 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ScrubbedValue is the value that replaces credentials in a cassette.
+const ScrubbedValue = "REDACTED"
+
+// ScrubbedAccountID is the value that replaces the account IDs listed in Options.AccountIDs.
+// Tests that run against a cassette should use this value as their account ID.
+const ScrubbedAccountID = "scrubbed-account-id"
+
+// scrubbedExpiration is the expiration time (2100-01-01T00:00:00Z) stored in scrubbed
+// IAM token responses, so that a replayed access token is never refreshed.
+const scrubbedExpiration = 4102444800
+
+// ScrubbedAccessToken is the unsigned JWT that replaces access tokens in a cassette.
+// It remains parseable by authenticators that inspect the claims of an access token.
+var ScrubbedAccessToken = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`)) + "." +
+	base64.RawURLEncoding.EncodeToString([]byte(`{"iam_id":"REDACTED","iat":0,"exp":4102444800}`)) + "."
+
+// Scrubber modifies an interaction before it is stored in a cassette.
+// Scrubbers are also applied to each live request that is matched against a cassette
+// during replay; in that case the interaction's Response is nil.
+type Scrubber func(interaction *Interaction)
+
+// sensitiveHeaders are the request headers whose values are scrubbed.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"X-Auth-Refresh-Token",
+	"X-Auth-Token",
+	"X-Api-Key",
+}
+
+// sensitiveFields are the JSON properties and form fields whose values are scrubbed.
+var sensitiveFields = map[string]bool{
+	"access_token":            true,
+	"api_key":                 true,
+	"apikey":                  true,
+	"client_secret":           true,
+	"delegated_refresh_token": true,
+	"password":                true,
+	"private_key":             true,
+	"refresh_token":           true,
+}
+
+// ReplaceString returns a Scrubber that replaces each occurrence of "old" with "new"
+// in the URL, header values and bodies of an interaction.
+func ReplaceString(old string, new string) Scrubber {
+	return func(interaction *Interaction) {
+		if old == "" {
+			return
+		}
+		if request := interaction.Request; request != nil {
+			request.URL = strings.ReplaceAll(request.URL, old, new)
+			replaceInHeader(request.Header, old, new)
+			if request.BodyEncoding == "" {
+				request.Body = strings.ReplaceAll(request.Body, old, new)
+			}
+		}
+		if response := interaction.Response; response != nil {
+			replaceInHeader(response.Header, old, new)
+			if response.BodyEncoding == "" {
+				response.Body = strings.ReplaceAll(response.Body, old, new)
+			}
+		}
+	}
+}
+
+// scrubCredentials removes credentials from the headers and bodies of an interaction.
+func scrubCredentials(interaction *Interaction) {
+	if request := interaction.Request; request != nil {
+		for _, name := range sensitiveHeaders {
+			values := request.Header.Values(name)
+			for i, value := range values {
+				// Keep the authentication scheme (e.g. "Bearer") so the cassette remains readable.
+				if scheme, _, found := strings.Cut(value, " "); found {
+					values[i] = scheme + " " + ScrubbedValue
+				} else {
+					values[i] = ScrubbedValue
+				}
+			}
+		}
+		if request.BodyEncoding == "" {
+			if strings.HasPrefix(request.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+				request.Body = scrubForm(request.Body)
+			} else {
+				request.Body = scrubJSON(request.Body)
+			}
+		}
+	}
+	if response := interaction.Response; response != nil {
+		response.Header.Del("Set-Cookie")
+		if response.BodyEncoding == "" {
+			response.Body = scrubJSON(response.Body)
+		}
+	}
+}
+
+// scrubForm scrubs the sensitive fields of a form-encoded body.
+func scrubForm(body string) string {
+	values, err := url.ParseQuery(body)
+	if err != nil {
+		return body
+	}
+	changed := false
+	for name := range values {
+		if sensitiveFields[strings.ToLower(name)] {
+			values.Set(name, ScrubbedValue)
+			changed = true
+		}
+	}
+	if !changed {
+		return body
+	}
+	return values.Encode()
+}
+
+// scrubJSON scrubs the sensitive properties of a JSON body.
+// Bodies that are not JSON, or that contain nothing to scrub, are returned unchanged.
+func scrubJSON(body string) string {
+	trimmed := strings.TrimSpace(body)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return body
+	}
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if decoder.Decode(&value) != nil {
+		return body
+	}
+	if !scrubValue(value) {
+		return body
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if encoder.Encode(value) != nil {
+		return body
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// scrubValue scrubs the sensitive properties of a decoded JSON value in place
+// and returns true if anything was changed.
+func scrubValue(value interface{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, property := range v {
+			if _, isString := property.(string); isString && sensitiveFields[strings.ToLower(name)] {
+				if name == "access_token" {
+					v[name] = ScrubbedAccessToken
+				} else {
+					v[name] = ScrubbedValue
+				}
+				changed = true
+			} else if scrubValue(property) {
+				changed = true
+			}
+		}
+		// An IAM token response: make sure the replayed token does not expire.
+		if _, isToken := v["access_token"]; isToken {
+			if _, hasExpiration := v["expiration"]; hasExpiration {
+				v["expiration"] = scrubbedExpiration
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, element := range v {
+			if scrubValue(element) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+func replaceInHeader(header http.Header, old string, new string) {
+	for _, values := range header {
+		for i, value := range values {
+			values[i] = strings.ReplaceAll(value, old, new)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// RedactedCredentialValue replaces the secret values of Credentials when they are formatted
+// with String() or by the fmt package, or returned by Redact().
+const RedactedCredentialValue = "[REDACTED]" // #nosec G101
+
+// ErrCredentialsUnavailable is returned (wrapped) by GetResourceKeyCredentials() and
+// GetResourceBindingCredentials() when the service does not return the credentials.
+// It can be detected with errors.Is().
+var ErrCredentialsUnavailable = errors.New("the credentials are not available")
+
+// secretCredentialNames are the fragments of property names whose values are treated as secrets.
+var secretCredentialNames = []string{"apikey", "api_key", "password", "passwd", "secret", "token", "private_key", "privatekey"}
+
+// publicCredentialNames are the properties of Credentials whose names contain a secret fragment,
+// but whose values are not secret.
+var publicCredentialNames = map[string]bool{
+	"iam_apikey_description": true,
+	"iam_apikey_name":        true,
+}
+
+// urlPasswordPattern matches the password of a URL with user information (e.g. a connection string).
+var urlPasswordPattern = regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://[^:/@\s]*:)([^@\s]+)(@)`)
+
+// Reveal returns all the properties of the credentials, including the additional properties
+// from the resource broker, with their secret values. Unlike String() and Redact(), which
+// redact the secret values so that the credentials can be logged safely, the result of Reveal()
+// (like that of MarshalJSON()) must be handled with care.
+func (o *Credentials) Reveal() map[string]interface{} {
+	m := make(map[string]interface{})
+	if o == nil {
+		return m
+	}
+	for k, v := range o.additionalProperties {
+		m[k] = v
+	}
+	if o.Redacted != nil {
+		m["REDACTED"] = *o.Redacted
+	}
+	if o.Apikey != nil {
+		m["apikey"] = *o.Apikey
+	}
+	if o.IamApikeyDescription != nil {
+		m["iam_apikey_description"] = *o.IamApikeyDescription
+	}
+	if o.IamApikeyName != nil {
+		m["iam_apikey_name"] = *o.IamApikeyName
+	}
+	if o.IamRoleCRN != nil {
+		m["iam_role_crn"] = *o.IamRoleCRN
+	}
+	if o.IamServiceidCRN != nil {
+		m["iam_serviceid_crn"] = *o.IamServiceidCRN
+	}
+	return m
+}
+
+// Redact returns the properties of the credentials, with the values of secret properties
+// (e.g. "apikey" or "password", at any level of nesting) and the passwords of connection
+// strings replaced by RedactedCredentialValue.
+func (o *Credentials) Redact() map[string]interface{} {
+	return redactCredentialValue("", o.Reveal()).(map[string]interface{})
+}
+
+// IsRedacted returns true if the service did not return the credentials because the user
+// doesn't have the access required to view them.
+func (o *Credentials) IsRedacted() bool {
+	return o != nil && o.Redacted != nil
+}
+
+// String returns the JSON representation of the credentials, with secret values redacted.
+func (o *Credentials) String() string {
+	return modelString(o.Redact(), nil)
+}
+
+// Format implements fmt.Formatter, so that the credentials are formatted by String(), with
+// secret values redacted, whatever the verb (including %#v).
+func (o *Credentials) Format(state fmt.State, verb rune) {
+	fmt.Fprint(state, o.String())
+}
+
+// String returns the JSON representation of the resource key, with the secret values of its
+// credentials redacted.
+func (resourceKey *ResourceKey) String() string {
+	if resourceKey == nil {
+		return "null"
+	}
+	return modelString(resourceKey, resourceKey.Credentials)
+}
+
+// String returns the JSON representation of the resource binding, with the secret values of
+// its credentials redacted.
+func (resourceBinding *ResourceBinding) String() string {
+	if resourceBinding == nil {
+		return "null"
+	}
+	return modelString(resourceBinding, resourceBinding.Credentials)
+}
+
+// GetResourceKeyCredentials retrieves the resource key "id" and returns its credentials.
+// An error wrapping ErrCredentialsUnavailable is returned if the credentials were redacted by
+// the service, either because the user doesn't have the access required to view them or
+// because the key has one-time credentials, which are only returned when the key is created.
+func (resourceController *ResourceControllerV2) GetResourceKeyCredentials(ctx context.Context, id string) (*Credentials, error) {
+	key, _, err := resourceController.GetResourceKeyWithContext(ctx, resourceController.NewGetResourceKeyOptions(id))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-credentials-error")
+	}
+	err = checkCredentialsAvailable("resource key", id, key.Credentials, key.OnetimeCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return key.Credentials, nil
+}
+
+// GetResourceBindingCredentials retrieves the resource binding "id" and returns its credentials.
+// An error wrapping ErrCredentialsUnavailable is returned if the credentials were redacted by
+// the service because the user doesn't have the access required to view them.
+func (resourceController *ResourceControllerV2) GetResourceBindingCredentials(ctx context.Context, id string) (*Credentials, error) {
+	binding, _, err := resourceController.GetResourceBindingWithContext(ctx, resourceController.NewGetResourceBindingOptions(id))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-credentials-error")
+	}
+	err = checkCredentialsAvailable("resource binding", id, binding.Credentials, nil)
+	if err != nil {
+		return nil, err
+	}
+	return binding.Credentials, nil
+}
+
+// checkCredentialsAvailable returns an error wrapping ErrCredentialsUnavailable if "credentials"
+// were not returned by the service. "onetime" is the OnetimeCredentials property of the key, if any.
+func checkCredentialsAvailable(kind string, id string, credentials *Credentials, onetime *bool) error {
+	var reason string
+	switch {
+	case onetime != nil && *onetime && (credentials == nil || credentials.IsRedacted()):
+		reason = "it has one-time credentials, which are only returned when it is created; create a new " + kind + " to obtain new credentials"
+	case credentials == nil:
+		reason = "it has no credentials"
+	case credentials.IsRedacted():
+		reason = fmt.Sprintf("the credentials were redacted by the service (%s); viewing them requires additional access", *credentials.Redacted)
+	default:
+		return nil
+	}
+	err := fmt.Errorf("%w: %s '%s': %s", ErrCredentialsUnavailable, kind, id, reason)
+	return core.SDKErrorf(err, "", "credentials-unavailable", common.GetComponentInfo())
+}
+
+// redactCredentialValue returns "value" with secret values replaced by RedactedCredentialValue.
+// "name" is the name of the property that holds the value, if any.
+func redactCredentialValue(name string, value interface{}) interface{} {
+	if value != nil && isSecretCredentialName(name) {
+		return RedactedCredentialValue
+	}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[k] = redactCredentialValue(k, e)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, e := range v {
+			s[i] = redactCredentialValue("", e)
+		}
+		return s
+	case string:
+		return urlPasswordPattern.ReplaceAllString(v, "${1}"+RedactedCredentialValue+"${3}")
+	case *string:
+		if v == nil {
+			return v
+		}
+		return redactCredentialValue(name, *v)
+	}
+	return value
+}
+
+func isSecretCredentialName(name string) bool {
+	name = strings.ToLower(name)
+	if name == "" || publicCredentialNames[name] {
+		return false
+	}
+	for _, secret := range secretCredentialNames {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// modelString returns the JSON representation of "model". If "credentials" is not nil, the
+// "credentials" property of the model is replaced by its redacted properties.
+func modelString(model interface{}, credentials *Credentials) string {
+	buffer, err := json.Marshal(model)
+	if err == nil && credentials != nil {
+		var m map[string]json.RawMessage
+		if err = json.Unmarshal(buffer, &m); err == nil {
+			if m["credentials"], err = json.Marshal(credentials.Redact()); err == nil {
+				buffer, err = json.Marshal(m)
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Sprintf("<invalid %T: %s>", model, err.Error())
+	}
+	return string(buffer)
+}
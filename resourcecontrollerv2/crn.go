@@ -0,0 +1,81 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/crn"
+)
+
+// ParseCRN parses the CRN of the resource instance.
+func (resourceInstance *ResourceInstance) ParseCRN() (*crn.CRN, error) {
+	return parseCRN(resourceInstance.CRN)
+}
+
+// ParseTargetCRN parses the CRN of the deployment location of the resource instance.
+func (resourceInstance *ResourceInstance) ParseTargetCRN() (*crn.CRN, error) {
+	return parseCRN(resourceInstance.TargetCRN)
+}
+
+// ParseResourceGroupCRN parses the CRN of the resource group that contains the resource instance.
+func (resourceInstance *ResourceInstance) ParseResourceGroupCRN() (*crn.CRN, error) {
+	return parseCRN(resourceInstance.ResourceGroupCRN)
+}
+
+// ParseCRN parses the CRN of the resource key.
+func (resourceKey *ResourceKey) ParseCRN() (*crn.CRN, error) {
+	return parseCRN(resourceKey.CRN)
+}
+
+// ParseSourceCRN parses the CRN of the resource instance or alias that the key belongs to.
+func (resourceKey *ResourceKey) ParseSourceCRN() (*crn.CRN, error) {
+	return parseCRN(resourceKey.SourceCRN)
+}
+
+// ParseCRN parses the CRN of the resource alias.
+func (resourceAlias *ResourceAlias) ParseCRN() (*crn.CRN, error) {
+	return parseCRN(resourceAlias.CRN)
+}
+
+// ParseTargetCRN parses the CRN of the target namespace of the resource alias.
+func (resourceAlias *ResourceAlias) ParseTargetCRN() (*crn.CRN, error) {
+	return parseCRN(resourceAlias.TargetCRN)
+}
+
+// ParseCRN parses the CRN of the resource binding.
+func (resourceBinding *ResourceBinding) ParseCRN() (*crn.CRN, error) {
+	return parseCRN(resourceBinding.CRN)
+}
+
+// NewCreateResourceAliasOptionsForCRN : Instantiate CreateResourceAliasOptions for the resource
+// instance "source" and the namespace identified by "target".
+func (*ResourceControllerV2) NewCreateResourceAliasOptionsForCRN(name string, source string, target *crn.CRN) *CreateResourceAliasOptions {
+	return &CreateResourceAliasOptions{
+		Name:   core.StringPtr(name),
+		Source: core.StringPtr(source),
+		Target: core.StringPtr(target.String()),
+	}
+}
+
+func parseCRN(value *string) (*crn.CRN, error) {
+	parsed, err := crn.Parse(core.StringNilMapper(value))
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-crn", common.GetComponentInfo())
+	}
+	return parsed, nil
+}
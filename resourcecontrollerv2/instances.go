@@ -0,0 +1,69 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// nonRemovedResourceInstanceStates are the states listed by ListNonRemovedResourceInstances().
+var nonRemovedResourceInstanceStates = []string{
+	ListResourceInstancesOptionsStateActiveConst,
+	ListResourceInstancesOptionsStateProvisioningConst,
+	ListResourceInstancesOptionsStatePreProvisioningConst,
+	ListResourceInstancesOptionsStateInactiveConst,
+	ListResourceInstancesOptionsStateFailedConst,
+	ListResourceInstancesOptionsStatePendingReclamationConst,
+}
+
+// ListNonRemovedResourceInstances returns the resource instances selected by "options" (which may
+// be nil) in every state other than removed. The ListResourceInstances operation returns only
+// active and provisioning instances unless a state is specified, so each state is listed in turn;
+// options.State is ignored. An instance that changes state between two lists is returned once.
+func (resourceController *ResourceControllerV2) ListNonRemovedResourceInstances(ctx context.Context, options *ListResourceInstancesOptions) (result []ResourceInstance, err error) {
+	if options == nil {
+		options = &ListResourceInstancesOptions{}
+	}
+	result = []ResourceInstance{}
+	seen := map[string]bool{}
+	for _, state := range nonRemovedResourceInstanceStates {
+		stateOptions := *options
+		stateOptions.State = core.StringPtr(state)
+		stateOptions.Start = nil
+		var pager *ResourceInstancesPager
+		pager, err = resourceController.NewResourceInstancesPager(&stateOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-instances-error")
+			return
+		}
+		var instances []ResourceInstance
+		instances, err = pager.GetAllWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "list-instances-error")
+			return
+		}
+		for _, instance := range instances {
+			if id := getID(&instance); id == "" || !seen[id] {
+				seen[id] = true
+				result = append(result, instance)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,262 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// The steps of a resource key rotation, as recorded in a KeyRotationStep.
+const (
+	KeyRotationStepGetKeyConst      = "get_key"
+	KeyRotationStepCreateKeyConst   = "create_key"
+	KeyRotationStepPublishConst     = "publish"
+	KeyRotationStepGracePeriodConst = "grace_period"
+	KeyRotationStepDeleteKeyConst   = "delete_key"
+	KeyRotationStepRepublishConst   = "republish"
+	KeyRotationStepRollbackConst    = "rollback"
+)
+
+// KeyPublisher distributes the credentials of "key" to the consumers of a resource key, for
+// example by writing them to a secrets manager.
+type KeyPublisher func(ctx context.Context, key *ResourceKey) error
+
+// RotateResourceKeyOptions : The options for ResourceControllerV2.RotateResourceKey().
+type RotateResourceKeyOptions struct {
+	// The GUID or CRN of the resource key to rotate.
+	ID *string `validate:"required,ne="`
+
+	// Publishes the credentials of the new key. If the rotation is rolled back after the new key
+	// was published, Publish is invoked again with the old key.
+	Publish KeyPublisher `validate:"required"`
+
+	// The name of the new key (defaults to the name of the old key).
+	Name *string
+
+	// The role of the new key (defaults to the role of the old key). It is required if the role
+	// of the old key is not included in its credentials, e.g. because they are redacted.
+	Role *string
+
+	// The parameters of the new key. By default, the new key reuses the service ID of the old
+	// key, so that the access policies of the service ID apply to both keys.
+	Parameters *ResourceKeyPostParameters
+
+	// The time to wait after the new key has been published before the old key is deleted,
+	// which gives consumers time to pick up the new credentials.
+	GracePeriod time.Duration
+
+	// If set, Log is invoked as each step of the rotation completes.
+	Log func(step *KeyRotationStep)
+}
+
+// KeyRotationStep : A step of a resource key rotation.
+type KeyRotationStep struct {
+	// The step (one of the KeyRotationStep constants).
+	Step string
+
+	// The GUID of the key that the step applies to.
+	KeyID string
+
+	// The time at which the step started.
+	StartedAt time.Time
+
+	// The duration of the step.
+	Duration time.Duration
+
+	// The error that caused the step to fail, if any.
+	Err error
+}
+
+// String returns a one-line description of the step.
+func (step *KeyRotationStep) String() string {
+	if step.Err != nil {
+		return fmt.Sprintf("%s %s: failed after %s: %s", step.Step, step.KeyID, step.Duration, step.Err.Error())
+	}
+	return fmt.Sprintf("%s %s: completed in %s", step.Step, step.KeyID, step.Duration)
+}
+
+// KeyRotationResult : The result of ResourceControllerV2.RotateResourceKey().
+type KeyRotationResult struct {
+	// The key that was rotated.
+	OldKey *ResourceKey
+
+	// The key that replaced it, or nil if it was not created (or was deleted by the rollback).
+	NewKey *ResourceKey
+
+	// The steps performed, in order.
+	Steps []KeyRotationStep
+
+	// True if the rotation failed and the new key was rolled back.
+	RolledBack bool
+}
+
+// RotateResourceKey replaces a resource key with a new key that has the same source, role and
+// service ID:
+//
+//  1. A new key is created for the source of the old key.
+//  2. The credentials of the new key are published with options.Publish.
+//  3. After options.GracePeriod, the old key is deleted.
+//
+// If step 2 fails, or "ctx" is done during the grace period, the rotation is rolled back: the old
+// key is published again (if the new key may have been published) and the new key is deleted.
+// The result, which is returned even if an error occurs, records every step performed.
+// If the old key cannot be deleted after the new key was published, the rotation is not rolled
+// back; the error is returned and the old key must be deleted later.
+func (resourceController *ResourceControllerV2) RotateResourceKey(ctx context.Context, options *RotateResourceKeyOptions) (result *KeyRotationResult, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	result = &KeyRotationResult{}
+	record := func(step string, keyID string, f func() error) error {
+		entry := KeyRotationStep{Step: step, KeyID: keyID, StartedAt: time.Now()}
+		entry.Err = f()
+		entry.Duration = time.Since(entry.StartedAt)
+		result.Steps = append(result.Steps, entry)
+		core.GetLogger().Debug("Resource key rotation: %s", entry.String())
+		if options.Log != nil {
+			options.Log(&entry)
+		}
+		return entry.Err
+	}
+
+	err = record(KeyRotationStepGetKeyConst, *options.ID, func() (stepErr error) {
+		result.OldKey, _, stepErr = resourceController.GetResourceKeyWithContext(ctx, resourceController.NewGetResourceKeyOptions(*options.ID))
+		return
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "rotate-key-error")
+		return
+	}
+	oldKeyID := *result.OldKey.GUID
+
+	createOptions, err := resourceController.newRotatedKeyOptions(result.OldKey, options)
+	if err != nil {
+		return
+	}
+	err = record(KeyRotationStepCreateKeyConst, oldKeyID, func() (stepErr error) {
+		result.NewKey, _, stepErr = resourceController.CreateResourceKeyWithContext(ctx, createOptions)
+		return
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "rotate-key-error")
+		return
+	}
+	newKeyID := *result.NewKey.GUID
+
+	err = record(KeyRotationStepPublishConst, newKeyID, func() error {
+		return options.Publish(ctx, result.NewKey)
+	})
+	if err == nil {
+		err = record(KeyRotationStepGracePeriodConst, oldKeyID, func() error {
+			return sleepWithContext(ctx, options.GracePeriod)
+		})
+	}
+	if err != nil {
+		resourceController.rollBackKeyRotation(ctx, options, result, record)
+		err = core.SDKErrorf(err, fmt.Sprintf("the rotation of resource key '%s' was rolled back", oldKeyID),
+			"rotate-key-rolled-back", common.GetComponentInfo())
+		return
+	}
+
+	err = record(KeyRotationStepDeleteKeyConst, oldKeyID, func() error {
+		_, stepErr := resourceController.DeleteResourceKeyWithContext(ctx, resourceController.NewDeleteResourceKeyOptions(oldKeyID))
+		return stepErr
+	})
+	err = core.RepurposeSDKProblem(err, "rotate-key-error")
+	return
+}
+
+// newRotatedKeyOptions returns the options used to create the key that replaces "oldKey".
+// An error is returned if options.Role is not set and the role of "oldKey" cannot be determined
+// from its credentials (e.g. because they were redacted), rather than creating the new key with
+// the default role of the service.
+func (resourceController *ResourceControllerV2) newRotatedKeyOptions(oldKey *ResourceKey, options *RotateResourceKeyOptions) (*CreateResourceKeyOptions, error) {
+	createOptions := resourceController.NewCreateResourceKeyOptions("", *oldKey.SourceCRN)
+	createOptions.Name = oldKey.Name
+	if options.Name != nil {
+		createOptions.Name = options.Name
+	}
+	createOptions.Role = options.Role
+	createOptions.Parameters = options.Parameters
+	if credentials := oldKey.Credentials; credentials != nil {
+		if createOptions.Role == nil {
+			createOptions.Role = credentials.IamRoleCRN
+		}
+		if createOptions.Parameters == nil && credentials.IamServiceidCRN != nil {
+			createOptions.Parameters = &ResourceKeyPostParameters{ServiceidCRN: credentials.IamServiceidCRN}
+		}
+	}
+	if createOptions.Role == nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the role of resource key '%s' cannot be determined from its credentials; set the Role option",
+			*oldKey.GUID), "missing-key-role", common.GetComponentInfo())
+	}
+	return createOptions, nil
+}
+
+// rollBackKeyRotation republishes the old key and deletes the new key. Rollback failures are
+// recorded in the steps of the result.
+func (resourceController *ResourceControllerV2) rollBackKeyRotation(ctx context.Context, options *RotateResourceKeyOptions,
+	result *KeyRotationResult, record func(string, string, func() error) error) {
+	// The rollback must proceed even if the rotation failed because ctx is done.
+	ctx = context.WithoutCancel(ctx)
+
+	_ = record(KeyRotationStepRepublishConst, *result.OldKey.GUID, func() error {
+		return options.Publish(ctx, result.OldKey)
+	})
+	rollbackErr := record(KeyRotationStepRollbackConst, *result.NewKey.GUID, func() error {
+		_, stepErr := resourceController.DeleteResourceKeyWithContext(ctx, resourceController.NewDeleteResourceKeyOptions(*result.NewKey.GUID))
+		return stepErr
+	})
+	result.RolledBack = true
+	if rollbackErr == nil {
+		result.NewKey = nil
+	}
+}
+
+// sleepWithContext waits for "duration", or until "ctx" is done.
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if duration <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,317 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+// ResourceInstanceAction : An action that changes the lifecycle state of a resource instance.
+type ResourceInstanceAction string
+
+// The actions that can be performed on a resource instance.
+const (
+	ResourceInstanceActionUpdate              ResourceInstanceAction = "update"
+	ResourceInstanceActionDelete              ResourceInstanceAction = "delete"
+	ResourceInstanceActionLock                ResourceInstanceAction = "lock"
+	ResourceInstanceActionUnlock              ResourceInstanceAction = "unlock"
+	ResourceInstanceActionCancelLastOperation ResourceInstanceAction = "cancel_last_operation"
+	ResourceInstanceActionRestore             ResourceInstanceAction = "restore"
+	ResourceInstanceActionReclaim             ResourceInstanceAction = "reclaim"
+)
+
+// resourceInstanceActions lists every action, in the order reported by GetPermittedActions().
+var resourceInstanceActions = []ResourceInstanceAction{
+	ResourceInstanceActionUpdate,
+	ResourceInstanceActionDelete,
+	ResourceInstanceActionLock,
+	ResourceInstanceActionUnlock,
+	ResourceInstanceActionCancelLastOperation,
+	ResourceInstanceActionRestore,
+	ResourceInstanceActionReclaim,
+}
+
+// ErrActionNotPermitted is returned (wrapped) when an action is not permitted in the current
+// state of a resource instance. It can be detected with errors.Is().
+var ErrActionNotPermitted = errors.New("the action is not permitted in the current state of the resource instance")
+
+// GetPermittedActions returns the actions that are permitted in the current state of "instance":
+//   - A removed instance permits no actions.
+//   - An instance that is pending reclamation can only be restored or reclaimed.
+//   - A locked instance cannot be updated or deleted until it is unlocked.
+//   - An instance with an operation in progress cannot be updated or deleted, but the operation
+//     may be canceled if it is cancelable.
+//   - A failed instance can be deleted, but not updated.
+func GetPermittedActions(instance *ResourceInstance) []ResourceInstanceAction {
+	permitted := []ResourceInstanceAction{}
+	for _, action := range resourceInstanceActions {
+		if getDenialReason(instance, action) == "" {
+			permitted = append(permitted, action)
+		}
+	}
+	return permitted
+}
+
+// IsActionPermitted returns true if "action" is permitted in the current state of "instance".
+func IsActionPermitted(instance *ResourceInstance, action ResourceInstanceAction) bool {
+	return getDenialReason(instance, action) == ""
+}
+
+// CheckResourceInstanceAction returns nil if "action" is permitted in the current state of
+// "instance", or an error wrapping ErrActionNotPermitted that describes why it is not.
+func CheckResourceInstanceAction(instance *ResourceInstance, action ResourceInstanceAction) error {
+	reason := getDenialReason(instance, action)
+	if reason == "" {
+		return nil
+	}
+	err := fmt.Errorf("%w: cannot %s resource instance '%s': %s", ErrActionNotPermitted,
+		describeAction(action), getInstanceID(instance), reason)
+	return core.SDKErrorf(err, "", "action-not-permitted", common.GetComponentInfo())
+}
+
+// getDenialReason returns the reason why "action" is not permitted in the current state of
+// "instance", or "" if it is permitted.
+func getDenialReason(instance *ResourceInstance, action ResourceInstanceAction) string {
+	if instance == nil {
+		return "the instance is unknown"
+	}
+	state := getState(instance)
+	locked := instance.Locked != nil && *instance.Locked
+	inProgress := operationInProgress(instance) ||
+		state == ResourceInstanceStatePreProvisioningConst ||
+		state == ResourceInstanceStateProvisioningConst ||
+		state == ResourceInstanceStatePendingRemovalConst
+
+	if state == ResourceInstanceStateRemovedConst {
+		return "the instance has been removed"
+	}
+	pendingReclamation := state == ResourceInstanceStatePendingReclamationConst
+	switch action {
+	case ResourceInstanceActionRestore, ResourceInstanceActionReclaim:
+		if !pendingReclamation {
+			return fmt.Sprintf("the instance is '%s', not 'pending_reclamation'", state)
+		}
+		return ""
+	case ResourceInstanceActionUpdate, ResourceInstanceActionDelete, ResourceInstanceActionLock,
+		ResourceInstanceActionUnlock, ResourceInstanceActionCancelLastOperation:
+		if pendingReclamation {
+			return "the instance is pending reclamation and must be restored first"
+		}
+	default:
+		return fmt.Sprintf("'%s' is not a known action", action)
+	}
+
+	switch action {
+	case ResourceInstanceActionLock:
+		if locked {
+			return "the instance is already locked"
+		}
+	case ResourceInstanceActionUnlock:
+		if !locked {
+			return "the instance is not locked"
+		}
+	case ResourceInstanceActionCancelLastOperation:
+		if !operationInProgress(instance) {
+			return "no operation is in progress"
+		}
+		if instance.LastOperation.Cancelable == nil || !*instance.LastOperation.Cancelable {
+			return fmt.Sprintf("the %s in progress cannot be canceled", describeOperation(instance))
+		}
+	default:
+		switch {
+		case locked:
+			return "the instance is locked and must be unlocked first"
+		case inProgress:
+			return fmt.Sprintf("the %s is in progress", describeOperation(instance))
+		case action == ResourceInstanceActionUpdate && state == ResourceInstanceStateFailedConst:
+			return "the instance is 'failed'; it can only be deleted"
+		}
+	}
+	return ""
+}
+
+func describeAction(action ResourceInstanceAction) string {
+	if action == ResourceInstanceActionCancelLastOperation {
+		return "cancel the last operation of"
+	}
+	return string(action)
+}
+
+func describeOperation(instance *ResourceInstance) string {
+	if instance.LastOperation != nil && instance.LastOperation.Type != nil {
+		return fmt.Sprintf("'%s' operation", *instance.LastOperation.Type)
+	}
+	return fmt.Sprintf("'%s' operation", getState(instance))
+}
+
+func getInstanceID(instance *ResourceInstance) string {
+	if instance == nil {
+		return ""
+	}
+	return getID(instance)
+}
+
+// GuardedUpdateResourceInstance retrieves the resource instance and, if it can be updated in its
+// current state, updates it as described by "updateResourceInstanceOptions".
+// If the update is not permitted, an error wrapping ErrActionNotPermitted is returned without
+// sending the update request.
+func (resourceController *ResourceControllerV2) GuardedUpdateResourceInstance(ctx context.Context, updateResourceInstanceOptions *UpdateResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	if err = validateGuardedOptions(updateResourceInstanceOptions, "updateResourceInstanceOptions"); err != nil {
+		return
+	}
+	_, response, err = resourceController.checkAction(ctx, *updateResourceInstanceOptions.ID, ResourceInstanceActionUpdate)
+	if err == nil {
+		result, response, err = resourceController.UpdateResourceInstanceWithContext(ctx, updateResourceInstanceOptions)
+	}
+	err = core.RepurposeSDKProblem(err, "guarded-update-error")
+	return
+}
+
+// GuardedDeleteResourceInstance retrieves the resource instance and, if it can be deleted in its
+// current state, deletes it as described by "deleteResourceInstanceOptions".
+// If the delete is not permitted, an error wrapping ErrActionNotPermitted is returned without
+// sending the delete request.
+func (resourceController *ResourceControllerV2) GuardedDeleteResourceInstance(ctx context.Context, deleteResourceInstanceOptions *DeleteResourceInstanceOptions) (response *core.DetailedResponse, err error) {
+	if err = validateGuardedOptions(deleteResourceInstanceOptions, "deleteResourceInstanceOptions"); err != nil {
+		return
+	}
+	_, response, err = resourceController.checkAction(ctx, *deleteResourceInstanceOptions.ID, ResourceInstanceActionDelete)
+	if err == nil {
+		response, err = resourceController.DeleteResourceInstanceWithContext(ctx, deleteResourceInstanceOptions)
+	}
+	err = core.RepurposeSDKProblem(err, "guarded-delete-error")
+	return
+}
+
+// GuardedLockResourceInstance retrieves the resource instance and locks it if it can be locked
+// in its current state; otherwise an error wrapping ErrActionNotPermitted is returned.
+func (resourceController *ResourceControllerV2) GuardedLockResourceInstance(ctx context.Context, lockResourceInstanceOptions *LockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	if err = validateGuardedOptions(lockResourceInstanceOptions, "lockResourceInstanceOptions"); err != nil {
+		return
+	}
+	_, response, err = resourceController.checkAction(ctx, *lockResourceInstanceOptions.ID, ResourceInstanceActionLock)
+	if err == nil {
+		result, response, err = resourceController.LockResourceInstanceWithContext(ctx, lockResourceInstanceOptions)
+	}
+	err = core.RepurposeSDKProblem(err, "guarded-lock-error")
+	return
+}
+
+// GuardedUnlockResourceInstance retrieves the resource instance and unlocks it if it can be
+// unlocked in its current state; otherwise an error wrapping ErrActionNotPermitted is returned.
+func (resourceController *ResourceControllerV2) GuardedUnlockResourceInstance(ctx context.Context, unlockResourceInstanceOptions *UnlockResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	if err = validateGuardedOptions(unlockResourceInstanceOptions, "unlockResourceInstanceOptions"); err != nil {
+		return
+	}
+	_, response, err = resourceController.checkAction(ctx, *unlockResourceInstanceOptions.ID, ResourceInstanceActionUnlock)
+	if err == nil {
+		result, response, err = resourceController.UnlockResourceInstanceWithContext(ctx, unlockResourceInstanceOptions)
+	}
+	err = core.RepurposeSDKProblem(err, "guarded-unlock-error")
+	return
+}
+
+// GuardedCancelLastopResourceInstance retrieves the resource instance and cancels its last
+// operation if a cancelable operation is in progress; otherwise an error wrapping
+// ErrActionNotPermitted is returned.
+func (resourceController *ResourceControllerV2) GuardedCancelLastopResourceInstance(ctx context.Context, cancelLastopResourceInstanceOptions *CancelLastopResourceInstanceOptions) (result *ResourceInstance, response *core.DetailedResponse, err error) {
+	if err = validateGuardedOptions(cancelLastopResourceInstanceOptions, "cancelLastopResourceInstanceOptions"); err != nil {
+		return
+	}
+	_, response, err = resourceController.checkAction(ctx, *cancelLastopResourceInstanceOptions.ID, ResourceInstanceActionCancelLastOperation)
+	if err == nil {
+		result, response, err = resourceController.CancelLastopResourceInstanceWithContext(ctx, cancelLastopResourceInstanceOptions)
+	}
+	err = core.RepurposeSDKProblem(err, "guarded-cancel-error")
+	return
+}
+
+// RestoreResourceInstance restores the resource instance "id", which must be pending reclamation,
+// by running the "restore" action of its reclamation.
+// If the instance is not pending reclamation, an error wrapping ErrActionNotPermitted is returned.
+func (resourceController *ResourceControllerV2) RestoreResourceInstance(ctx context.Context, id string) (result *Reclamation, response *core.DetailedResponse, err error) {
+	result, response, err = resourceController.runInstanceReclamationAction(ctx, id, ResourceInstanceActionRestore)
+	err = core.RepurposeSDKProblem(err, "restore-error")
+	return
+}
+
+// ReclaimResourceInstance permanently deletes the resource instance "id", which must be pending
+// reclamation, by running the "reclaim" action of its reclamation.
+// If the instance is not pending reclamation, an error wrapping ErrActionNotPermitted is returned.
+func (resourceController *ResourceControllerV2) ReclaimResourceInstance(ctx context.Context, id string) (result *Reclamation, response *core.DetailedResponse, err error) {
+	result, response, err = resourceController.runInstanceReclamationAction(ctx, id, ResourceInstanceActionReclaim)
+	err = core.RepurposeSDKProblem(err, "reclaim-error")
+	return
+}
+
+// runInstanceReclamationAction runs "action" on the reclamation of the instance "id".
+func (resourceController *ResourceControllerV2) runInstanceReclamationAction(ctx context.Context, id string, action ResourceInstanceAction) (result *Reclamation, response *core.DetailedResponse, err error) {
+	instance, response, err := resourceController.checkAction(ctx, id, action)
+	if err != nil {
+		return
+	}
+
+	instanceID := getID(instance)
+	listOptions := resourceController.NewListReclamationsOptions().SetResourceInstanceID(instanceID)
+	if instance.AccountID != nil {
+		listOptions.SetAccountID(*instance.AccountID)
+	}
+	reclamations, response, err := resourceController.ListReclamationsWithContext(ctx, listOptions)
+	if err != nil {
+		return
+	}
+	var reclamationID string
+	for _, reclamation := range reclamations.Resources {
+		if reclamation.ResourceInstanceID != nil && *reclamation.ResourceInstanceID == instanceID && reclamation.ID != nil {
+			reclamationID = *reclamation.ID
+			break
+		}
+	}
+	if reclamationID == "" {
+		err = core.SDKErrorf(fmt.Errorf("no reclamation was found for resource instance '%s'", id), "", "no-reclamation", common.GetComponentInfo())
+		return
+	}
+
+	return resourceController.RunReclamationActionWithContext(ctx, resourceController.NewRunReclamationActionOptions(reclamationID, string(action)))
+}
+
+// checkAction retrieves the instance "id" and returns an error if "action" is not permitted in its current state.
+func (resourceController *ResourceControllerV2) checkAction(ctx context.Context, id string, action ResourceInstanceAction) (instance *ResourceInstance, response *core.DetailedResponse, err error) {
+	instance, response, err = resourceController.GetResourceInstanceWithContext(ctx, resourceController.NewGetResourceInstanceOptions(id))
+	if err != nil {
+		return
+	}
+	err = CheckResourceInstanceAction(instance, action)
+	return
+}
+
+func validateGuardedOptions(options interface{}, name string) error {
+	err := core.ValidateNotNil(options, name+" cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	err = core.ValidateStruct(options, name)
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
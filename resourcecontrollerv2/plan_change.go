@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globalcatalogv1"
+)
+
+// ErrPlanChangeNotAllowed is returned (wrapped) by ResourceControllerV2.ChangeResourceInstancePlan()
+// when the requested plan is not an eligible target for the resource instance.
+// It can be detected with errors.Is().
+var ErrPlanChangeNotAllowed = errors.New("the plan of the resource instance cannot be changed to the requested plan")
+
+// catalogPageLimit is the page size used to list the plans of a service.
+const catalogPageLimit = 100
+
+// CatalogPlan : A plan of the service of a resource instance, as listed by the Global Catalog.
+type CatalogPlan struct {
+	// The ID of the plan.
+	ID string
+
+	// The programmatic name of the plan.
+	Name string
+
+	// True if the plan is the current plan of the instance.
+	Current bool
+
+	// True if the plan of the instance can be changed to this plan.
+	Eligible bool
+
+	// The reason why the plan is not eligible, if it is not.
+	Reason string
+
+	// The catalog entry of the plan.
+	Entry *globalcatalogv1.CatalogEntry
+}
+
+// ChangeResourceInstancePlanOptions : The options for ResourceControllerV2.ChangeResourceInstancePlan().
+type ChangeResourceInstancePlanOptions struct {
+	// The GUID or CRN of the resource instance.
+	ID *string `validate:"required,ne="`
+
+	// The ID of the plan that the instance is moved to.
+	ResourcePlanID *string `validate:"required,ne="`
+
+	// The client used to look up the plans of the instance's service.
+	GlobalCatalog *globalcatalogv1.GlobalCatalogV1 `validate:"required"`
+
+	// Parameters passed to the service broker with the plan change.
+	Parameters map[string]interface{}
+
+	// If true, the plan change is validated but not applied.
+	DryRun bool
+
+	// The options used to wait for the update of the instance to complete.
+	WaitOptions *WaitOptions
+}
+
+// PlanChangeResult : The result of ResourceControllerV2.ChangeResourceInstancePlan().
+type PlanChangeResult struct {
+	// The instance, as returned once the plan change completed (or before the change, for a
+	// dry run).
+	Instance *ResourceInstance
+
+	// The ID of the plan of the instance before the change.
+	PreviousPlanID string
+
+	// The plan of the instance after the change.
+	TargetPlan *CatalogPlan
+
+	// The element added to the plan history of the instance by the change, or nil for a dry run.
+	PlanHistoryItem *PlanHistoryItem
+
+	// True if the plan change was applied (false for a dry run).
+	Applied bool
+
+	// The time taken to apply the change and wait for it to complete.
+	Duration time.Duration
+}
+
+// String returns a one-line description of the plan change.
+func (result *PlanChangeResult) String() string {
+	instanceID := getInstanceID(result.Instance)
+	if !result.Applied {
+		return fmt.Sprintf("resource instance '%s' can be moved from plan '%s' to plan '%s'", instanceID,
+			result.PreviousPlanID, result.TargetPlan.ID)
+	}
+	return fmt.Sprintf("resource instance '%s' moved from plan '%s' to plan '%s' in %s", instanceID,
+		result.PreviousPlanID, result.TargetPlan.ID, result.Duration)
+}
+
+// ListResourceInstancePlans returns the plans of the service of the resource instance "id"
+// (a GUID or CRN), as listed by the Global Catalog. Each plan reports whether the plan of the
+// instance can be changed to it:
+//   - The current plan of the instance is not eligible.
+//   - No plan is eligible if the catalog reports that the service does not support plan changes.
+//   - Disabled and inactive plans are not eligible.
+//   - Plans that are restricted to other locations than that of the instance are not eligible.
+func (resourceController *ResourceControllerV2) ListResourceInstancePlans(ctx context.Context, globalCatalog *globalcatalogv1.GlobalCatalogV1, id string) (plans []CatalogPlan, err error) {
+	if globalCatalog == nil {
+		err = core.SDKErrorf(nil, "a GlobalCatalog client must be specified", "missing-client", common.GetComponentInfo())
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	instance, _, err := resourceController.GetResourceInstanceWithContext(ctx, resourceController.NewGetResourceInstanceOptions(id))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-plans-error")
+		return
+	}
+	plans, err = listCatalogPlans(ctx, globalCatalog, instance)
+	err = core.RepurposeSDKProblem(err, "list-plans-error")
+	return
+}
+
+// ChangeResourceInstancePlan moves a resource instance to another plan of its service:
+//
+//  1. The instance is retrieved and must be in a state that permits updates.
+//  2. The plans of the instance's service are retrieved from the Global Catalog, and the
+//     requested plan must be eligible (see ListResourceInstancePlans()).
+//  3. The instance is updated with the new plan.
+//  4. The update is awaited until the instance is active again.
+//
+// An error wrapping ErrPlanChangeNotAllowed (or ErrActionNotPermitted, if the instance cannot be
+// updated in its current state) is returned if the plan change is not valid. If options.DryRun
+// is true, the plan change is validated but not applied.
+func (resourceController *ResourceControllerV2) ChangeResourceInstancePlan(ctx context.Context, options *ChangeResourceInstancePlanOptions) (result *PlanChangeResult, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	instance, _, err := resourceController.GetResourceInstanceWithContext(ctx, resourceController.NewGetResourceInstanceOptions(*options.ID))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "change-plan-error")
+		return
+	}
+	if err = CheckResourceInstanceAction(instance, ResourceInstanceActionUpdate); err != nil {
+		return
+	}
+	plans, err := listCatalogPlans(ctx, options.GlobalCatalog, instance)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "change-plan-error")
+		return
+	}
+	target, err := findEligiblePlan(plans, instance, *options.ResourcePlanID)
+	if err != nil {
+		return
+	}
+
+	result = &PlanChangeResult{
+		Instance:       instance,
+		PreviousPlanID: core.StringNilMapper(instance.ResourcePlanID),
+		TargetPlan:     target,
+	}
+	if options.DryRun {
+		return
+	}
+
+	start := time.Now()
+	updateOptions := resourceController.NewUpdateResourceInstanceOptions(*instance.GUID).SetResourcePlanID(target.ID)
+	if options.Parameters != nil {
+		updateOptions.SetParameters(options.Parameters)
+	}
+	_, _, err = resourceController.UpdateResourceInstanceWithContext(ctx, updateOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "change-plan-error")
+		return
+	}
+	result.Applied = true
+	updated, err := resourceController.WaitForResourceInstanceActive(ctx, *instance.GUID, options.WaitOptions)
+	result.Duration = time.Since(start)
+	if err != nil {
+		return
+	}
+	result.Instance = updated
+	for i := len(updated.PlanHistory) - 1; i >= 0; i-- {
+		if core.StringNilMapper(updated.PlanHistory[i].ResourcePlanID) == target.ID {
+			result.PlanHistoryItem = &updated.PlanHistory[i]
+			break
+		}
+	}
+	core.GetLogger().Debug("Plan change: %s", result.String())
+	return
+}
+
+// findEligiblePlan returns the plan "planID" from "plans", or an error wrapping
+// ErrPlanChangeNotAllowed if the plan is not known or not eligible.
+func findEligiblePlan(plans []CatalogPlan, instance *ResourceInstance, planID string) (*CatalogPlan, error) {
+	for i := range plans {
+		plan := &plans[i]
+		if plan.ID != planID {
+			continue
+		}
+		if !plan.Eligible {
+			err := fmt.Errorf("%w: cannot move resource instance '%s' to plan '%s': %s", ErrPlanChangeNotAllowed,
+				getInstanceID(instance), planID, plan.Reason)
+			return nil, core.SDKErrorf(err, "", "plan-change-not-allowed", common.GetComponentInfo())
+		}
+		return plan, nil
+	}
+	err := fmt.Errorf("%w: cannot move resource instance '%s' to plan '%s': the plan does not belong to service '%s'",
+		ErrPlanChangeNotAllowed, getInstanceID(instance), planID, core.StringNilMapper(instance.ResourceID))
+	return nil, core.SDKErrorf(err, "", "plan-change-not-allowed", common.GetComponentInfo())
+}
+
+// listCatalogPlans retrieves the catalog entries of the service of "instance" and of its plans,
+// and determines which plans are eligible targets of a plan change.
+func listCatalogPlans(ctx context.Context, globalCatalog *globalcatalogv1.GlobalCatalogV1, instance *ResourceInstance) (plans []CatalogPlan, err error) {
+	serviceID := core.StringNilMapper(instance.ResourceID)
+	service, _, err := globalCatalog.GetCatalogEntryWithContext(ctx, globalCatalog.NewGetCatalogEntryOptions(serviceID).SetInclude("metadata"))
+	if err != nil {
+		return
+	}
+	planUpdateable := true
+	if service.Metadata != nil && service.Metadata.Service != nil && service.Metadata.Service.PlanUpdateable != nil {
+		planUpdateable = *service.Metadata.Service.PlanUpdateable
+	}
+
+	plans = []CatalogPlan{}
+	for offset := int64(0); ; offset += catalogPageLimit {
+		childOptions := globalCatalog.NewGetChildObjectsOptions(serviceID, "plan").
+			SetInclude("metadata:geo_tags").SetOffset(offset).SetLimit(catalogPageLimit)
+		var page *globalcatalogv1.EntrySearchResult
+		page, _, err = globalCatalog.GetChildObjectsWithContext(ctx, childOptions)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Resources {
+			entry := &page.Resources[i]
+			plan := CatalogPlan{
+				ID:      core.StringNilMapper(entry.ID),
+				Name:    core.StringNilMapper(entry.Name),
+				Current: core.StringNilMapper(entry.ID) == core.StringNilMapper(instance.ResourcePlanID),
+				Entry:   entry,
+			}
+			plan.Reason = getPlanIneligibility(&plan, planUpdateable, instance)
+			plan.Eligible = plan.Reason == ""
+			plans = append(plans, plan)
+		}
+		if len(page.Resources) < catalogPageLimit || (page.Count != nil && offset+catalogPageLimit >= *page.Count) {
+			break
+		}
+	}
+	return
+}
+
+// getPlanIneligibility returns the reason why the plan of "instance" cannot be changed to
+// "plan", or "" if it can.
+func getPlanIneligibility(plan *CatalogPlan, planUpdateable bool, instance *ResourceInstance) string {
+	entry := plan.Entry
+	switch {
+	case plan.Current:
+		return "it is the current plan of the instance"
+	case !planUpdateable:
+		return "the service does not support plan changes"
+	case entry.Disabled != nil && *entry.Disabled:
+		return "the plan is disabled"
+	case entry.Active != nil && !*entry.Active:
+		return "the plan is not active"
+	}
+	location := core.StringNilMapper(instance.RegionID)
+	if len(entry.GeoTags) > 0 && location != "" && location != "global" {
+		for _, geoTag := range entry.GeoTags {
+			if geoTag == location {
+				return ""
+			}
+		}
+		return fmt.Sprintf("the plan is not available in location '%s'", location)
+	}
+	return ""
+}
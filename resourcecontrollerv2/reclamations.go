@@ -0,0 +1,394 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultReclamationConcurrency is the default value of BulkReclamationOptions.Concurrency.
+const DefaultReclamationConcurrency = 4
+
+// The status of an item in a ReclamationReport.
+const (
+	ReclamationResultStatusSucceededConst = "succeeded"
+	ReclamationResultStatusFailedConst    = "failed"
+	ReclamationResultStatusDryRunConst    = "dry_run"
+)
+
+// ReclamationManager : Lists the soft-deleted (pending reclamation) resource instances of an
+// account and restores or reclaims them in bulk.
+type ReclamationManager struct {
+	client *ResourceControllerV2
+	now    func() time.Time
+}
+
+// NewReclamationManager returns a new ReclamationManager that uses "client" to access the
+// resource controller.
+func NewReclamationManager(client *ResourceControllerV2) (manager *ReclamationManager, err error) {
+	if client == nil {
+		err = core.SDKErrorf(nil, "a client must be specified", "missing-client", common.GetComponentInfo())
+		return
+	}
+	manager = &ReclamationManager{
+		client: client,
+		now:    time.Now,
+	}
+	return
+}
+
+// ReclamationFilter : Selects the reclamations processed by a ReclamationManager.
+// Reclamations are selected only if they satisfy every condition that is set.
+type ReclamationFilter struct {
+	// Selects reclamations in the specified account.
+	AccountID string
+
+	// Selects reclamations of instances in the specified resource group.
+	ResourceGroupID string
+
+	// Selects reclamations of the specified resource instances (GUIDs or CRNs).
+	ResourceInstanceIDs []string
+
+	// Selects reclamations that were created (i.e. instances that were deleted) at least
+	// MinAge ago.
+	MinAge time.Duration
+
+	// Selects reclamations that were created at or after DeletedAfter.
+	DeletedAfter time.Time
+
+	// Selects reclamations that were created before DeletedBefore.
+	DeletedBefore time.Time
+
+	// Selects reclamations whose retention period ends before TargetTimeBefore.
+	TargetTimeBefore time.Time
+
+	// If set, selects reclamations for which Match returns true.
+	Match func(instance *ReclaimableInstance) bool
+}
+
+// ReclaimableInstance : A reclamation joined with the resource instance that it reclaims.
+type ReclaimableInstance struct {
+	// The reclamation.
+	Reclamation *Reclamation
+
+	// The resource instance, or nil if it could not be found.
+	Instance *ResourceInstance
+}
+
+// GetTargetTime returns the time at which the retention period of the reclamation ends, or the
+// zero time if it is unknown.
+func (reclaimable *ReclaimableInstance) GetTargetTime() time.Time {
+	if reclaimable.Reclamation.TargetTime == nil {
+		return time.Time{}
+	}
+	targetTime, err := strfmt.ParseDateTime(*reclaimable.Reclamation.TargetTime)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Time(targetTime)
+}
+
+// getDeletedAt returns the time at which the reclamation was created.
+func (reclaimable *ReclaimableInstance) getDeletedAt() time.Time {
+	if reclaimable.Reclamation.CreatedAt == nil {
+		return time.Time{}
+	}
+	return time.Time(*reclaimable.Reclamation.CreatedAt)
+}
+
+// BulkReclamationOptions : The options for ReclamationManager.RunBulkAction().
+type BulkReclamationOptions struct {
+	// The action to perform: ResourceInstanceActionRestore or ResourceInstanceActionReclaim.
+	Action ResourceInstanceAction `validate:"required"`
+
+	// Selects the reclamations on which the action is performed; all reclamations are selected
+	// if it is nil.
+	Filter *ReclamationFilter
+
+	// The maximum number of actions performed concurrently (defaults to DefaultReclamationConcurrency).
+	Concurrency int
+
+	// If true, the report lists the selected reclamations without performing the action.
+	DryRun bool
+
+	// If set, Progress is invoked (serially) as each result becomes available.
+	Progress func(result *ReclamationResult)
+}
+
+// ReclamationResult : The outcome of the bulk action for one reclamation.
+type ReclamationResult struct {
+	ReclaimableInstance
+
+	// The status of the action (one of the ReclamationResultStatus constants).
+	Status string
+
+	// The reclamation returned by the action, if it succeeded.
+	Result *Reclamation
+
+	// The error that caused the action to fail.
+	Err error
+}
+
+// ReclamationReport : The result of ReclamationManager.RunBulkAction().
+type ReclamationReport struct {
+	// The action that was performed.
+	Action ResourceInstanceAction
+
+	// True if the action was not performed.
+	DryRun bool
+
+	// The results, in the order in which the reclamations were listed.
+	Results []ReclamationResult
+}
+
+// GetResults returns the results with the specified status.
+func (report *ReclamationReport) GetResults(status string) []ReclamationResult {
+	results := []ReclamationResult{}
+	for _, result := range report.Results {
+		if result.Status == status {
+			results = append(results, result)
+		}
+	}
+	return results
+}
+
+// String returns a one-line summary of the report.
+func (report *ReclamationReport) String() string {
+	if report.DryRun {
+		return fmt.Sprintf("%s (dry run): %d reclamations selected", report.Action, len(report.Results))
+	}
+	return fmt.Sprintf("%s: %d succeeded, %d failed", report.Action,
+		len(report.GetResults(ReclamationResultStatusSucceededConst)), len(report.GetResults(ReclamationResultStatusFailedConst)))
+}
+
+// ListReclaimableInstances returns the reclamations selected by "filter" (which may be nil),
+// each joined with the resource instance that it reclaims.
+func (manager *ReclamationManager) ListReclaimableInstances(ctx context.Context, filter *ReclamationFilter) (reclaimables []ReclaimableInstance, err error) {
+	if filter == nil {
+		filter = &ReclamationFilter{}
+	}
+	listOptions := manager.client.NewListReclamationsOptions()
+	if filter.AccountID != "" {
+		listOptions.SetAccountID(filter.AccountID)
+	}
+	if filter.ResourceGroupID != "" {
+		listOptions.SetResourceGroupID(filter.ResourceGroupID)
+	}
+	list, _, err := manager.client.ListReclamationsWithContext(ctx, listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-reclamations-error")
+		return
+	}
+
+	reclaimables = []ReclaimableInstance{}
+	// The conditions on the reclamation are checked first, so that the resource instance is
+	// retrieved only for the reclamations that can be selected.
+	for i := range list.Resources {
+		reclaimable := ReclaimableInstance{Reclamation: &list.Resources[i]}
+		if manager.matchesReclamation(filter, &reclaimable) {
+			reclaimables = append(reclaimables, reclaimable)
+		}
+	}
+
+	errs := make([]error, len(reclaimables))
+	forEachConcurrently(ctx, len(reclaimables), DefaultReclamationConcurrency, func(i int) {
+		instanceID := reclaimables[i].Reclamation.ResourceInstanceID
+		if instanceID == nil {
+			return
+		}
+		instance, _, getErr := manager.client.GetResourceInstanceWithContext(ctx,
+			manager.client.NewGetResourceInstanceOptions(*instanceID))
+		if getErr != nil && !common.IsNotFound(getErr) {
+			errs[i] = getErr
+		}
+		reclaimables[i].Instance = instance
+	})
+	for _, getErr := range errs {
+		if getErr != nil {
+			err = core.RepurposeSDKProblem(getErr, "get-instance-error")
+			return
+		}
+	}
+	if ctx.Err() != nil {
+		err = ctx.Err()
+		return
+	}
+
+	if filter.Match != nil {
+		selected := reclaimables[:0]
+		for i := range reclaimables {
+			if filter.Match(&reclaimables[i]) {
+				selected = append(selected, reclaimables[i])
+			}
+		}
+		reclaimables = selected
+	}
+	return
+}
+
+// matchesReclamation returns true if the reclamation of "reclaimable" satisfies every condition
+// of "filter" other than filter.Match, which also needs the resource instance.
+func (manager *ReclamationManager) matchesReclamation(filter *ReclamationFilter, reclaimable *ReclaimableInstance) bool {
+	if filter.ResourceGroupID != "" && !equalPtr(reclaimable.Reclamation.ResourceGroupID, filter.ResourceGroupID) {
+		return false
+	}
+	if len(filter.ResourceInstanceIDs) > 0 {
+		found := false
+		for _, id := range filter.ResourceInstanceIDs {
+			found = found || equalPtr(reclaimable.Reclamation.ResourceInstanceID, id) || equalPtr(reclaimable.Reclamation.EntityCRN, id)
+		}
+		if !found {
+			return false
+		}
+	}
+	deletedAt := reclaimable.getDeletedAt()
+	if filter.MinAge > 0 && (deletedAt.IsZero() || manager.now().Sub(deletedAt) < filter.MinAge) {
+		return false
+	}
+	if !filter.DeletedAfter.IsZero() && (deletedAt.IsZero() || deletedAt.Before(filter.DeletedAfter)) {
+		return false
+	}
+	if !filter.DeletedBefore.IsZero() && (deletedAt.IsZero() || !deletedAt.Before(filter.DeletedBefore)) {
+		return false
+	}
+	if !filter.TargetTimeBefore.IsZero() {
+		targetTime := reclaimable.GetTargetTime()
+		if targetTime.IsZero() || !targetTime.Before(filter.TargetTimeBefore) {
+			return false
+		}
+	}
+	return true
+}
+
+// RunBulkAction restores or reclaims every reclamation selected by options.Filter, performing at
+// most options.Concurrency actions at a time.
+// A failure of an individual action is recorded in its ReclamationResult and does not stop the
+// other actions; the returned error reports only failures to list the reclamations.
+func (manager *ReclamationManager) RunBulkAction(ctx context.Context, options *BulkReclamationOptions) (report *ReclamationReport, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	if options.Action != ResourceInstanceActionRestore && options.Action != ResourceInstanceActionReclaim {
+		err = core.SDKErrorf(nil, fmt.Sprintf("'%s' is not a reclamation action; use '%s' or '%s'", options.Action,
+			ResourceInstanceActionRestore, ResourceInstanceActionReclaim), "invalid-action", common.GetComponentInfo())
+		return
+	}
+
+	reclaimables, err := manager.ListReclaimableInstances(ctx, options.Filter)
+	if err != nil {
+		return
+	}
+	report = &ReclamationReport{
+		Action:  options.Action,
+		DryRun:  options.DryRun,
+		Results: make([]ReclamationResult, len(reclaimables)),
+	}
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultReclamationConcurrency
+	}
+	var progressMutex sync.Mutex
+	forEachConcurrently(ctx, len(reclaimables), concurrency, func(i int) {
+		result := &report.Results[i]
+		result.ReclaimableInstance = reclaimables[i]
+		manager.runAction(ctx, options, result)
+		if options.Progress != nil {
+			progressMutex.Lock()
+			defer progressMutex.Unlock()
+			options.Progress(result)
+		}
+	})
+	// Actions that were not started because the context is done are reported as failed.
+	for i := range report.Results {
+		if report.Results[i].Status == "" {
+			report.Results[i].ReclaimableInstance = reclaimables[i]
+			report.Results[i].Status = ReclamationResultStatusFailedConst
+			report.Results[i].Err = ctx.Err()
+		}
+	}
+	return
+}
+
+// runAction performs the bulk action on the reclamation of "result" and records its outcome.
+func (manager *ReclamationManager) runAction(ctx context.Context, options *BulkReclamationOptions, result *ReclamationResult) {
+	if result.Instance != nil {
+		if err := CheckResourceInstanceAction(result.Instance, options.Action); err != nil {
+			result.Status, result.Err = ReclamationResultStatusFailedConst, err
+			return
+		}
+	}
+	if options.DryRun {
+		result.Status = ReclamationResultStatusDryRunConst
+		return
+	}
+
+	if result.Reclamation.ID == nil {
+		result.Status = ReclamationResultStatusFailedConst
+		result.Err = core.SDKErrorf(nil, "the reclamation has no ID", "missing-reclamation-id", common.GetComponentInfo())
+		return
+	}
+	actionOptions := manager.client.NewRunReclamationActionOptions(*result.Reclamation.ID, string(options.Action))
+	reclamation, _, err := manager.client.RunReclamationActionWithContext(ctx, actionOptions)
+	if err != nil {
+		result.Status, result.Err = ReclamationResultStatusFailedConst, core.RepurposeSDKProblem(err, "reclamation-action-error")
+		return
+	}
+	result.Status, result.Result = ReclamationResultStatusSucceededConst, reclamation
+}
+
+// forEachConcurrently invokes "f" for each index in [0, n), with at most "concurrency"
+// invocations running at a time. Indexes that have not been started when "ctx" is done are skipped.
+func forEachConcurrently(ctx context.Context, n int, concurrency int, f func(i int)) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for i := 0; i < n; i++ {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			f(i)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func equalPtr(value *string, expected string) bool {
+	return value != nil && *value == expected
+}
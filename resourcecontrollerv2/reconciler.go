@@ -0,0 +1,923 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+)
+
+// DefaultReconcileConcurrency is the default value of ReconcilerOptions.Concurrency.
+const DefaultReconcileConcurrency = 4
+
+// The actions of a ReconcileOperation.
+const (
+	ReconcileActionCreateConst = "create"
+	ReconcileActionUpdateConst = "update"
+	ReconcileActionDeleteConst = "delete"
+)
+
+// The types of resource changed by a ReconcileOperation.
+const (
+	ReconcileResourceInstanceConst = "resource_instance"
+	ReconcileResourceKeyConst      = "resource_key"
+	ReconcileResourceAliasConst    = "resource_alias"
+	ReconcileTagsConst             = "tags"
+)
+
+// The status of a ReconcileOperation.
+const (
+	ReconcileStatusPendingConst   = "pending"
+	ReconcileStatusSucceededConst = "succeeded"
+	ReconcileStatusFailedConst    = "failed"
+	ReconcileStatusSkippedConst   = "skipped"
+)
+
+// DesiredState : The resource instances, with their keys and aliases, that a Reconciler makes exist.
+// The struct tags allow the desired state to be loaded from a JSON or YAML document.
+type DesiredState struct {
+	Instances []DesiredResourceInstance `json:"instances" yaml:"instances"`
+}
+
+// DesiredResourceInstance : A resource instance of a DesiredState.
+// Instances are identified by their name, which must be unique within their resource group.
+type DesiredResourceInstance struct {
+	// The name of the instance.
+	Name string `json:"name" yaml:"name"`
+
+	// The ID of the plan of the instance.
+	ResourcePlanID string `json:"resource_plan_id" yaml:"resource_plan_id"`
+
+	// The deployment location of the instance (e.g. "us-south" or "global").
+	Target string `json:"target" yaml:"target"`
+
+	// The ID of the resource group of the instance.
+	ResourceGroupID string `json:"resource_group_id" yaml:"resource_group_id"`
+
+	// Configuration parameters of the instance. Only the parameters that are specified are
+	// compared with those of the live instance.
+	Parameters map[string]interface{} `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+
+	// The user tags of the instance. If nil, the tags of existing instances are not reconciled.
+	Tags []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+
+	// The keys of the instance, identified by name.
+	Keys []DesiredResourceKey `json:"keys,omitempty" yaml:"keys,omitempty"`
+
+	// The aliases of the instance, identified by name.
+	Aliases []DesiredResourceAlias `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+}
+
+// DesiredResourceKey : A resource key of a DesiredResourceInstance.
+type DesiredResourceKey struct {
+	// The name of the key.
+	Name string `json:"name" yaml:"name"`
+
+	// The role of the key, as a role name (e.g. "Writer") or CRN. If empty, the service's default
+	// role is used and the role of an existing key is not checked.
+	Role string `json:"role,omitempty" yaml:"role,omitempty"`
+
+	// Parameters used when the key is created.
+	Parameters map[string]interface{} `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+}
+
+// DesiredResourceAlias : A resource alias of a DesiredResourceInstance.
+type DesiredResourceAlias struct {
+	// The name of the alias.
+	Name string `json:"name" yaml:"name"`
+
+	// The CRN of the target namespace of the alias.
+	Target string `json:"target" yaml:"target"`
+}
+
+// ReconcilerOptions : The options used to configure a Reconciler.
+type ReconcilerOptions struct {
+	// The client used to reconcile the tags of existing instances. If nil, tags are applied only
+	// when instances are created.
+	GlobalTagging *globaltaggingv1.GlobalTaggingV1
+
+	// If true, the plan deletes the live instances in the resource groups of the desired state,
+	// and the keys and aliases of desired instances, that are not in the desired state.
+	Prune bool
+
+	// The maximum number of instances that are read or changed concurrently
+	// (defaults to DefaultReconcileConcurrency).
+	Concurrency int
+
+	// The options of the waiters used while the plan is applied.
+	WaitOptions *WaitOptions
+}
+
+// Reconciler : Computes and applies the operations that make the live resource instances, keys
+// and aliases of an account match a DesiredState.
+type Reconciler struct {
+	client  *ResourceControllerV2
+	options ReconcilerOptions
+}
+
+// NewReconciler returns a new Reconciler that uses "client" to access the resource controller.
+func NewReconciler(client *ResourceControllerV2, options *ReconcilerOptions) (reconciler *Reconciler, err error) {
+	if client == nil {
+		err = core.SDKErrorf(nil, "a client must be specified", "missing-client", common.GetComponentInfo())
+		return
+	}
+	reconciler = &Reconciler{client: client}
+	if options != nil {
+		reconciler.options = *options
+	}
+	if reconciler.options.Concurrency <= 0 {
+		reconciler.options.Concurrency = DefaultReconcileConcurrency
+	}
+	return
+}
+
+// ReconcileOperation : A change made by a ReconcilePlan.
+type ReconcileOperation struct {
+	// The action (one of the ReconcileAction constants).
+	Action string
+
+	// The type of resource that is changed (one of the ReconcileResource constants, or ReconcileTagsConst).
+	ResourceType string
+
+	// The name of the resource. For tag operations, the name of the instance.
+	Name string
+
+	// The name of the instance that a key or alias belongs to.
+	InstanceName string
+
+	// The resource group of the instance.
+	ResourceGroupID string
+
+	// The GUID of the live resource, if it exists.
+	ID string
+
+	// A description of each change made by the operation.
+	Changes []string
+
+	// The status of the operation (one of the ReconcileStatus constants).
+	Status string
+
+	// The error that caused the operation to fail or be skipped.
+	Err error
+
+	instance *reconciledInstance
+	run      func(ctx context.Context) error
+}
+
+// String returns a one-line description of the operation.
+func (operation *ReconcileOperation) String() string {
+	symbol := map[string]string{
+		ReconcileActionCreateConst: "+",
+		ReconcileActionUpdateConst: "~",
+		ReconcileActionDeleteConst: "-",
+	}[operation.Action]
+	description := fmt.Sprintf("%s %s %s %q", symbol, operation.Action, operation.ResourceType, operation.Name)
+	if operation.InstanceName != "" {
+		description += fmt.Sprintf(" of instance %q", operation.InstanceName)
+	}
+	if len(operation.Changes) > 0 {
+		description += ": " + strings.Join(operation.Changes, ", ")
+	}
+	return description
+}
+
+// ReconcilePlan : The operations that make the live state match a DesiredState, as computed by
+// Reconciler.Plan().
+type ReconcilePlan struct {
+	// The operations, in the order in which they are applied.
+	Operations []*ReconcileOperation
+
+	// Differences that cannot be reconciled (e.g. a change of the region of an instance).
+	// They are reported but do not prevent the plan from being applied.
+	Conflicts []string
+}
+
+// IsEmpty returns true if the plan has no operations.
+func (plan *ReconcilePlan) IsEmpty() bool {
+	return len(plan.Operations) == 0
+}
+
+// String renders the plan for review, one operation or conflict per line, followed by a summary.
+func (plan *ReconcilePlan) String() string {
+	var builder strings.Builder
+	counts := map[string]int{}
+	for _, operation := range plan.Operations {
+		builder.WriteString(operation.String())
+		builder.WriteString("\n")
+		counts[operation.Action]++
+	}
+	for _, conflict := range plan.Conflicts {
+		fmt.Fprintf(&builder, "! %s\n", conflict)
+	}
+	fmt.Fprintf(&builder, "Plan: %d to create, %d to update, %d to delete, %d conflicts.",
+		counts[ReconcileActionCreateConst], counts[ReconcileActionUpdateConst], counts[ReconcileActionDeleteConst], len(plan.Conflicts))
+	return builder.String()
+}
+
+// reconciledInstance tracks the live instance that the operations of a desired instance apply to.
+type reconciledInstance struct {
+	guid   string
+	crn    string
+	failed bool
+}
+
+// liveInstance is a live instance with its keys, aliases and (if retrieved) user tags.
+type liveInstance struct {
+	instance ResourceInstance
+	keys     []ResourceKey
+	aliases  []ResourceAlias
+	tags     []string
+}
+
+// Plan compares "desired" with the live resource instances in its resource groups, and returns
+// the operations that make the live state match it. The plan can be rendered with String() for
+// a dry run, and applied with Apply().
+func (reconciler *Reconciler) Plan(ctx context.Context, desired *DesiredState) (plan *ReconcilePlan, err error) {
+	err = validateDesiredState(desired)
+	if err != nil {
+		return
+	}
+
+	live, err := reconciler.getLiveInstances(ctx, desired)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "plan-error")
+		return
+	}
+
+	plan = &ReconcilePlan{}
+	matched := map[*liveInstance]bool{}
+	for i := range desired.Instances {
+		want := &desired.Instances[i]
+		candidates := live[want.ResourceGroupID][want.Name]
+		if len(candidates) > 1 {
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("instance %q: %d live instances named %q exist in resource group '%s'",
+				want.Name, len(candidates), want.Name, want.ResourceGroupID))
+			for _, candidate := range candidates {
+				matched[candidate] = true
+			}
+			continue
+		}
+		if len(candidates) == 0 {
+			reconciler.planCreateInstance(plan, want)
+			continue
+		}
+		matched[candidates[0]] = true
+		switch state := getState(&candidates[0].instance); state {
+		case ResourceInstanceStatePendingReclamationConst:
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("instance %q: the live instance is pending reclamation; restore or reclaim it first",
+				want.Name))
+		case ResourceInstanceStateFailedConst:
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("instance %q: the live instance is in state '%s'; delete it first",
+				want.Name, state))
+		default:
+			reconciler.planUpdateInstance(plan, want, candidates[0])
+		}
+	}
+
+	if reconciler.options.Prune {
+		var groupIDs []string
+		for groupID := range live {
+			groupIDs = append(groupIDs, groupID)
+		}
+		sort.Strings(groupIDs)
+		for _, groupID := range groupIDs {
+			var names []string
+			for name := range live[groupID] {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				for _, candidate := range live[groupID][name] {
+					// Instances pending reclamation have already been deleted.
+					if !matched[candidate] && getState(&candidate.instance) != ResourceInstanceStatePendingReclamationConst {
+						reconciler.planDeleteInstance(plan, candidate)
+					}
+				}
+			}
+		}
+	}
+
+	// Order the operations by the phase in which they are applied.
+	sort.SliceStable(plan.Operations, func(i, j int) bool {
+		return getPhase(plan.Operations[i]) < getPhase(plan.Operations[j])
+	})
+	return
+}
+
+// validateDesiredState checks that the required properties are set and that names are unique.
+func validateDesiredState(desired *DesiredState) error {
+	if desired == nil {
+		return core.SDKErrorf(nil, "the desired state cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	var problems []string
+	instanceNames := map[string]bool{}
+	for i, instance := range desired.Instances {
+		required := []struct{ property, value string }{
+			{"name", instance.Name},
+			{"resource_plan_id", instance.ResourcePlanID},
+			{"target", instance.Target},
+			{"resource_group_id", instance.ResourceGroupID},
+		}
+		for _, field := range required {
+			if field.value == "" {
+				problems = append(problems, fmt.Sprintf("instances[%d]: '%s' is required", i, field.property))
+			}
+		}
+		qualifiedName := instance.ResourceGroupID + "/" + instance.Name
+		if instanceNames[qualifiedName] {
+			problems = append(problems, fmt.Sprintf("instances[%d]: the name %q is not unique in its resource group", i, instance.Name))
+		}
+		instanceNames[qualifiedName] = true
+
+		keyNames := map[string]bool{}
+		for j, key := range instance.Keys {
+			if key.Name == "" || keyNames[key.Name] {
+				problems = append(problems, fmt.Sprintf("instances[%d].keys[%d]: a unique name is required", i, j))
+			}
+			keyNames[key.Name] = true
+		}
+		aliasNames := map[string]bool{}
+		for j, alias := range instance.Aliases {
+			if alias.Name == "" || aliasNames[alias.Name] {
+				problems = append(problems, fmt.Sprintf("instances[%d].aliases[%d]: a unique name is required", i, j))
+			}
+			if alias.Target == "" {
+				problems = append(problems, fmt.Sprintf("instances[%d].aliases[%d]: 'target' is required", i, j))
+			}
+			aliasNames[alias.Name] = true
+		}
+	}
+	if len(problems) > 0 {
+		return core.SDKErrorf(nil, "the desired state is not valid: "+strings.Join(problems, "; "),
+			"invalid-desired-state", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// getLiveInstances returns the live instances in the resource groups of the desired state, indexed
+// by resource group ID and name. Instances in every state other than removed are returned, so that
+// a failed instance or an instance pending reclamation is not created again.
+func (reconciler *Reconciler) getLiveInstances(ctx context.Context, desired *DesiredState) (live map[string]map[string][]*liveInstance, err error) {
+	live = map[string]map[string][]*liveInstance{}
+	var all []*liveInstance
+	for _, want := range desired.Instances {
+		if _, ok := live[want.ResourceGroupID]; ok {
+			continue
+		}
+		live[want.ResourceGroupID] = map[string][]*liveInstance{}
+
+		var instances []ResourceInstance
+		instances, err = reconciler.client.ListNonRemovedResourceInstances(ctx, &ListResourceInstancesOptions{
+			ResourceGroupID: core.StringPtr(want.ResourceGroupID),
+		})
+		if err != nil {
+			return
+		}
+		for _, instance := range instances {
+			if instance.Name == nil || instance.GUID == nil {
+				continue
+			}
+			entry := &liveInstance{instance: instance}
+			live[want.ResourceGroupID][*instance.Name] = append(live[want.ResourceGroupID][*instance.Name], entry)
+			// The keys and aliases of an instance pending reclamation are neither updated nor deleted.
+			if getState(&instance) != ResourceInstanceStatePendingReclamationConst {
+				all = append(all, entry)
+			}
+		}
+	}
+
+	// Retrieve the keys, aliases and tags of each live instance.
+	errs := make([]error, len(all))
+	forEachConcurrently(ctx, len(all), reconciler.options.Concurrency, func(i int) {
+		errs[i] = reconciler.getLiveDetails(ctx, all[i])
+	})
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	for _, detailsErr := range errs {
+		if detailsErr != nil {
+			err = detailsErr
+			return
+		}
+	}
+	return
+}
+
+// getLiveDetails retrieves the keys, aliases and user tags of "live".
+func (reconciler *Reconciler) getLiveDetails(ctx context.Context, live *liveInstance) error {
+	keysPager, err := reconciler.client.NewResourceKeysForInstancePager(&ListResourceKeysForInstanceOptions{ID: live.instance.GUID})
+	if err != nil {
+		return err
+	}
+	if live.keys, err = keysPager.GetAllWithContext(ctx); err != nil {
+		return err
+	}
+	aliasesPager, err := reconciler.client.NewResourceAliasesForInstancePager(&ListResourceAliasesForInstanceOptions{ID: live.instance.GUID})
+	if err != nil {
+		return err
+	}
+	if live.aliases, err = aliasesPager.GetAllWithContext(ctx); err != nil {
+		return err
+	}
+
+	if reconciler.options.GlobalTagging == nil || live.instance.CRN == nil {
+		return nil
+	}
+	tagsPager, err := reconciler.options.GlobalTagging.NewTagsPager(&globaltaggingv1.ListTagsOptions{
+		AttachedTo: live.instance.CRN,
+		TagType:    core.StringPtr(globaltaggingv1.ListTagsOptionsTagTypeUserConst),
+	})
+	if err != nil {
+		return err
+	}
+	tags, err := tagsPager.GetAllWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	live.tags = []string{}
+	for _, tag := range tags {
+		live.tags = append(live.tags, *tag.Name)
+	}
+	return nil
+}
+
+// getPhase returns the phase of Apply() in which the operation is performed:
+// instances are created and updated first, then their keys, aliases and tags; keys and aliases
+// are deleted before instances.
+func getPhase(operation *ReconcileOperation) int {
+	switch {
+	case operation.ResourceType == ReconcileResourceInstanceConst && operation.Action != ReconcileActionDeleteConst:
+		return 0
+	case operation.Action != ReconcileActionDeleteConst:
+		return 1
+	case operation.ResourceType != ReconcileResourceInstanceConst:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func (reconciler *Reconciler) planCreateInstance(plan *ReconcilePlan, want *DesiredResourceInstance) {
+	instance := &reconciledInstance{}
+	changes := []string{
+		fmt.Sprintf("resource_plan_id=%s", want.ResourcePlanID),
+		fmt.Sprintf("target=%s", want.Target),
+	}
+	if len(want.Parameters) > 0 {
+		changes = append(changes, fmt.Sprintf("parameters=%s", formatValue(want.Parameters)))
+	}
+	if len(want.Tags) > 0 {
+		changes = append(changes, fmt.Sprintf("tags=%s", strings.Join(want.Tags, ",")))
+	}
+	plan.Operations = append(plan.Operations, &ReconcileOperation{
+		Action:          ReconcileActionCreateConst,
+		ResourceType:    ReconcileResourceInstanceConst,
+		Name:            want.Name,
+		ResourceGroupID: want.ResourceGroupID,
+		Changes:         changes,
+		instance:        instance,
+		run: func(ctx context.Context) error {
+			createOptions := reconciler.client.NewCreateResourceInstanceOptions(want.Name, want.Target, want.ResourceGroupID, want.ResourcePlanID)
+			createOptions.Parameters = want.Parameters
+			createOptions.Tags = want.Tags
+			created, _, err := reconciler.client.CreateResourceInstanceWithContext(ctx, createOptions)
+			if err != nil {
+				return err
+			}
+			instance.guid, instance.crn = *created.GUID, *created.CRN
+			_, err = reconciler.client.WaitForResourceInstanceActive(ctx, instance.guid, reconciler.options.WaitOptions)
+			return err
+		},
+	})
+	for i := range want.Keys {
+		reconciler.planCreateKey(plan, want, instance, &want.Keys[i])
+	}
+	for i := range want.Aliases {
+		reconciler.planCreateAlias(plan, want, instance, &want.Aliases[i])
+	}
+}
+
+func (reconciler *Reconciler) planUpdateInstance(plan *ReconcilePlan, want *DesiredResourceInstance, live *liveInstance) {
+	instance := &reconciledInstance{guid: *live.instance.GUID}
+	if live.instance.CRN != nil {
+		instance.crn = *live.instance.CRN
+	}
+	if live.instance.RegionID != nil && *live.instance.RegionID != want.Target {
+		plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("instance %q: the target cannot be changed from '%s' to '%s'",
+			want.Name, *live.instance.RegionID, want.Target))
+	}
+
+	updateOptions := reconciler.client.NewUpdateResourceInstanceOptions(instance.guid)
+	var changes []string
+	if live.instance.ResourcePlanID == nil || *live.instance.ResourcePlanID != want.ResourcePlanID {
+		changes = append(changes, fmt.Sprintf("resource_plan_id: %s -> %s", formatValue(live.instance.ResourcePlanID), want.ResourcePlanID))
+		updateOptions.SetResourcePlanID(want.ResourcePlanID)
+	}
+	if changed := getChangedParameters(want.Parameters, live.instance.Parameters); len(changed) > 0 {
+		var names []string
+		for name := range changed {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			changes = append(changes, fmt.Sprintf("parameters.%s: %s -> %s", name, formatValue(live.instance.Parameters[name]), formatValue(changed[name])))
+		}
+		updateOptions.SetParameters(changed)
+	}
+	if len(changes) > 0 {
+		plan.Operations = append(plan.Operations, &ReconcileOperation{
+			Action:          ReconcileActionUpdateConst,
+			ResourceType:    ReconcileResourceInstanceConst,
+			Name:            want.Name,
+			ResourceGroupID: want.ResourceGroupID,
+			ID:              instance.guid,
+			Changes:         changes,
+			instance:        instance,
+			run: func(ctx context.Context) error {
+				_, _, err := reconciler.client.UpdateResourceInstanceWithContext(ctx, updateOptions)
+				if err != nil {
+					return err
+				}
+				_, err = reconciler.client.WaitForResourceInstanceActive(ctx, instance.guid, reconciler.options.WaitOptions)
+				return err
+			},
+		})
+	}
+
+	if want.Tags != nil && reconciler.options.GlobalTagging != nil {
+		reconciler.planTags(plan, want, instance, live.tags)
+	}
+
+	liveKeys := map[string]*ResourceKey{}
+	for i := range live.keys {
+		liveKeys[*live.keys[i].Name] = &live.keys[i]
+	}
+	wantKeys := map[string]bool{}
+	for i := range want.Keys {
+		wantKey := &want.Keys[i]
+		wantKeys[wantKey.Name] = true
+		liveKey := liveKeys[wantKey.Name]
+		switch {
+		case liveKey == nil:
+			reconciler.planCreateKey(plan, want, instance, wantKey)
+		case wantKey.Role != "" && !isSameRole(liveKey, wantKey.Role):
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("key %q of instance %q: the role cannot be changed to '%s'; rotate the key instead",
+				wantKey.Name, want.Name, wantKey.Role))
+		}
+	}
+	liveAliases := map[string]*ResourceAlias{}
+	for i := range live.aliases {
+		liveAliases[*live.aliases[i].Name] = &live.aliases[i]
+	}
+	wantAliases := map[string]bool{}
+	for i := range want.Aliases {
+		wantAlias := &want.Aliases[i]
+		wantAliases[wantAlias.Name] = true
+		liveAlias := liveAliases[wantAlias.Name]
+		switch {
+		case liveAlias == nil:
+			reconciler.planCreateAlias(plan, want, instance, wantAlias)
+		case liveAlias.TargetCRN == nil || *liveAlias.TargetCRN != wantAlias.Target:
+			plan.Conflicts = append(plan.Conflicts, fmt.Sprintf("alias %q of instance %q: the target cannot be changed to '%s'",
+				wantAlias.Name, want.Name, wantAlias.Target))
+		}
+	}
+
+	if !reconciler.options.Prune {
+		return
+	}
+	for i := range live.keys {
+		if key := &live.keys[i]; !wantKeys[*key.Name] {
+			plan.Operations = append(plan.Operations, reconciler.newDeleteKeyOperation(want.Name, want.ResourceGroupID, key))
+		}
+	}
+	for i := range live.aliases {
+		if alias := &live.aliases[i]; !wantAliases[*alias.Name] {
+			plan.Operations = append(plan.Operations, reconciler.newDeleteAliasOperation(want.Name, want.ResourceGroupID, alias))
+		}
+	}
+}
+
+func (reconciler *Reconciler) planTags(plan *ReconcilePlan, want *DesiredResourceInstance, instance *reconciledInstance, liveTags []string) {
+	attach := difference(want.Tags, liveTags)
+	detach := difference(liveTags, want.Tags)
+	if len(attach) == 0 && len(detach) == 0 {
+		return
+	}
+	var changes []string
+	for _, tag := range attach {
+		changes = append(changes, "+"+tag)
+	}
+	for _, tag := range detach {
+		changes = append(changes, "-"+tag)
+	}
+	globalTagging := reconciler.options.GlobalTagging
+	plan.Operations = append(plan.Operations, &ReconcileOperation{
+		Action:          ReconcileActionUpdateConst,
+		ResourceType:    ReconcileTagsConst,
+		Name:            want.Name,
+		ResourceGroupID: want.ResourceGroupID,
+		ID:              instance.guid,
+		Changes:         changes,
+		instance:        instance,
+		run: func(ctx context.Context) error {
+			resources := []globaltaggingv1.Resource{{ResourceID: core.StringPtr(instance.crn)}}
+			if len(attach) > 0 {
+				attachOptions := globalTagging.NewAttachTagOptions(resources).SetTagNames(attach).
+					SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+				if _, _, err := globalTagging.AttachTagWithContext(ctx, attachOptions); err != nil {
+					return err
+				}
+			}
+			if len(detach) > 0 {
+				detachOptions := globalTagging.NewDetachTagOptions(resources).SetTagNames(detach).
+					SetTagType(globaltaggingv1.DetachTagOptionsTagTypeUserConst)
+				if _, _, err := globalTagging.DetachTagWithContext(ctx, detachOptions); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func (reconciler *Reconciler) planCreateKey(plan *ReconcilePlan, want *DesiredResourceInstance, instance *reconciledInstance, wantKey *DesiredResourceKey) {
+	var changes []string
+	if wantKey.Role != "" {
+		changes = append(changes, "role="+wantKey.Role)
+	}
+	plan.Operations = append(plan.Operations, &ReconcileOperation{
+		Action:          ReconcileActionCreateConst,
+		ResourceType:    ReconcileResourceKeyConst,
+		Name:            wantKey.Name,
+		InstanceName:    want.Name,
+		ResourceGroupID: want.ResourceGroupID,
+		Changes:         changes,
+		instance:        instance,
+		run: func(ctx context.Context) error {
+			createOptions := reconciler.client.NewCreateResourceKeyOptions(wantKey.Name, instance.guid)
+			if wantKey.Role != "" {
+				createOptions.SetRole(wantKey.Role)
+			}
+			if len(wantKey.Parameters) > 0 {
+				parameters := &ResourceKeyPostParameters{}
+				for name, value := range wantKey.Parameters {
+					if serviceIDCRN, ok := value.(string); ok && name == "serviceid_crn" {
+						parameters.ServiceidCRN = core.StringPtr(serviceIDCRN)
+					} else {
+						parameters.SetProperty(name, value)
+					}
+				}
+				createOptions.SetParameters(parameters)
+			}
+			_, _, err := reconciler.client.CreateResourceKeyWithContext(ctx, createOptions)
+			return err
+		},
+	})
+}
+
+func (reconciler *Reconciler) planCreateAlias(plan *ReconcilePlan, want *DesiredResourceInstance, instance *reconciledInstance, wantAlias *DesiredResourceAlias) {
+	plan.Operations = append(plan.Operations, &ReconcileOperation{
+		Action:          ReconcileActionCreateConst,
+		ResourceType:    ReconcileResourceAliasConst,
+		Name:            wantAlias.Name,
+		InstanceName:    want.Name,
+		ResourceGroupID: want.ResourceGroupID,
+		Changes:         []string{"target=" + wantAlias.Target},
+		instance:        instance,
+		run: func(ctx context.Context) error {
+			createOptions := reconciler.client.NewCreateResourceAliasOptions(wantAlias.Name, instance.guid, wantAlias.Target)
+			_, _, err := reconciler.client.CreateResourceAliasWithContext(ctx, createOptions)
+			return err
+		},
+	})
+}
+
+func (reconciler *Reconciler) newDeleteKeyOperation(instanceName string, resourceGroupID string, key *ResourceKey) *ReconcileOperation {
+	guid := *key.GUID
+	return &ReconcileOperation{
+		Action:          ReconcileActionDeleteConst,
+		ResourceType:    ReconcileResourceKeyConst,
+		Name:            *key.Name,
+		InstanceName:    instanceName,
+		ResourceGroupID: resourceGroupID,
+		ID:              guid,
+		run: func(ctx context.Context) error {
+			_, err := reconciler.client.DeleteResourceKeyWithContext(ctx, reconciler.client.NewDeleteResourceKeyOptions(guid))
+			return err
+		},
+	}
+}
+
+func (reconciler *Reconciler) newDeleteAliasOperation(instanceName string, resourceGroupID string, alias *ResourceAlias) *ReconcileOperation {
+	guid := *alias.GUID
+	return &ReconcileOperation{
+		Action:          ReconcileActionDeleteConst,
+		ResourceType:    ReconcileResourceAliasConst,
+		Name:            *alias.Name,
+		InstanceName:    instanceName,
+		ResourceGroupID: resourceGroupID,
+		ID:              guid,
+		run: func(ctx context.Context) error {
+			_, err := reconciler.client.DeleteResourceAliasWithContext(ctx, reconciler.client.NewDeleteResourceAliasOptions(guid))
+			return err
+		},
+	}
+}
+
+func (reconciler *Reconciler) planDeleteInstance(plan *ReconcilePlan, live *liveInstance) {
+	name := *live.instance.Name
+	groupID := ""
+	if live.instance.ResourceGroupID != nil {
+		groupID = *live.instance.ResourceGroupID
+	}
+	for i := range live.keys {
+		plan.Operations = append(plan.Operations, reconciler.newDeleteKeyOperation(name, groupID, &live.keys[i]))
+	}
+	for i := range live.aliases {
+		plan.Operations = append(plan.Operations, reconciler.newDeleteAliasOperation(name, groupID, &live.aliases[i]))
+	}
+
+	guid := *live.instance.GUID
+	plan.Operations = append(plan.Operations, &ReconcileOperation{
+		Action:          ReconcileActionDeleteConst,
+		ResourceType:    ReconcileResourceInstanceConst,
+		Name:            name,
+		ResourceGroupID: groupID,
+		ID:              guid,
+		run: func(ctx context.Context) error {
+			deleteOptions := reconciler.client.NewDeleteResourceInstanceOptions(guid).SetRecursive(true)
+			if _, err := reconciler.client.DeleteResourceInstanceWithContext(ctx, deleteOptions); err != nil {
+				return err
+			}
+			_, err := reconciler.client.WaitForResourceInstanceState(ctx, guid,
+				[]string{ResourceInstanceStateRemovedConst, ResourceInstanceStatePendingReclamationConst}, reconciler.options.WaitOptions)
+			if common.IsNotFound(err) {
+				err = nil
+			}
+			return err
+		},
+	})
+}
+
+// Apply performs the operations of "plan" in dependency order, waiting for instances to become
+// active (or removed) before the operations that depend on them:
+//
+//  1. Instances are created and updated.
+//  2. Keys, aliases and tags are created and updated.
+//  3. Keys and aliases are deleted.
+//  4. Instances are deleted.
+//
+// The operations of each phase are performed concurrently. An operation that fails does not stop
+// the other operations, but the operations that depend on a failed instance operation are skipped.
+// The Status and Err of each operation are updated, and an error that combines the errors of the
+// failed operations is returned.
+func (reconciler *Reconciler) Apply(ctx context.Context, plan *ReconcilePlan) error {
+	if plan == nil {
+		return core.SDKErrorf(nil, "the plan cannot be nil", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	for _, operation := range plan.Operations {
+		operation.Status, operation.Err = ReconcileStatusPendingConst, nil
+	}
+
+	var mutex sync.Mutex
+	var errs []error
+	for phase := 0; phase <= 3; phase++ {
+		var operations []*ReconcileOperation
+		for _, operation := range plan.Operations {
+			if getPhase(operation) == phase {
+				operations = append(operations, operation)
+			}
+		}
+		forEachConcurrently(ctx, len(operations), reconciler.options.Concurrency, func(i int) {
+			operation := operations[i]
+			if operation.instance != nil && operation.instance.failed {
+				operation.Status = ReconcileStatusSkippedConst
+				operation.Err = fmt.Errorf("the operation on instance %q failed", operation.getInstanceName())
+				return
+			}
+			err := operation.run(ctx)
+			if err == nil {
+				operation.Status = ReconcileStatusSucceededConst
+				return
+			}
+			operation.Status, operation.Err = ReconcileStatusFailedConst, err
+			if operation.instance != nil && operation.ResourceType == ReconcileResourceInstanceConst {
+				operation.instance.failed = true
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			errs = append(errs, fmt.Errorf("%s: %w", strings.TrimLeft(operation.String(), "+~- "), err))
+		})
+	}
+	for _, operation := range plan.Operations {
+		if operation.Status == ReconcileStatusPendingConst {
+			operation.Status, operation.Err = ReconcileStatusSkippedConst, ctx.Err()
+		}
+	}
+	if ctx.Err() != nil {
+		errs = append(errs, ctx.Err())
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return core.SDKErrorf(errors.Join(errs...), "", "apply-error", common.GetComponentInfo())
+}
+
+func (operation *ReconcileOperation) getInstanceName() string {
+	if operation.InstanceName != "" {
+		return operation.InstanceName
+	}
+	return operation.Name
+}
+
+// getChangedParameters returns the desired parameters whose values differ from the live parameters.
+func getChangedParameters(desired map[string]interface{}, live map[string]interface{}) map[string]interface{} {
+	changed := map[string]interface{}{}
+	for name, value := range desired {
+		liveValue, ok := live[name]
+		if !ok || !reflect.DeepEqual(normalizeValue(value), normalizeValue(liveValue)) {
+			changed[name] = value
+		}
+	}
+	return changed
+}
+
+// normalizeValue returns "value" as it would be decoded from JSON, so that, for example, an int
+// loaded from YAML compares equal to the float64 returned by the service.
+func normalizeValue(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return value
+	}
+	return normalized
+}
+
+func formatValue(value interface{}) string {
+	if pointer, ok := value.(*string); ok {
+		if pointer == nil {
+			return "<none>"
+		}
+		return *pointer
+	}
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
+
+// isSameRole returns true if the role of "key" is "role", which may be a role name or CRN.
+func isSameRole(key *ResourceKey, role string) bool {
+	if key.Credentials == nil || key.Credentials.IamRoleCRN == nil {
+		return false
+	}
+	roleCRN := *key.Credentials.IamRoleCRN
+	return roleCRN == role || strings.HasSuffix(roleCRN, ":"+role)
+}
+
+// difference returns the elements of "a" that are not in "b".
+func difference(a []string, b []string) []string {
+	present := map[string]bool{}
+	for _, value := range b {
+		present[value] = true
+	}
+	result := []string{}
+	for _, value := range a {
+		if !present[value] {
+			result = append(result, value)
+		}
+	}
+	return result
+}
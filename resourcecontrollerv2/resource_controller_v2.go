@@ -252,7 +252,7 @@ func (resourceController *ResourceControllerV2) ListResourceInstancesWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceInstances", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_instances", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -350,7 +350,7 @@ func (resourceController *ResourceControllerV2) CreateResourceInstanceWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "CreateResourceInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -420,7 +420,7 @@ func (resourceController *ResourceControllerV2) GetResourceInstanceWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "GetResourceInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -492,7 +492,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceInstanceWithContex
 		return
 	}
 
-	response, err = resourceController.Service.Request(request, nil)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "DeleteResourceInstance", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -574,7 +574,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceInstanceWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "UpdateResourceInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -653,7 +653,7 @@ func (resourceController *ResourceControllerV2) ListResourceAliasesForInstanceWi
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceAliasesForInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_aliases_for_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -730,7 +730,7 @@ func (resourceController *ResourceControllerV2) ListResourceKeysForInstanceWithC
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceKeysForInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_keys_for_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -800,7 +800,7 @@ func (resourceController *ResourceControllerV2) LockResourceInstanceWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "LockResourceInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "lock_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -870,7 +870,7 @@ func (resourceController *ResourceControllerV2) UnlockResourceInstanceWithContex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "UnlockResourceInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "unlock_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -940,7 +940,7 @@ func (resourceController *ResourceControllerV2) CancelLastopResourceInstanceWith
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "CancelLastopResourceInstance", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "cancel_lastop_resource_instance", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1025,7 +1025,7 @@ func (resourceController *ResourceControllerV2) ListResourceKeysWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceKeys", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_keys", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1110,7 +1110,7 @@ func (resourceController *ResourceControllerV2) CreateResourceKeyWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "CreateResourceKey", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1179,7 +1179,7 @@ func (resourceController *ResourceControllerV2) GetResourceKeyWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "GetResourceKey", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1246,7 +1246,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceKeyWithContext(ctx
 		return
 	}
 
-	response, err = resourceController.Service.Request(request, nil)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "DeleteResourceKey", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1318,7 +1318,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceKeyWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "UpdateResourceKey", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_key", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1409,7 +1409,7 @@ func (resourceController *ResourceControllerV2) ListResourceBindingsWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceBindings", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_bindings", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1500,7 +1500,7 @@ func (resourceController *ResourceControllerV2) CreateResourceBindingWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "CreateResourceBinding", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_binding", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1573,7 +1573,7 @@ func (resourceController *ResourceControllerV2) GetResourceBindingWithContext(ct
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "GetResourceBinding", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_binding", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1643,7 +1643,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceBindingWithContext
 		return
 	}
 
-	response, err = resourceController.Service.Request(request, nil)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "DeleteResourceBinding", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_binding", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1718,7 +1718,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceBindingWithContext
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "UpdateResourceBinding", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_binding", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1812,7 +1812,7 @@ func (resourceController *ResourceControllerV2) ListResourceAliasesWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceAliases", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_aliases", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1897,7 +1897,7 @@ func (resourceController *ResourceControllerV2) CreateResourceAliasWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "CreateResourceAlias", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_resource_alias", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1970,7 +1970,7 @@ func (resourceController *ResourceControllerV2) GetResourceAliasWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "GetResourceAlias", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_alias", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2045,7 +2045,7 @@ func (resourceController *ResourceControllerV2) DeleteResourceAliasWithContext(c
 		return
 	}
 
-	response, err = resourceController.Service.Request(request, nil)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "DeleteResourceAlias", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_resource_alias", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2120,7 +2120,7 @@ func (resourceController *ResourceControllerV2) UpdateResourceAliasWithContext(c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "UpdateResourceAlias", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_resource_alias", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2199,7 +2199,7 @@ func (resourceController *ResourceControllerV2) ListResourceBindingsForAliasWith
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListResourceBindingsForAlias", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_resource_bindings_for_alias", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2269,7 +2269,7 @@ func (resourceController *ResourceControllerV2) ListReclamationsWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "ListReclamations", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "list_reclamations", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -2354,7 +2354,7 @@ func (resourceController *ResourceControllerV2) RunReclamationActionWithContext(
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceController.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceController.Service, "resource_controller", "V2", "RunReclamationAction", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "run_reclamation_action", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -5733,6 +5733,33 @@ func (pager *ResourceInstancesPager) GetAll() (allItems []ResourceInstance, err
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceInstancesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceInstancesPager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceInstancesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceInstancesPager returns a new ResourceInstancesPager instance positioned at the checkpoint
+// returned by ResourceInstancesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceInstancesPager(options *ListResourceInstancesOptions, checkpoint string) (pager *ResourceInstancesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceInstancesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceInstancesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ResourceAliasesForInstancePager can be used to simplify the use of the "ListResourceAliasesForInstance" method.
 type ResourceAliasesForInstancePager struct {
 	hasNext     bool
@@ -5825,6 +5852,33 @@ func (pager *ResourceAliasesForInstancePager) GetAll() (allItems []ResourceAlias
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceAliasesForInstancePager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceAliasesForInstancePager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceAliasesForInstancePager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceAliasesForInstancePager returns a new ResourceAliasesForInstancePager instance positioned at the checkpoint
+// returned by ResourceAliasesForInstancePager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceAliasesForInstancePager(options *ListResourceAliasesForInstanceOptions, checkpoint string) (pager *ResourceAliasesForInstancePager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceAliasesForInstancePager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceAliasesForInstancePager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ResourceKeysForInstancePager can be used to simplify the use of the "ListResourceKeysForInstance" method.
 type ResourceKeysForInstancePager struct {
 	hasNext     bool
@@ -5917,6 +5971,33 @@ func (pager *ResourceKeysForInstancePager) GetAll() (allItems []ResourceKey, err
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceKeysForInstancePager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceKeysForInstancePager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceKeysForInstancePager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceKeysForInstancePager returns a new ResourceKeysForInstancePager instance positioned at the checkpoint
+// returned by ResourceKeysForInstancePager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceKeysForInstancePager(options *ListResourceKeysForInstanceOptions, checkpoint string) (pager *ResourceKeysForInstancePager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceKeysForInstancePager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceKeysForInstancePager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ResourceKeysPager can be used to simplify the use of the "ListResourceKeys" method.
 type ResourceKeysPager struct {
 	hasNext     bool
@@ -6009,6 +6090,33 @@ func (pager *ResourceKeysPager) GetAll() (allItems []ResourceKey, err error) {
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceKeysPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceKeysPager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceKeysPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceKeysPager returns a new ResourceKeysPager instance positioned at the checkpoint
+// returned by ResourceKeysPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceKeysPager(options *ListResourceKeysOptions, checkpoint string) (pager *ResourceKeysPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceKeysPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceKeysPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ResourceBindingsPager can be used to simplify the use of the "ListResourceBindings" method.
 type ResourceBindingsPager struct {
 	hasNext     bool
@@ -6101,6 +6209,33 @@ func (pager *ResourceBindingsPager) GetAll() (allItems []ResourceBinding, err er
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceBindingsPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceBindingsPager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceBindingsPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceBindingsPager returns a new ResourceBindingsPager instance positioned at the checkpoint
+// returned by ResourceBindingsPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceBindingsPager(options *ListResourceBindingsOptions, checkpoint string) (pager *ResourceBindingsPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceBindingsPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceBindingsPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ResourceAliasesPager can be used to simplify the use of the "ListResourceAliases" method.
 type ResourceAliasesPager struct {
 	hasNext     bool
@@ -6193,6 +6328,33 @@ func (pager *ResourceAliasesPager) GetAll() (allItems []ResourceAlias, err error
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceAliasesPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceAliasesPager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceAliasesPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceAliasesPager returns a new ResourceAliasesPager instance positioned at the checkpoint
+// returned by ResourceAliasesPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceAliasesPager(options *ListResourceAliasesOptions, checkpoint string) (pager *ResourceAliasesPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceAliasesPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceAliasesPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 // ResourceBindingsForAliasPager can be used to simplify the use of the "ListResourceBindingsForAlias" method.
 type ResourceBindingsForAliasPager struct {
 	hasNext     bool
@@ -6284,3 +6446,30 @@ func (pager *ResourceBindingsForAliasPager) GetAll() (allItems []ResourceBinding
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeResourceBindingsForAliasPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *ResourceBindingsForAliasPager) GetCheckpoint() string {
+	return common.EncodePageToken("ResourceBindingsForAliasPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeResourceBindingsForAliasPager returns a new ResourceBindingsForAliasPager instance positioned at the checkpoint
+// returned by ResourceBindingsForAliasPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (resourceController *ResourceControllerV2) ResumeResourceBindingsForAliasPager(options *ListResourceBindingsForAliasOptions, checkpoint string) (pager *ResourceBindingsForAliasPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("ResourceBindingsForAliasPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = resourceController.NewResourceBindingsForAliasPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
@@ -0,0 +1,294 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcecontrollerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+)
+
+const (
+	// DefaultWaitPollInterval is the default value of WaitOptions.PollInterval.
+	DefaultWaitPollInterval = 5 * time.Second
+
+	// DefaultWaitMaxPollInterval is the default value of WaitOptions.MaxPollInterval.
+	DefaultWaitMaxPollInterval = time.Minute
+
+	// DefaultWaitTimeout is the default value of WaitOptions.Timeout.
+	DefaultWaitTimeout = 30 * time.Minute
+)
+
+// ErrResourceInstanceFailed is returned (wrapped) by the resource instance waiters when the
+// instance, or its last operation, reaches the "failed" state. It can be detected with errors.Is().
+var ErrResourceInstanceFailed = errors.New("the resource instance operation failed")
+
+// WaitOptions : The options used to configure the resource instance waiters.
+type WaitOptions struct {
+	// The delay between the first polls of the instance (defaults to DefaultWaitPollInterval).
+	// The delay is doubled after each poll, up to MaxPollInterval. When the last operation of the
+	// instance specifies a "poll_after" interval, that interval is used instead.
+	PollInterval time.Duration
+
+	// The maximum delay between polls (defaults to DefaultWaitMaxPollInterval).
+	MaxPollInterval time.Duration
+
+	// The maximum time to wait (defaults to DefaultWaitTimeout). Any deadline of the
+	// Context passed to the waiter also applies.
+	Timeout time.Duration
+
+	// If set, Progress is invoked after each poll of the instance.
+	Progress func(progress *WaitProgress)
+}
+
+// WaitProgress : The state of a resource instance observed by a waiter.
+type WaitProgress struct {
+	// The instance that was retrieved, or nil if the instance no longer exists.
+	Instance *ResourceInstance
+
+	// The state of the instance (e.g. "provisioning").
+	State string
+
+	// The state of the last operation of the instance (e.g. "in progress"), if any.
+	LastOperationState string
+
+	// The description of the last operation of the instance, if any.
+	Description string
+
+	// The number of times the instance has been polled.
+	Polls int
+
+	// The time elapsed since the waiter was started.
+	Elapsed time.Duration
+}
+
+// waitCondition examines a retrieved instance and reports whether the wait is complete,
+// or returns an error if the instance can no longer reach the desired state.
+type waitCondition func(instance *ResourceInstance) (done bool, err error)
+
+// WaitForResourceInstanceActive polls the resource instance "id" until it is "active" and its
+// last operation (e.g. a create or update) is no longer in progress.
+// An error wrapping ErrResourceInstanceFailed is returned if the instance or its last operation fails.
+func (resourceController *ResourceControllerV2) WaitForResourceInstanceActive(ctx context.Context, id string, options *WaitOptions) (*ResourceInstance, error) {
+	instance, err := resourceController.waitForResourceInstance(ctx, id, "active", false, func(instance *ResourceInstance) (bool, error) {
+		if err := checkFailed(instance); err != nil {
+			return false, err
+		}
+		return getState(instance) == ResourceInstanceStateActiveConst && !operationInProgress(instance), nil
+	}, options)
+	return instance, core.RepurposeSDKProblem(err, "wait-error")
+}
+
+// WaitForResourceInstanceRemoved polls the resource instance "id" until it is "removed" or no
+// longer exists. The returned instance is nil if the instance no longer exists.
+// In an account with a reclamation policy, a deleted instance is "pending_reclamation" rather
+// than "removed"; use WaitForResourceInstanceState() to wait for that state instead.
+// An error wrapping ErrResourceInstanceFailed is returned if the delete operation fails.
+func (resourceController *ResourceControllerV2) WaitForResourceInstanceRemoved(ctx context.Context, id string, options *WaitOptions) (*ResourceInstance, error) {
+	instance, err := resourceController.waitForResourceInstance(ctx, id, "removed", true, func(instance *ResourceInstance) (bool, error) {
+		if getState(instance) == ResourceInstanceStateRemovedConst {
+			return true, nil
+		}
+		return false, checkFailed(instance)
+	}, options)
+	return instance, core.RepurposeSDKProblem(err, "wait-error")
+}
+
+// WaitForResourceInstanceState polls the resource instance "id" until it is in one of the
+// specified states and its last operation is no longer in progress.
+// Unless "failed" is one of the specified states, an error wrapping ErrResourceInstanceFailed
+// is returned if the instance or its last operation fails.
+func (resourceController *ResourceControllerV2) WaitForResourceInstanceState(ctx context.Context, id string, states []string, options *WaitOptions) (*ResourceInstance, error) {
+	if len(states) == 0 {
+		err := core.SDKErrorf(fmt.Errorf("at least one state must be specified"), "", "no-states", common.GetComponentInfo())
+		return nil, err
+	}
+	acceptFailed := false
+	for _, state := range states {
+		acceptFailed = acceptFailed || state == ResourceInstanceStateFailedConst
+	}
+
+	instance, err := resourceController.waitForResourceInstance(ctx, id, strings.Join(states, "' or '"), false, func(instance *ResourceInstance) (bool, error) {
+		state := getState(instance)
+		for _, target := range states {
+			if state == target && !operationInProgress(instance) {
+				return true, nil
+			}
+		}
+		if acceptFailed {
+			return false, nil
+		}
+		return false, checkFailed(instance)
+	}, options)
+	return instance, core.RepurposeSDKProblem(err, "wait-error")
+}
+
+// waitForResourceInstance polls the instance until "condition" reports that the wait is complete.
+// If "goneIsDone" is true, the wait also completes when the instance no longer exists.
+func (resourceController *ResourceControllerV2) waitForResourceInstance(ctx context.Context, id string, target string, goneIsDone bool,
+	condition waitCondition, options *WaitOptions) (instance *ResourceInstance, err error) {
+	if options == nil {
+		options = &WaitOptions{}
+	}
+	interval, maxInterval, timeout := options.PollInterval, options.MaxPollInterval, options.Timeout
+	if interval <= 0 {
+		interval = DefaultWaitPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxPollInterval
+	}
+	if timeout <= 0 {
+		timeout = DefaultWaitTimeout
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	getOptions := resourceController.NewGetResourceInstanceOptions(id)
+	for polls := 1; ; polls++ {
+		delay := interval
+		current, _, getErr := resourceController.GetResourceInstanceWithContext(ctx, getOptions)
+		switch {
+		case getErr == nil:
+			instance = current
+		case goneIsDone && common.IsNotFound(getErr):
+			instance = nil
+			reportProgress(options, nil, polls, start)
+			return
+		case common.IsRateLimited(getErr):
+			// Keep polling, after the delay requested by the service.
+			if retryAfter, ok := common.RetryAfter(getErr); ok {
+				delay = retryAfter
+			}
+		case ctx.Err() != nil:
+			err = waitTimeoutError(ctx, id, target, instance)
+			return
+		default:
+			err = getErr
+			return
+		}
+
+		if getErr == nil {
+			reportProgress(options, instance, polls, start)
+
+			var done bool
+			done, err = condition(instance)
+			if err != nil || done {
+				return
+			}
+			if pollAfter := getPollAfter(instance); pollAfter > 0 {
+				delay = pollAfter
+			}
+		}
+		if delay > maxInterval {
+			delay = maxInterval
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = waitTimeoutError(ctx, id, target, instance)
+			return
+		case <-timer.C:
+		}
+		if interval < maxInterval {
+			interval *= 2
+		}
+	}
+}
+
+// waitTimeoutError returns the error reported when the wait is interrupted by "ctx".
+func waitTimeoutError(ctx context.Context, id string, target string, instance *ResourceInstance) error {
+	err := fmt.Errorf("stopped waiting for resource instance '%s' to become '%s' (current state: '%s'): %w",
+		id, target, getState(instance), ctx.Err())
+	return core.SDKErrorf(err, "", "wait-interrupted", common.GetComponentInfo())
+}
+
+// checkFailed returns an error wrapping ErrResourceInstanceFailed if the instance or its last operation has failed.
+func checkFailed(instance *ResourceInstance) error {
+	lastOperationFailed := instance.LastOperation != nil && instance.LastOperation.State != nil &&
+		*instance.LastOperation.State == ResourceInstanceLastOperationStateFailedConst
+	if getState(instance) != ResourceInstanceStateFailedConst && !lastOperationFailed {
+		return nil
+	}
+
+	description := "no description"
+	if instance.LastOperation != nil && instance.LastOperation.Description != nil {
+		description = *instance.LastOperation.Description
+	}
+	err := fmt.Errorf("%w: resource instance '%s' is '%s': %s", ErrResourceInstanceFailed, getID(instance), getState(instance), description)
+	return core.SDKErrorf(err, "", "instance-failed", common.GetComponentInfo())
+}
+
+func reportProgress(options *WaitOptions, instance *ResourceInstance, polls int, start time.Time) {
+	if options.Progress == nil {
+		return
+	}
+	progress := &WaitProgress{
+		Instance: instance,
+		State:    getState(instance),
+		Polls:    polls,
+		Elapsed:  time.Since(start),
+	}
+	if instance != nil && instance.LastOperation != nil {
+		if instance.LastOperation.State != nil {
+			progress.LastOperationState = *instance.LastOperation.State
+		}
+		if instance.LastOperation.Description != nil {
+			progress.Description = *instance.LastOperation.Description
+		}
+	}
+	options.Progress(progress)
+}
+
+func operationInProgress(instance *ResourceInstance) bool {
+	return instance.LastOperation != nil && instance.LastOperation.State != nil &&
+		*instance.LastOperation.State == ResourceInstanceLastOperationStateInProgressConst
+}
+
+func getPollAfter(instance *ResourceInstance) time.Duration {
+	if instance.LastOperation == nil || instance.LastOperation.PollAfter == nil {
+		return 0
+	}
+	return time.Duration(*instance.LastOperation.PollAfter * float64(time.Second))
+}
+
+func getState(instance *ResourceInstance) string {
+	if instance == nil || instance.State == nil {
+		return ""
+	}
+	return *instance.State
+}
+
+func getID(instance *ResourceInstance) string {
+	if instance.GUID != nil {
+		return *instance.GUID
+	}
+	if instance.ID != nil {
+		return *instance.ID
+	}
+	return ""
+}
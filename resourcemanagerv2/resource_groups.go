@@ -0,0 +1,443 @@
+/**
+ * (C) Copyright IBM Corp. 2024.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package resourcemanagerv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/common"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// ErrResourceGroupNotEmpty is returned (wrapped) by ResourceGroupManager.DeleteResourceGroup()
+// when the resource group contains resources and a cascading delete was not requested.
+// It can be detected with errors.Is().
+var ErrResourceGroupNotEmpty = errors.New("the resource group is not empty")
+
+// DefaultQuotaWarningThreshold is the default value of the "threshold" parameter of
+// QuotaUsageReport.GetNearLimit().
+const DefaultQuotaWarningThreshold = 0.8
+
+// ResourceGroupManager : Lifecycle helpers for resource groups, which combine the Resource
+// Manager API with the Resource Controller API that manages the resources within the groups.
+type ResourceGroupManager struct {
+	resourceManager    *ResourceManagerV2
+	resourceController *resourcecontrollerv2.ResourceControllerV2
+}
+
+// NewResourceGroupManager returns a ResourceGroupManager that uses the specified clients.
+func NewResourceGroupManager(resourceManager *ResourceManagerV2, resourceController *resourcecontrollerv2.ResourceControllerV2) (*ResourceGroupManager, error) {
+	if resourceManager == nil || resourceController == nil {
+		return nil, core.SDKErrorf(nil, "a ResourceManager and a ResourceController client are required", "missing-client", common.GetComponentInfo())
+	}
+	return &ResourceGroupManager{
+		resourceManager:    resourceManager,
+		resourceController: resourceController,
+	}, nil
+}
+
+// ResourceGroupContents : The resources contained in a resource group.
+type ResourceGroupContents struct {
+	// The ID of the resource group.
+	ResourceGroupID string
+
+	// The resource instances in the group, in every state other than removed (including
+	// instances that are failed, inactive or pending reclamation).
+	Instances []resourcecontrollerv2.ResourceInstance
+
+	// The resource keys in the group.
+	Keys []resourcecontrollerv2.ResourceKey
+
+	// The resource aliases in the group.
+	Aliases []resourcecontrollerv2.ResourceAlias
+
+	// The resource bindings in the group.
+	Bindings []resourcecontrollerv2.ResourceBinding
+}
+
+// Count returns the number of resources in the group.
+func (contents *ResourceGroupContents) Count() int {
+	return len(contents.Instances) + len(contents.Keys) + len(contents.Aliases) + len(contents.Bindings)
+}
+
+// IsEmpty returns true if the group contains no resources.
+func (contents *ResourceGroupContents) IsEmpty() bool {
+	return contents.Count() == 0
+}
+
+// String returns a summary of the contents of the group, e.g.
+// "2 resource instances, 1 resource key, 0 resource aliases, 0 resource bindings".
+func (contents *ResourceGroupContents) String() string {
+	counts := []struct {
+		count int
+		name  string
+	}{
+		{len(contents.Instances), "resource instance"},
+		{len(contents.Keys), "resource key"},
+		{len(contents.Aliases), "resource alias"},
+		{len(contents.Bindings), "resource binding"},
+	}
+	var parts []string
+	for _, c := range counts {
+		plural := ""
+		if c.count != 1 {
+			plural = "s"
+			if strings.HasSuffix(c.name, "s") {
+				plural = "es"
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%d %s%s", c.count, c.name, plural))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// GetResourceGroupContents lists the resources contained in the resource group "id".
+func (manager *ResourceGroupManager) GetResourceGroupContents(ctx context.Context, id string) (contents *ResourceGroupContents, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	resourceController := manager.resourceController
+	contents = &ResourceGroupContents{ResourceGroupID: id}
+
+	contents.Instances, err = resourceController.ListNonRemovedResourceInstances(ctx, &resourcecontrollerv2.ListResourceInstancesOptions{
+		ResourceGroupID: core.StringPtr(id),
+	})
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-instances-error")
+	}
+
+	keysPager, err := resourceController.NewResourceKeysPager(&resourcecontrollerv2.ListResourceKeysOptions{
+		ResourceGroupID: core.StringPtr(id),
+	})
+	if err == nil {
+		contents.Keys, err = keysPager.GetAllWithContext(ctx)
+	}
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-keys-error")
+	}
+
+	aliasesPager, err := resourceController.NewResourceAliasesPager(&resourcecontrollerv2.ListResourceAliasesOptions{
+		ResourceGroupID: core.StringPtr(id),
+	})
+	if err == nil {
+		contents.Aliases, err = aliasesPager.GetAllWithContext(ctx)
+	}
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-aliases-error")
+	}
+
+	bindingsPager, err := resourceController.NewResourceBindingsPager(&resourcecontrollerv2.ListResourceBindingsOptions{
+		ResourceGroupID: core.StringPtr(id),
+	})
+	if err == nil {
+		contents.Bindings, err = bindingsPager.GetAllWithContext(ctx)
+	}
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-bindings-error")
+	}
+	return contents, nil
+}
+
+// ResourceGroupDeleteOptions : The options for ResourceGroupManager.DeleteResourceGroup().
+type ResourceGroupDeleteOptions struct {
+	// If true, the resources in the group are deleted before the group. Resource instances are
+	// deleted recursively, together with their keys, aliases and bindings.
+	// Otherwise, an error wrapping ErrResourceGroupNotEmpty is returned if the group is not empty.
+	Cascade bool
+
+	// If true, instances that are pending reclamation (including the instances deleted by a
+	// cascading delete in an account with a reclamation policy) are reclaimed, which deletes
+	// them permanently. Instances that are pending reclamation prevent the group from being
+	// deleted.
+	Reclaim bool
+
+	// The options used to wait for each resource instance to be deleted.
+	WaitOptions *resourcecontrollerv2.WaitOptions
+}
+
+// DeleteResourceGroup deletes the resource group "id" if it is empty or, if options.Cascade is
+// true, after deleting the resources it contains. The returned contents list the resources that
+// the group contained.
+func (manager *ResourceGroupManager) DeleteResourceGroup(ctx context.Context, id string, options *ResourceGroupDeleteOptions) (contents *ResourceGroupContents, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if options == nil {
+		options = &ResourceGroupDeleteOptions{}
+	}
+
+	contents, err = manager.GetResourceGroupContents(ctx, id)
+	if err != nil {
+		return
+	}
+	if !contents.IsEmpty() {
+		if !options.Cascade {
+			err = core.SDKErrorf(fmt.Errorf("%w: resource group '%s' contains %s", ErrResourceGroupNotEmpty, id, contents.String()),
+				"", "resource-group-not-empty", common.GetComponentInfo())
+			return
+		}
+		if err = manager.deleteContents(ctx, contents, options); err != nil {
+			return
+		}
+	}
+
+	_, err = manager.resourceManager.DeleteResourceGroupWithContext(ctx, manager.resourceManager.NewDeleteResourceGroupOptions(id))
+	err = core.RepurposeSDKProblem(err, "delete-resource-group-error")
+	return
+}
+
+// deleteContents deletes the resources in a group: first the instances (recursively), then the
+// keys, aliases and bindings that remain, such as those whose source is outside the group.
+func (manager *ResourceGroupManager) deleteContents(ctx context.Context, contents *ResourceGroupContents, options *ResourceGroupDeleteOptions) error {
+	resourceController := manager.resourceController
+	for _, instance := range contents.Instances {
+		if err := manager.deleteInstance(ctx, &instance, options); err != nil {
+			return err
+		}
+	}
+
+	remaining, err := manager.GetResourceGroupContents(ctx, contents.ResourceGroupID)
+	if err != nil {
+		return err
+	}
+	for _, key := range remaining.Keys {
+		_, err = resourceController.DeleteResourceKeyWithContext(ctx, resourceController.NewDeleteResourceKeyOptions(*key.GUID))
+		if err != nil && !isGone(err) {
+			return core.RepurposeSDKProblem(err, "delete-key-error")
+		}
+	}
+	for _, alias := range remaining.Aliases {
+		_, err = resourceController.DeleteResourceAliasWithContext(ctx, resourceController.NewDeleteResourceAliasOptions(*alias.GUID).SetRecursive(true))
+		if err != nil && !isGone(err) {
+			return core.RepurposeSDKProblem(err, "delete-alias-error")
+		}
+	}
+	for _, binding := range remaining.Bindings {
+		_, err = resourceController.DeleteResourceBindingWithContext(ctx, resourceController.NewDeleteResourceBindingOptions(*binding.GUID))
+		if err != nil && !isGone(err) {
+			return core.RepurposeSDKProblem(err, "delete-binding-error")
+		}
+	}
+	return nil
+}
+
+// deleteInstance deletes an instance recursively, waits for the deletion to complete and,
+// if options.Reclaim is true, reclaims the instance.
+func (manager *ResourceGroupManager) deleteInstance(ctx context.Context, instance *resourcecontrollerv2.ResourceInstance, options *ResourceGroupDeleteOptions) error {
+	resourceController := manager.resourceController
+	id := *instance.GUID
+	state := core.StringNilMapper(instance.State)
+
+	if state != resourcecontrollerv2.ResourceInstanceStatePendingReclamationConst {
+		deleteOptions := resourceController.NewDeleteResourceInstanceOptions(id).SetRecursive(true)
+		if _, err := resourceController.DeleteResourceInstanceWithContext(ctx, deleteOptions); err != nil {
+			return core.RepurposeSDKProblem(err, "delete-instance-error")
+		}
+		deleted, err := resourceController.WaitForResourceInstanceState(ctx, id, []string{
+			resourcecontrollerv2.ResourceInstanceStateRemovedConst,
+			resourcecontrollerv2.ResourceInstanceStatePendingReclamationConst,
+		}, options.WaitOptions)
+		if err != nil {
+			return err
+		}
+		state = core.StringNilMapper(deleted.State)
+	}
+
+	if state == resourcecontrollerv2.ResourceInstanceStatePendingReclamationConst {
+		if !options.Reclaim {
+			return core.SDKErrorf(fmt.Errorf("%w: resource instance '%s' is pending reclamation", ErrResourceGroupNotEmpty, id),
+				"", "resource-group-not-empty", common.GetComponentInfo())
+		}
+		if _, _, err := resourceController.ReclaimResourceInstance(ctx, id); err != nil {
+			return core.RepurposeSDKProblem(err, "reclaim-error")
+		}
+		if _, err := resourceController.WaitForResourceInstanceRemoved(ctx, id, options.WaitOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isGone returns true if "err" reports that a resource no longer exists, for example because it
+// was deleted together with its resource instance.
+func isGone(err error) bool {
+	switch common.GetErrorStatusCode(err) {
+	case 404, 410:
+		return true
+	}
+	return false
+}
+
+// QuotaUsage : The usage of a resource quota of a quota definition.
+type QuotaUsage struct {
+	// The catalog ID of the service that the quota applies to.
+	ResourceID string
+
+	// The CRN of the quota, if any.
+	CRN string
+
+	// The maximum number of instances of the service in the account.
+	Limit float64
+
+	// The number of instances of the service in the account.
+	Used int
+
+	// The number of instances of the service in the resource group of the report.
+	UsedInGroup int
+}
+
+// Remaining returns the number of instances that can still be created before the limit is reached.
+func (usage *QuotaUsage) Remaining() float64 {
+	return usage.Limit - float64(usage.Used)
+}
+
+// Ratio returns the fraction of the limit that is used (e.g. 0.5 if half of the limit is used).
+func (usage *QuotaUsage) Ratio() float64 {
+	if usage.Limit <= 0 {
+		if usage.Used > 0 {
+			return 1
+		}
+		return 0
+	}
+	return float64(usage.Used) / usage.Limit
+}
+
+// IsExceeded returns true if the number of instances has reached or exceeded the limit.
+func (usage *QuotaUsage) IsExceeded() bool {
+	return float64(usage.Used) >= usage.Limit
+}
+
+// QuotaUsageReport : The usage of the quota definition of a resource group, as returned by
+// ResourceGroupManager.GetQuotaUsage().
+type QuotaUsageReport struct {
+	// The resource group whose quota definition was examined.
+	ResourceGroupID string
+
+	// The quota definition of the resource group.
+	QuotaDefinition *QuotaDefinition
+
+	// The total number of resource instances in the account.
+	TotalInstances int
+
+	// The total number of resource instances in the resource group.
+	TotalInstancesInGroup int
+
+	// The usage of each resource quota of the quota definition, sorted by resource ID.
+	Usage []QuotaUsage
+}
+
+// GetExceeded returns the resource quotas whose limit has been reached or exceeded.
+func (report *QuotaUsageReport) GetExceeded() []QuotaUsage {
+	var exceeded []QuotaUsage
+	for _, usage := range report.Usage {
+		if usage.IsExceeded() {
+			exceeded = append(exceeded, usage)
+		}
+	}
+	return exceeded
+}
+
+// GetNearLimit returns the resource quotas whose usage ratio is at least "threshold"
+// (e.g. DefaultQuotaWarningThreshold), including those that are exceeded.
+func (report *QuotaUsageReport) GetNearLimit(threshold float64) []QuotaUsage {
+	var near []QuotaUsage
+	for _, usage := range report.Usage {
+		if usage.Ratio() >= threshold {
+			near = append(near, usage)
+		}
+	}
+	return near
+}
+
+// String returns a human-readable summary of the report, with one line per resource quota.
+func (report *QuotaUsageReport) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Quota '%s' of resource group '%s': %d instances in the account, %d in the group",
+		core.StringNilMapper(report.QuotaDefinition.Name), report.ResourceGroupID, report.TotalInstances, report.TotalInstancesInGroup)
+	for _, usage := range report.Usage {
+		status := ""
+		if usage.IsExceeded() {
+			status = " (limit reached)"
+		}
+		fmt.Fprintf(&b, "\n  %s: %d of %g used%s", usage.ResourceID, usage.Used, usage.Limit, status)
+	}
+	return b.String()
+}
+
+// GetQuotaUsage compares the number of resource instances of each service in the account of the
+// resource group "id" with the limits of the group's quota definition. Instances in every state
+// other than removed are counted.
+func (manager *ResourceGroupManager) GetQuotaUsage(ctx context.Context, id string) (report *QuotaUsageReport, err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	group, _, err := manager.resourceManager.GetResourceGroupWithContext(ctx, manager.resourceManager.NewGetResourceGroupOptions(id))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-resource-group-error")
+	}
+	if group.QuotaID == nil {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("resource group '%s' has no quota definition", id), "missing-quota-definition",
+			common.GetComponentInfo())
+	}
+	quota, _, err := manager.resourceManager.GetQuotaDefinitionWithContext(ctx, manager.resourceManager.NewGetQuotaDefinitionOptions(*group.QuotaID))
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "get-quota-definition-error")
+	}
+
+	instances, err := manager.resourceController.ListNonRemovedResourceInstances(ctx, nil)
+	if err != nil {
+		return nil, core.RepurposeSDKProblem(err, "list-instances-error")
+	}
+
+	report = &QuotaUsageReport{ResourceGroupID: id, QuotaDefinition: quota}
+	used := make(map[string]int)
+	usedInGroup := make(map[string]int)
+	for _, instance := range instances {
+		if group.AccountID != nil && instance.AccountID != nil && *instance.AccountID != *group.AccountID {
+			continue
+		}
+		resourceID := core.StringNilMapper(instance.ResourceID)
+		report.TotalInstances++
+		used[resourceID]++
+		if core.StringNilMapper(instance.ResourceGroupID) == id {
+			report.TotalInstancesInGroup++
+			usedInGroup[resourceID]++
+		}
+	}
+	for _, resourceQuota := range quota.ResourceQuotas {
+		if resourceQuota.ResourceID == nil || resourceQuota.Limit == nil {
+			continue
+		}
+		resourceID := *resourceQuota.ResourceID
+		report.Usage = append(report.Usage, QuotaUsage{
+			ResourceID:  resourceID,
+			CRN:         core.StringNilMapper(resourceQuota.CRN),
+			Limit:       *resourceQuota.Limit,
+			Used:        used[resourceID],
+			UsedInGroup: usedInGroup[resourceID],
+		})
+	}
+	sort.Slice(report.Usage, func(i, j int) bool {
+		return report.Usage[i].ResourceID < report.Usage[j].ResourceID
+	})
+	return report, nil
+}
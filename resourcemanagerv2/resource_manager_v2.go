@@ -217,7 +217,7 @@ func (resourceManager *ResourceManagerV2) ListResourceGroupsWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceManager.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "ListResourceGroups", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -288,7 +288,7 @@ func (resourceManager *ResourceManagerV2) CreateResourceGroupWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceManager.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "CreateResourceGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -352,7 +352,7 @@ func (resourceManager *ResourceManagerV2) GetResourceGroupWithContext(ctx contex
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceManager.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "GetResourceGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -427,7 +427,7 @@ func (resourceManager *ResourceManagerV2) UpdateResourceGroupWithContext(ctx con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceManager.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "UpdateResourceGroup", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -491,7 +491,7 @@ func (resourceManager *ResourceManagerV2) DeleteResourceGroupWithContext(ctx con
 		return
 	}
 
-	response, err = resourceManager.Service.Request(request, nil)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "DeleteResourceGroup", request, nil)
 
 	return
 }
@@ -537,7 +537,7 @@ func (resourceManager *ResourceManagerV2) ListQuotaDefinitionsWithContext(ctx co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceManager.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "ListQuotaDefinitions", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -603,7 +603,7 @@ func (resourceManager *ResourceManagerV2) GetQuotaDefinitionWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = resourceManager.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(resourceManager.Service, "resource_manager", "V2", "GetQuotaDefinition", request, &rawResponse)
 	if err != nil {
 		return
 	}
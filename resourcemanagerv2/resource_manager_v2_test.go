@@ -19,6 +19,7 @@ package resourcemanagerv2_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -27,6 +28,8 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/platformfake"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/go-openapi/strfmt"
 	. "github.com/onsi/ginkgo"
@@ -1591,6 +1594,217 @@ var _ = Describe(`ResourceManagerV2`, func() {
 			})
 		})
 	})
+	Describe(`Resource group lifecycle helpers`, func() {
+		var server *platformfake.Server
+		var resourceManagerService *resourcemanagerv2.ResourceManagerV2
+		var resourceControllerService *resourcecontrollerv2.ResourceControllerV2
+		var manager *resourcemanagerv2.ResourceGroupManager
+		fastPolling := &resourcecontrollerv2.WaitOptions{PollInterval: time.Millisecond, MaxPollInterval: 5 * time.Millisecond}
+
+		startServer := func(options *platformfake.ServerOptions) {
+			server = platformfake.NewServer(options)
+			var serviceErr error
+			resourceManagerService, serviceErr = resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			resourceControllerService, serviceErr = resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			manager, serviceErr = resourcemanagerv2.NewResourceGroupManager(resourceManagerService, resourceControllerService)
+			Expect(serviceErr).To(BeNil())
+		}
+		createGroup := func(name string) string {
+			group, _, err := resourceManagerService.CreateResourceGroup(resourceManagerService.NewCreateResourceGroupOptions().SetName(name))
+			Expect(err).To(BeNil())
+			return *group.ID
+		}
+		createInstance := func(name string, groupID string, planID string) *resourcecontrollerv2.ResourceInstance {
+			createOptions := resourceControllerService.NewCreateResourceInstanceOptions(name, "us-south", groupID, planID)
+			instance, _, err := resourceControllerService.CreateResourceInstance(createOptions)
+			Expect(err).To(BeNil())
+			return instance
+		}
+		getGroupState := func(groupID string) string {
+			group, _, err := resourceManagerService.GetResourceGroup(resourceManagerService.NewGetResourceGroupOptions(groupID))
+			Expect(err).To(BeNil())
+			return *group.State
+		}
+		expectDeleted := func(groupID string) {
+			_, response, err := resourceManagerService.GetResourceGroup(resourceManagerService.NewGetResourceGroupOptions(groupID))
+			Expect(err).ToNot(BeNil())
+			Expect(response.StatusCode).To(Equal(404))
+		}
+		AfterEach(func() {
+			server.Close()
+		})
+		It(`Invoke NewResourceGroupManager without clients`, func() {
+			startServer(nil)
+			manager, err := resourcemanagerv2.NewResourceGroupManager(nil, nil)
+			Expect(err).ToNot(BeNil())
+			Expect(manager).To(BeNil())
+		})
+		Context(`Without reclamation`, func() {
+			BeforeEach(func() {
+				startServer(nil)
+			})
+			It(`Invoke GetResourceGroupContents successfully`, func() {
+				groupID := createGroup("contents")
+				instance := createInstance("db", groupID, "lite-plan")
+				createInstance("other", server.DefaultResourceGroupID(), "lite-plan")
+				_, _, err := resourceControllerService.CreateResourceKey(resourceControllerService.NewCreateResourceKeyOptions("key", *instance.GUID))
+				Expect(err).To(BeNil())
+
+				contents, err := manager.GetResourceGroupContents(context.Background(), groupID)
+				Expect(err).To(BeNil())
+				Expect(contents.ResourceGroupID).To(Equal(groupID))
+				Expect(contents.Instances).To(HaveLen(1))
+				Expect(*contents.Instances[0].GUID).To(Equal(*instance.GUID))
+				Expect(contents.Keys).To(HaveLen(1))
+				Expect(contents.Count()).To(Equal(2))
+				Expect(contents.IsEmpty()).To(BeFalse())
+				Expect(contents.String()).To(Equal("1 resource instance, 1 resource key, 0 resource aliases, 0 resource bindings"))
+
+				contents, err = manager.GetResourceGroupContents(context.Background(), createGroup("empty"))
+				Expect(err).To(BeNil())
+				Expect(contents.IsEmpty()).To(BeTrue())
+			})
+			It(`Invoke DeleteResourceGroup with a non-empty group`, func() {
+				groupID := createGroup("busy")
+				createInstance("db", groupID, "lite-plan")
+
+				contents, err := manager.DeleteResourceGroup(context.Background(), groupID, nil)
+				Expect(err).ToNot(BeNil())
+				Expect(errors.Is(err, resourcemanagerv2.ErrResourceGroupNotEmpty)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("1 resource instance"))
+				Expect(contents.Instances).To(HaveLen(1))
+				Expect(getGroupState(groupID)).To(Equal("ACTIVE"))
+			})
+			It(`Invoke DeleteResourceGroup with an empty group`, func() {
+				groupID := createGroup("empty")
+				contents, err := manager.DeleteResourceGroup(context.Background(), groupID, nil)
+				Expect(err).To(BeNil())
+				Expect(contents.IsEmpty()).To(BeTrue())
+
+				expectDeleted(groupID)
+			})
+			It(`Invoke DeleteResourceGroup with a cascading delete`, func() {
+				groupID := createGroup("cascade")
+				instance := createInstance("db", groupID, "lite-plan")
+				createInstance("cache", groupID, "lite-plan")
+				_, _, err := resourceControllerService.CreateResourceKey(resourceControllerService.NewCreateResourceKeyOptions("key", *instance.GUID))
+				Expect(err).To(BeNil())
+
+				contents, err := manager.DeleteResourceGroup(context.Background(), groupID, &resourcemanagerv2.ResourceGroupDeleteOptions{
+					Cascade:     true,
+					WaitOptions: fastPolling,
+				})
+				Expect(err).To(BeNil())
+				Expect(contents.Instances).To(HaveLen(2))
+				Expect(contents.Keys).To(HaveLen(1))
+
+				remaining, err := manager.GetResourceGroupContents(context.Background(), groupID)
+				Expect(err).To(BeNil())
+				Expect(remaining.IsEmpty()).To(BeTrue())
+				expectDeleted(groupID)
+			})
+		})
+		Context(`With reclamation`, func() {
+			BeforeEach(func() {
+				startServer(&platformfake.ServerOptions{ReclamationEnabled: true})
+			})
+			It(`Invoke DeleteResourceGroup with instances pending reclamation`, func() {
+				groupID := createGroup("reclaim")
+				createInstance("db", groupID, "lite-plan")
+
+				options := &resourcemanagerv2.ResourceGroupDeleteOptions{Cascade: true, WaitOptions: fastPolling}
+				_, err := manager.DeleteResourceGroup(context.Background(), groupID, options)
+				Expect(err).ToNot(BeNil())
+				Expect(errors.Is(err, resourcemanagerv2.ErrResourceGroupNotEmpty)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("pending reclamation"))
+
+				options.Reclaim = true
+				contents, err := manager.DeleteResourceGroup(context.Background(), groupID, options)
+				Expect(err).To(BeNil())
+				Expect(contents.Instances).To(HaveLen(1))
+				Expect(*contents.Instances[0].State).To(Equal("pending_reclamation"))
+				expectDeleted(groupID)
+			})
+		})
+		Context(`Using quota definitions`, func() {
+			BeforeEach(func() {
+				startServer(nil)
+				server.RegisterPlan("cos-plan", platformfake.Plan{ServiceName: "cloud-object-storage", ResourceID: "cos-id"})
+				server.RegisterPlan("db-plan", platformfake.Plan{ServiceName: "databases-for-postgresql", ResourceID: "db-id"})
+				server.RegisterQuotaDefinition(resourcemanagerv2.QuotaDefinition{
+					ID:   core.StringPtr(platformfake.DefaultQuotaID),
+					Name: core.StringPtr("Limited Quota"),
+					ResourceQuotas: []resourcemanagerv2.ResourceQuota{
+						{ResourceID: core.StringPtr("db-id"), Limit: core.Float64Ptr(4)},
+						{ResourceID: core.StringPtr("cos-id"), Limit: core.Float64Ptr(2)},
+					},
+				})
+			})
+			It(`Invoke GetQuotaUsage successfully`, func() {
+				groupID := createGroup("quota")
+				createInstance("cos-1", groupID, "cos-plan")
+				createInstance("cos-2", server.DefaultResourceGroupID(), "cos-plan")
+				createInstance("db-1", groupID, "db-plan")
+				createInstance("other", groupID, "lite-plan")
+
+				report, err := manager.GetQuotaUsage(context.Background(), groupID)
+				Expect(err).To(BeNil())
+				Expect(report.ResourceGroupID).To(Equal(groupID))
+				Expect(*report.QuotaDefinition.Name).To(Equal("Limited Quota"))
+				Expect(report.TotalInstances).To(Equal(4))
+				Expect(report.TotalInstancesInGroup).To(Equal(3))
+				Expect(report.Usage).To(HaveLen(2))
+
+				cos := report.Usage[0]
+				Expect(cos.ResourceID).To(Equal("cos-id"))
+				Expect(cos.Used).To(Equal(2))
+				Expect(cos.UsedInGroup).To(Equal(1))
+				Expect(cos.Remaining()).To(Equal(float64(0)))
+				Expect(cos.IsExceeded()).To(BeTrue())
+
+				db := report.Usage[1]
+				Expect(db.ResourceID).To(Equal("db-id"))
+				Expect(db.Used).To(Equal(1))
+				Expect(db.Ratio()).To(Equal(0.25))
+				Expect(db.IsExceeded()).To(BeFalse())
+
+				Expect(report.GetExceeded()).To(HaveLen(1))
+				Expect(report.GetNearLimit(resourcemanagerv2.DefaultQuotaWarningThreshold)).To(HaveLen(1))
+				Expect(report.GetNearLimit(0.2)).To(HaveLen(2))
+				Expect(report.String()).To(ContainSubstring("cos-id: 2 of 2 used (limit reached)"))
+				Expect(report.String()).To(ContainSubstring("db-id: 1 of 4 used"))
+			})
+			It(`Invoke GetQuotaUsage with an unknown group`, func() {
+				_, err := manager.GetQuotaUsage(context.Background(), "unknown")
+				Expect(err).ToNot(BeNil())
+				Expect(err.Error()).To(ContainSubstring("unknown"))
+			})
+		})
+	})
+	Describe(`CRN helpers`, func() {
+		It(`Invoke ParseCRN successfully`, func() {
+			resourceGroup := &resourcemanagerv2.ResourceGroup{
+				CRN: core.StringPtr("crn:v1:bluemix:public:resource-controller::a/account::resource-group:group"),
+			}
+			parsed, err := resourceGroup.ParseCRN()
+			Expect(err).To(BeNil())
+			Expect(parsed.Resource).To(Equal("group"))
+
+			resourceGroup.CRN = core.StringPtr("not-a-crn")
+			_, err = resourceGroup.ParseCRN()
+			Expect(err).ToNot(BeNil())
+			var sdkProblem *core.SDKProblem
+			Expect(errors.As(err, &sdkProblem)).To(BeTrue())
+		})
+	})
 	Describe(`Model constructor tests`, func() {
 		Context(`Using a service client instance`, func() {
 			resourceManagerService, _ := resourcemanagerv2.NewResourceManagerV2(&resourcemanagerv2.ResourceManagerV2Options{
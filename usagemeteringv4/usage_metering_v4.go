@@ -214,7 +214,7 @@ func (usageMetering *UsageMeteringV4) ReportResourceUsageWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageMetering.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageMetering.Service, "usage_metering", "V4", "ReportResourceUsage", request, &rawResponse)
 	if err != nil {
 		return
 	}
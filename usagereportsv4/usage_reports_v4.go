@@ -222,7 +222,7 @@ func (usageReports *UsageReportsV4) GetAccountSummaryWithContext(ctx context.Con
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetAccountSummary", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_summary", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -300,7 +300,7 @@ func (usageReports *UsageReportsV4) GetAccountUsageWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetAccountUsage", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_account_usage", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -379,7 +379,7 @@ func (usageReports *UsageReportsV4) GetResourceGroupUsageWithContext(ctx context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetResourceGroupUsage", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_group_usage", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -484,7 +484,7 @@ func (usageReports *UsageReportsV4) GetResourceUsageAccountWithContext(ctx conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetResourceUsageAccount", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_usage_account", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -584,7 +584,7 @@ func (usageReports *UsageReportsV4) GetResourceUsageResourceGroupWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetResourceUsageResourceGroup", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_usage_resource_group", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -684,7 +684,7 @@ func (usageReports *UsageReportsV4) GetResourceUsageOrgWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetResourceUsageOrg", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_resource_usage_org", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -763,7 +763,7 @@ func (usageReports *UsageReportsV4) GetOrgUsageWithContext(ctx context.Context,
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetOrgUsage", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_org_usage", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -858,7 +858,7 @@ func (usageReports *UsageReportsV4) CreateReportsSnapshotConfigWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "CreateReportsSnapshotConfig", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "create_reports_snapshot_config", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -925,7 +925,7 @@ func (usageReports *UsageReportsV4) GetReportsSnapshotConfigWithContext(ctx cont
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetReportsSnapshotConfig", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_reports_snapshot_config", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1019,7 +1019,7 @@ func (usageReports *UsageReportsV4) UpdateReportsSnapshotConfigWithContext(ctx c
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "UpdateReportsSnapshotConfig", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "update_reports_snapshot_config", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1084,7 +1084,7 @@ func (usageReports *UsageReportsV4) DeleteReportsSnapshotConfigWithContext(ctx c
 		return
 	}
 
-	response, err = usageReports.Service.Request(request, nil)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "DeleteReportsSnapshotConfig", request, nil)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "delete_reports_snapshot_config", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1171,7 +1171,7 @@ func (usageReports *UsageReportsV4) ValidateReportsSnapshotConfigWithContext(ctx
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "ValidateReportsSnapshotConfig", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "validate_reports_snapshot_config", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -1251,7 +1251,7 @@ func (usageReports *UsageReportsV4) GetReportsSnapshotWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = usageReports.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(usageReports.Service, "usage_reports", "V4", "GetReportsSnapshot", request, &rawResponse)
 	if err != nil {
 		core.EnrichHTTPProblem(err, "get_reports_snapshot", getServiceComponentInfo())
 		err = core.SDKErrorf(err, "", "http-request-err", common.GetComponentInfo())
@@ -4456,6 +4456,33 @@ func (pager *GetResourceUsageAccountPager) GetAll() (allItems []InstanceUsage, e
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetResourceUsageAccountPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetResourceUsageAccountPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetResourceUsageAccountPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetResourceUsageAccountPager returns a new GetResourceUsageAccountPager instance positioned at the checkpoint
+// returned by GetResourceUsageAccountPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (usageReports *UsageReportsV4) ResumeGetResourceUsageAccountPager(options *GetResourceUsageAccountOptions, checkpoint string) (pager *GetResourceUsageAccountPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetResourceUsageAccountPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = usageReports.NewGetResourceUsageAccountPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // GetResourceUsageResourceGroupPager can be used to simplify the use of the "GetResourceUsageResourceGroup" method.
 //
@@ -4550,6 +4577,33 @@ func (pager *GetResourceUsageResourceGroupPager) GetAll() (allItems []InstanceUs
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetResourceUsageResourceGroupPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetResourceUsageResourceGroupPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetResourceUsageResourceGroupPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetResourceUsageResourceGroupPager returns a new GetResourceUsageResourceGroupPager instance positioned at the checkpoint
+// returned by GetResourceUsageResourceGroupPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (usageReports *UsageReportsV4) ResumeGetResourceUsageResourceGroupPager(options *GetResourceUsageResourceGroupOptions, checkpoint string) (pager *GetResourceUsageResourceGroupPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetResourceUsageResourceGroupPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = usageReports.NewGetResourceUsageResourceGroupPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // GetResourceUsageOrgPager can be used to simplify the use of the "GetResourceUsageOrg" method.
 //
@@ -4644,6 +4698,33 @@ func (pager *GetResourceUsageOrgPager) GetAll() (allItems []InstanceUsage, err e
 	return
 }
 
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetResourceUsageOrgPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetResourceUsageOrgPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetResourceUsageOrgPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetResourceUsageOrgPager returns a new GetResourceUsageOrgPager instance positioned at the checkpoint
+// returned by GetResourceUsageOrgPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (usageReports *UsageReportsV4) ResumeGetResourceUsageOrgPager(options *GetResourceUsageOrgOptions, checkpoint string) (pager *GetResourceUsageOrgPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetResourceUsageOrgPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = usageReports.NewGetResourceUsageOrgPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
+
 //
 // GetReportsSnapshotPager can be used to simplify the use of the "GetReportsSnapshot" method.
 //
@@ -4737,3 +4818,30 @@ func (pager *GetReportsSnapshotPager) GetAll() (allItems []SnapshotListSnapshots
 	err = core.RepurposeSDKProblem(err, "")
 	return
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeGetReportsSnapshotPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *GetReportsSnapshotPager) GetCheckpoint() string {
+	return common.EncodePageToken("GetReportsSnapshotPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeGetReportsSnapshotPager returns a new GetReportsSnapshotPager instance positioned at the checkpoint
+// returned by GetReportsSnapshotPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (usageReports *UsageReportsV4) ResumeGetReportsSnapshotPager(options *GetReportsSnapshotOptions, checkpoint string) (pager *GetReportsSnapshotPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("GetReportsSnapshotPager", checkpoint)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "invalid-checkpoint", common.GetComponentInfo())
+		return
+	}
+
+	pager, err = usageReports.NewGetReportsSnapshotPager(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "")
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}
@@ -226,7 +226,7 @@ func (userManagement *UserManagementV1) ListUsersWithContext(ctx context.Context
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = userManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "ListUsers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -310,7 +310,7 @@ func (userManagement *UserManagementV1) InviteUsersWithContext(ctx context.Conte
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = userManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "InviteUsers", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -377,7 +377,7 @@ func (userManagement *UserManagementV1) GetUserProfileWithContext(ctx context.Co
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = userManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "GetUserProfile", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -473,7 +473,7 @@ func (userManagement *UserManagementV1) UpdateUserProfileWithContext(ctx context
 		return
 	}
 
-	response, err = userManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "UpdateUserProfile", request, nil)
 
 	return
 }
@@ -529,7 +529,7 @@ func (userManagement *UserManagementV1) RemoveUserWithContext(ctx context.Contex
 		return
 	}
 
-	response, err = userManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "RemoveUser", request, nil)
 
 	return
 }
@@ -581,7 +581,7 @@ func (userManagement *UserManagementV1) AcceptWithContext(ctx context.Context, a
 		return
 	}
 
-	response, err = userManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "Accept", request, nil)
 
 	return
 }
@@ -634,7 +634,7 @@ func (userManagement *UserManagementV1) V3RemoveUserWithContext(ctx context.Cont
 		return
 	}
 
-	response, err = userManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "V3RemoveUser", request, nil)
 
 	return
 }
@@ -692,7 +692,7 @@ func (userManagement *UserManagementV1) GetUserSettingsWithContext(ctx context.C
 	}
 
 	var rawResponse map[string]json.RawMessage
-	response, err = userManagement.Service.Request(request, &rawResponse)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "GetUserSettings", request, &rawResponse)
 	if err != nil {
 		return
 	}
@@ -773,7 +773,7 @@ func (userManagement *UserManagementV1) UpdateUserSettingsWithContext(ctx contex
 		return
 	}
 
-	response, err = userManagement.Service.Request(request, nil)
+	response, err = common.InvokeRequest(userManagement.Service, "user_management", "V1", "UpdateUserSettings", request, nil)
 
 	return
 }
@@ -1745,3 +1745,28 @@ func (pager *UsersPager) GetNext() (page []UserProfile, err error) {
 func (pager *UsersPager) GetAll() (allItems []UserProfile, err error) {
 	return pager.GetAllWithContext(context.Background())
 }
+
+// GetCheckpoint returns an opaque token that records the current position of the pager.
+// The token can be saved and later passed to ResumeUsersPager() in order to continue
+// retrieving results with the page that follows the last page returned by GetNext().
+func (pager *UsersPager) GetCheckpoint() string {
+	return common.EncodePageToken("UsersPager", pager.pageContext.next, pager.hasNext)
+}
+
+// ResumeUsersPager returns a new UsersPager instance positioned at the checkpoint
+// returned by UsersPager.GetCheckpoint().
+// The options should be the same as those used to create the original pager.
+func (userManagement *UserManagementV1) ResumeUsersPager(options *ListUsersOptions, checkpoint string) (pager *UsersPager, err error) {
+	next, hasNext, err := common.DecodePageToken[string]("UsersPager", checkpoint)
+	if err != nil {
+		return
+	}
+
+	pager, err = userManagement.NewUsersPager(options)
+	if err != nil {
+		return
+	}
+	pager.pageContext.next = next
+	pager.hasNext = hasNext
+	return
+}